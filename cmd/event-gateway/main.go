@@ -1,12 +1,16 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/coreos/etcd/clientv3"
+	"github.com/satori/go.uuid"
 	"github.com/serverless/event-gateway/router"
 	"github.com/serverless/libkv"
 	"github.com/serverless/libkv/store"
@@ -14,20 +18,41 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/grpcapi"
 	"github.com/serverless/event-gateway/httpapi"
+	mqttingress "github.com/serverless/event-gateway/ingress/mqtt"
+	"github.com/serverless/event-gateway/internal/blob"
 	"github.com/serverless/event-gateway/internal/cache"
 	"github.com/serverless/event-gateway/internal/embedded"
+	"github.com/serverless/event-gateway/internal/sockets"
 	intstore "github.com/serverless/event-gateway/internal/store"
 	"github.com/serverless/event-gateway/internal/sync"
 	eventgateway "github.com/serverless/event-gateway/libkv"
 	"github.com/serverless/event-gateway/plugin"
+	execprovider "github.com/serverless/event-gateway/providers/exec"
+	httpprovider "github.com/serverless/event-gateway/providers/http"
 
 	// providers
+	_ "github.com/serverless/event-gateway/providers/amqp"
+	_ "github.com/serverless/event-gateway/providers/awseventbridge"
 	_ "github.com/serverless/event-gateway/providers/awsfirehose"
 	_ "github.com/serverless/event-gateway/providers/awskinesis"
 	_ "github.com/serverless/event-gateway/providers/awslambda"
+	_ "github.com/serverless/event-gateway/providers/awssns"
 	_ "github.com/serverless/event-gateway/providers/awssqs"
-	_ "github.com/serverless/event-gateway/providers/http"
+	_ "github.com/serverless/event-gateway/providers/azurefunctions"
+	_ "github.com/serverless/event-gateway/providers/azureservicebus"
+	_ "github.com/serverless/event-gateway/providers/gcpfunctions"
+	_ "github.com/serverless/event-gateway/providers/gcppubsub"
+	_ "github.com/serverless/event-gateway/providers/kafka"
+	_ "github.com/serverless/event-gateway/providers/knative"
+	_ "github.com/serverless/event-gateway/providers/mqtt"
+	_ "github.com/serverless/event-gateway/providers/nats"
+	_ "github.com/serverless/event-gateway/providers/openwhisk"
+	_ "github.com/serverless/event-gateway/providers/redisstreams"
+	_ "github.com/serverless/event-gateway/providers/script"
 )
 
 var version = "dev"
@@ -42,6 +67,9 @@ func main() {
 	logLevel := zap.LevelFlag("log-level", zap.InfoLevel, `The level of logging to show after the event gateway has started. The available log levels are "debug", "info", "warn", and "err".`)
 	logFormat := flag.String("log-format", "", `The format of logs. The available formats are "text", "json".`)
 	dbHosts := flag.String("db-hosts", "127.0.0.1:2379", "Comma-separated list of database hosts to connect to.")
+	dbEncryptionKeyFile := flag.String("db-encryption-key-file", "", `Path to a file with keys used for encrypting configuration stored in the database, one "id:base64 key" per line. The first key encrypts, others only decrypt. Empty disables encryption.`)
+	dbReencrypt := flag.Bool("db-reencrypt", false, "Re-encrypt configuration stored in the database with the first key from -db-encryption-key-file and exit. Used for encrypting existing configuration and after key rotation.")
+	dbAllowPlaintext := flag.Bool("db-encryption-allow-plaintext", false, "Accept configuration stored in the database as plaintext even after it was re-encrypted with -db-reencrypt.")
 	developmentMode := flag.Bool("dev", false, `Run in development mode with embedded etcd and "text" log format.`)
 	embedPeerAddr := flag.String("embed-peer-addr", "http://127.0.0.1:2380", "Address for testing embedded etcd to receive peer connections.")
 	embedCliAddr := flag.String("embed-cli-addr", "http://127.0.0.1:2379", "Address for testing embedded etcd to receive client connections.")
@@ -52,8 +80,40 @@ func main() {
 	eventsPort := flag.Uint("events-port", 4000, "Port to serve events API on.")
 	eventsTLSCrt := flag.String("events-tls-cert", "", "Path to events API TLS certificate file.")
 	eventsTLSKey := flag.String("events-tls-key", "", "Path to events API TLS key file.")
+	eventsGRPCPort := flag.Uint("events-grpc-port", 0, "Port to serve gRPC events API on. 0 disables gRPC events API.")
+	eventsMaxBodySize := flag.Int64("events-max-body-size", 0, "Maximum size (in bytes) of events API request body. 0 means no limit.")
+	eventsMaxHeaderSize := flag.Int("events-max-header-size", 1<<20, "Maximum size (in bytes) of events API request headers.")
+	eventsReadTimeout := flag.Duration("events-read-timeout", 60*time.Second, "Maximum duration for reading the entire events API request, including the body.")
+	eventsReadHeaderTimeout := flag.Duration("events-read-header-timeout", 10*time.Second, "Maximum duration for reading events API request headers.")
+	eventsClaimCheckThreshold := flag.Int64("events-claim-check-threshold", 0, "Body size (in bytes) above which event payload is stored in the blob store and replaced with a reference. 0 disables offloading.")
+	eventsBlobDir := flag.String("events-blob-dir", "", "Path to a directory where offloaded event payloads are stored.")
+	eventsBlobTTL := flag.Duration("events-blob-ttl", 24*time.Hour, "Time after which offloaded event payloads are removed from the blob store. 0 keeps them forever.")
+	eventsWebSocket := flag.Bool("events-websocket", false, "Enable WebSocket endpoint for pushing events to clients.")
+	eventsStream := flag.Bool("events-stream", false, "Enable Server-Sent Events endpoint for streaming events to clients.")
+	eventsStreamBuffer := flag.Int("events-stream-buffer", 1000, "Number of recent events kept in memory for resuming Server-Sent Events streams.")
+	spaceMaxBodySize := spaceLimits{}
+	flag.Var(&spaceMaxBodySize, "events-space-max-body-size", `Maximum size (in bytes) of event body delivered to a space, in "space=size" format. Can be specified multiple times.`)
+	spaceMaxHeaderSize := spaceLimits{}
+	flag.Var(&spaceMaxHeaderSize, "events-space-max-header-size", `Maximum size (in bytes) of headers of event delivered to a space, in "space=size" format. Can be specified multiple times.`)
+	spaceReadTimeout := spaceTimeouts{}
+	flag.Var(&spaceReadTimeout, "events-space-read-timeout", `Maximum duration for reading body of event delivered to a space, in "space=duration" format. Can be specified multiple times.`)
+	mqttBroker := flag.String("mqtt-broker", "", `MQTT broker URL (e.g. "tcp://localhost:1883") to receive events from. Empty disables MQTT ingress.`)
+	mqttClientID := flag.String("mqtt-client-id", "", "MQTT client ID. Random by default.")
+	mqttUsername := flag.String("mqtt-username", "", "MQTT broker username.")
+	mqttPassword := flag.String("mqtt-password", "", "MQTT broker password.")
+	mqttQoS := flag.Uint("mqtt-qos", 1, "QoS of MQTT subscriptions.")
+	mqttSharedGroup := flag.String("mqtt-shared-group", "event-gateway", "Group of MQTT shared subscriptions, so every message is received by one node of the cluster only. Empty disables shared subscriptions.")
+	mqttContentType := flag.String("mqtt-content-type", "application/json", "Content type of MQTT messages payload.")
+	mqttPath := flag.String("mqtt-path", "/", "Path on which events received from MQTT broker are emitted.")
+	mqttTopics := topicMappings{}
+	flag.Var(&mqttTopics, "mqtt-topic", `MQTT topic filter to subscribe to, in "filter" or "filter=eventType" format. Can be specified multiple times.`)
 	workersNumber := flag.Uint("workers", 100, "Number of workers processing incoming events.")
 	workersBacklog := flag.Uint("workers-backlog", 200, "Length of workers backlog. Maximum number of events that wait for processing.")
+	execProvider := flag.Bool("exec-provider", false, "Enable exec functions running local processes. Commands have to be allowed with -exec-allowed-command flag.")
+	execCommands := paths{}
+	flag.Var(&execCommands, "exec-allowed-command", "Path of an executable, or a directory containing executables, that exec functions can run. Can be specified multiple times.")
+	execDirs := paths{}
+	flag.Var(&execDirs, "exec-allowed-dir", "Directory (including subdirectories) that exec functions can use as working directory. Can be specified multiple times.")
 	plugins := paths{}
 	flag.Var(&plugins, "plugin", "Path to a plugin to load.")
 	flag.Parse()
@@ -87,15 +147,69 @@ func main() {
 		log.Fatal("Cannot create KV client.", zap.Error(err))
 	}
 
+	// etcd client used for transactions spanning multiple keys
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(*dbHosts, ","),
+		DialTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatal("Cannot create etcd client.", zap.Error(err))
+	}
+	kvstore = intstore.NewEtcd(kvstore, etcdClient)
+
+	// Encryption at rest of configuration. Plaintext values are rejected once all configuration was re-encrypted.
+	configStore := kvstore
+	if *dbReencrypt && *dbEncryptionKeyFile == "" {
+		log.Fatal("Cannot re-encrypt configuration without -db-encryption-key-file flag.")
+	}
+	if *dbEncryptionKeyFile != "" {
+		kms, err := intstore.NewLocalKMS(*dbEncryptionKeyFile)
+		if err != nil {
+			log.Fatal("Cannot load encryption keys.", zap.Error(err))
+		}
+		encrypted := intstore.NewEncrypted(kvstore, kms, log)
+
+		if *dbReencrypt {
+			reencryptConfig(encrypted, kvstore, log)
+			os.Exit(0)
+		}
+
+		_, err = kvstore.Get(encryptionMarkerKey, nil)
+		if err != nil && err != store.ErrKeyNotFound {
+			log.Fatal("Cannot check whether configuration is encrypted.", zap.Error(err))
+		}
+		encrypted.SetStrict(err == nil && !*dbAllowPlaintext)
+		configStore = encrypted
+	}
+
 	// Implementation of function and subscription services
 	service := &eventgateway.Service{
-		EventTypeStore:    intstore.NewPrefixed("/serverless-event-gateway/eventtypes", kvstore),
-		FunctionStore:     intstore.NewPrefixed("/serverless-event-gateway/functions", kvstore),
-		SubscriptionStore: intstore.NewPrefixed("/serverless-event-gateway/subscriptions", kvstore),
-		CORSStore:         intstore.NewPrefixed("/serverless-event-gateway/cors", kvstore),
+		EventTypeStore:    intstore.NewPrefixed("/serverless-event-gateway/eventtypes", configStore),
+		FunctionStore:     intstore.NewPrefixed("/serverless-event-gateway/functions", configStore),
+		SubscriptionStore: intstore.NewPrefixed("/serverless-event-gateway/subscriptions", configStore),
+		CORSStore:         intstore.NewPrefixed("/serverless-event-gateway/cors", configStore),
+		SchemaStore:       intstore.NewPrefixed("/serverless-event-gateway/schemas", configStore),
+		SecretStore:       intstore.NewPrefixed("/serverless-event-gateway/secrets", configStore),
 		Log:               log,
 	}
 
+	// Secrets referenced in function provider configs
+	function.SetSecretResolver(service)
+
+	// Service registry used by HTTP functions with "kv" discovery
+	httpprovider.SetRegistry(intstore.NewPrefixed("/serverless-event-gateway/registry", kvstore))
+
+	// Local processes run by exec functions
+	if *execProvider {
+		if len(execCommands) == 0 {
+			log.Fatal("Exec provider requires at least one -exec-allowed-command.")
+		}
+		err = execprovider.Configure(execprovider.Config{Commands: execCommands, Dirs: execDirs})
+		if err != nil {
+			log.Fatal("Cannot configure exec provider.", zap.Error(err))
+		}
+	}
+
 	// Plugin manager
 	pluginManager, err := plugin.NewManager(plugins, log)
 	if err != nil {
@@ -103,18 +217,84 @@ func main() {
 	}
 
 	// Router
-	targetCache := cache.NewTarget("/serverless-event-gateway", kvstore, log)
+	limits := router.Limits{
+		MaxBodySize:         *eventsMaxBodySize,
+		SpaceMaxBodySize:    spaceMaxBodySize,
+		SpaceMaxHeaderSize:  spaceMaxHeaderSize,
+		SpaceReadTimeout:    spaceReadTimeout,
+		ClaimCheckThreshold: *eventsClaimCheckThreshold,
+	}
+	var blobs blob.Store
+	if *eventsBlobDir != "" {
+		fileStore, err := blob.NewFileStore(*eventsBlobDir, *eventsBlobTTL)
+		if err != nil {
+			log.Fatal("Cannot create blob store.", zap.Error(err))
+		}
+		fileStore.StartCollector(time.Minute, shutdownGuard.ShuttingDown, func(err error) {
+			log.Error("Removing expired blobs failed.", zap.Error(err))
+		})
+		blobs = fileStore
+		limits.BlobStore = fileStore
+	}
+
+	streaming := router.Streaming{
+		WebSocket:        *eventsWebSocket,
+		ServerSentEvents: *eventsStream,
+		BufferSize:       *eventsStreamBuffer,
+	}
+	var socketRegistry *sockets.Registry
+	if *eventsWebSocket || *eventsStream {
+		socketRegistry = sockets.NewRegistry(uuid.NewV4().String(), "/serverless-event-gateway", kvstore, log)
+		streaming.Registry = socketRegistry
+	}
+
+	targetCache := cache.NewTarget("/serverless-event-gateway", configStore, log)
 	router := router.New(*workersNumber, *workersBacklog, targetCache, pluginManager, log)
+	router.SetLimits(limits)
+	router.SetStreaming(streaming)
 	router.StartWorkers()
 
 	httpapi.StartEventsAPI(router, httpapi.ServerConfig{
-		TLSCrt:        eventsTLSCrt,
-		TLSKey:        eventsTLSKey,
-		Port:          *eventsPort,
-		ShutdownGuard: shutdownGuard,
+		TLSCrt:            eventsTLSCrt,
+		TLSKey:            eventsTLSKey,
+		Port:              *eventsPort,
+		ShutdownGuard:     shutdownGuard,
+		ReadTimeout:       *eventsReadTimeout,
+		ReadHeaderTimeout: *eventsReadHeaderTimeout,
+		MaxHeaderBytes:    *eventsMaxHeaderSize,
 	})
 
-	httpapi.StartConfigAPI(service, service, service, service, httpapi.ServerConfig{
+	if *eventsGRPCPort != 0 {
+		grpcapi.StartEventsAPI(router, httpapi.ServerConfig{
+			Log:           log,
+			TLSCrt:        eventsTLSCrt,
+			TLSKey:        eventsTLSKey,
+			Port:          *eventsGRPCPort,
+			ShutdownGuard: shutdownGuard,
+		})
+	}
+
+	var mqttIngress *mqttingress.Ingress
+	if *mqttBroker != "" {
+		mqttIngress = mqttingress.New(router, mqttingress.Config{
+			Broker:      *mqttBroker,
+			ClientID:    *mqttClientID,
+			Username:    *mqttUsername,
+			Password:    *mqttPassword,
+			QoS:         byte(*mqttQoS),
+			Group:       *mqttSharedGroup,
+			Topics:      mqttTopics,
+			ContentType: *mqttContentType,
+			Path:        *mqttPath,
+			Log:         log,
+		})
+		err = mqttIngress.Start()
+		if err != nil {
+			log.Fatal("Cannot connect to MQTT broker.", zap.Error(err))
+		}
+	}
+
+	httpapi.StartConfigAPI(service, service, service, service, service, service, blobs, httpapi.ServerConfig{
 		TLSCrt:        configTLSCrt,
 		TLSKey:        configTLSKey,
 		Port:          *configPort,
@@ -135,13 +315,47 @@ func main() {
 	}
 
 	shutdownGuard.Wait()
+	if mqttIngress != nil {
+		mqttIngress.Shutdown()
+	}
 	router.Drain()
 
+	if socketRegistry != nil {
+		socketRegistry.Shutdown()
+	}
+
 	if pluginManager != nil {
 		pluginManager.Kill()
 	}
 }
 
+// encryptionMarkerKey is written when all configuration was encrypted with -db-reencrypt. After that values stored in
+// plaintext are rejected.
+const encryptionMarkerKey = "/serverless-event-gateway/encryption"
+
+// reencryptConfig re-encrypts all configuration with the current key. Values that cannot be decrypted are skipped and
+// prevent marking the configuration as encrypted.
+func reencryptConfig(encrypted *intstore.Encrypted, kvstore store.Store, log *zap.Logger) {
+	skipped := 0
+	for _, dir := range []string{"eventtypes", "functions", "subscriptions", "cors", "schemas", "secrets"} {
+		count, dirSkipped, err := encrypted.Reencrypt("/serverless-event-gateway/" + dir)
+		if err != nil {
+			log.Fatal("Cannot re-encrypt configuration.", zap.String("key", dir), zap.Error(err))
+		}
+		log.Info("Configuration re-encrypted.", zap.String("key", dir), zap.Int("count", count), zap.Int("skipped", dirSkipped))
+		skipped += dirSkipped
+	}
+
+	if skipped > 0 {
+		log.Fatal("Configuration contains values that cannot be decrypted. Fix or remove them and re-encrypt again.",
+			zap.Int("skipped", skipped))
+	}
+	err := kvstore.Put(encryptionMarkerKey, []byte("true"), nil)
+	if err != nil {
+		log.Fatal("Cannot mark configuration as encrypted.", zap.Error(err))
+	}
+}
+
 const (
 	consoleEncoding = "console"
 	jsonEncoding    = "json"
@@ -196,3 +410,76 @@ func (p *paths) Set(value string) error {
 	*p = append(*p, value)
 	return nil
 }
+
+type spaceLimits map[string]int64
+
+func (l spaceLimits) String() string {
+	limits := []string{}
+	for space, limit := range l {
+		limits = append(limits, space+"="+strconv.FormatInt(limit, 10))
+	}
+	return strings.Join(limits, ",")
+}
+
+func (l spaceLimits) Set(value string) error {
+	segments := strings.SplitN(value, "=", 2)
+	if len(segments) != 2 {
+		return errors.New(`limit has to be in "space=size" format`)
+	}
+
+	limit, err := strconv.ParseInt(segments[1], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	l[segments[0]] = limit
+	return nil
+}
+
+type spaceTimeouts map[string]time.Duration
+
+func (t spaceTimeouts) String() string {
+	timeouts := []string{}
+	for space, timeout := range t {
+		timeouts = append(timeouts, space+"="+timeout.String())
+	}
+	return strings.Join(timeouts, ",")
+}
+
+func (t spaceTimeouts) Set(value string) error {
+	segments := strings.SplitN(value, "=", 2)
+	if len(segments) != 2 {
+		return errors.New(`timeout has to be in "space=duration" format`)
+	}
+
+	timeout, err := time.ParseDuration(segments[1])
+	if err != nil {
+		return err
+	}
+
+	t[segments[0]] = timeout
+	return nil
+}
+
+type topicMappings map[string]eventpkg.TypeName
+
+func (m topicMappings) String() string {
+	mappings := []string{}
+	for filter, eventType := range m {
+		mappings = append(mappings, filter+"="+string(eventType))
+	}
+	return strings.Join(mappings, ",")
+}
+
+func (m topicMappings) Set(value string) error {
+	segments := strings.SplitN(value, "=", 2)
+	if segments[0] == "" {
+		return errors.New(`topic has to be in "filter" or "filter=eventType" format`)
+	}
+
+	m[segments[0]] = ""
+	if len(segments) == 2 {
+		m[segments[0]] = eventpkg.TypeName(segments[1])
+	}
+	return nil
+}
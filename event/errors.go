@@ -43,6 +43,13 @@ func (e ErrAuthorizerDoesNotExists) Error() string {
 	return fmt.Sprintf("Authorizer function doesn't exists.")
 }
 
+// ErrSchemaDoesNotExists occurs when schema referenced by event type doesn't exists.
+type ErrSchemaDoesNotExists struct{}
+
+func (e ErrSchemaDoesNotExists) Error() string {
+	return fmt.Sprintf("Schema doesn't exists.")
+}
+
 // ErrParsingCloudEvent occurs when payload is not valid CloudEvent.
 type ErrParsingCloudEvent struct {
 	Message string
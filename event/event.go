@@ -116,11 +116,101 @@ func (e *Event) Validate() error {
 	return nil
 }
 
+// NormalizeData converts raw data to a map or a string depending on content type, the same way as for events received
+// over HTTP.
+func (e *Event) NormalizeData() {
+	e.Data = normalizePayload(e.Data, e.ContentType)
+}
+
 // IsSystem indicates if the event is a system event.
 func (e *Event) IsSystem() bool {
 	return strings.HasPrefix(string(e.EventType), "eventgateway.")
 }
 
+// Attribute returns value of the event attribute pointed by the dot separated path of JSON field names e.g. "eventType",
+// "extensions.tenant" or "data.userId". It returns false if the attribute doesn't exist or is null.
+func (e *Event) Attribute(path string) (interface{}, bool) {
+	segments := strings.SplitN(path, ".", 2)
+	var value interface{}
+	switch segments[0] {
+	case "eventType":
+		value = string(e.EventType)
+	case "eventTypeVersion":
+		value = omitEmpty(e.EventTypeVersion)
+	case "cloudEventsVersion":
+		value = e.CloudEventsVersion
+	case "source":
+		value = e.Source
+	case "eventID":
+		value = e.EventID
+	case "eventTime":
+		if e.EventTime != nil {
+			value = e.EventTime.Format(time.RFC3339Nano)
+		}
+	case "schemaURL":
+		value = omitEmpty(e.SchemaURL)
+	case "contentType":
+		value = omitEmpty(e.ContentType)
+	case "extensions":
+		if e.Extensions != nil {
+			value = map[string]interface{}(e.Extensions)
+		}
+	case "data":
+		value = e.Data
+	}
+
+	if value == nil || len(segments) == 1 {
+		return value, value != nil
+	}
+	return Lookup(value, segments[1])
+}
+
+// Lookup returns value pointed by the dot separated path of JSON field names in the decoded JSON value. It returns false
+// if the value doesn't exist or is null.
+func Lookup(value interface{}, path string) (interface{}, bool) {
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := toObject(value)
+		if !ok {
+			return nil, false
+		}
+		value = object[segment]
+	}
+	return value, value != nil
+}
+
+// omitEmpty returns nil for empty string, the same way as fields with omitempty tag are omitted in JSON.
+func omitEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// toObject returns the value as a JSON object. Structs (e.g. HTTP request data) are converted through JSON.
+func toObject(value interface{}) (map[string]interface{}, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case map[string]interface{}:
+		return v, true
+	case zap.MapStringInterface:
+		return v, true
+	case string, float64, bool, []interface{}:
+		return nil, false
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	object := map[string]interface{}{}
+	err = json.Unmarshal(encoded, &object)
+	if err != nil {
+		return nil, false
+	}
+	return object, true
+}
+
 // MarshalLogObject is a part of zapcore.ObjectMarshaler interface
 func (e Event) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("eventType", string(e.EventType))
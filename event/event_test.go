@@ -66,6 +66,37 @@ func TestFromRequest(t *testing.T) {
 	}
 }
 
+func TestAttribute(t *testing.T) {
+	for _, testCase := range attributeTests {
+		t.Run(testCase.name, func(t *testing.T) {
+			value, ok := testCase.event.Attribute(testCase.path)
+
+			assert.Equal(t, testCase.expectedOK, ok)
+			assert.Equal(t, testCase.expectedValue, value)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	event := map[string]interface{}{
+		"eventType": "user.created",
+		"data":      map[string]interface{}{"userId": float64(1), "tags": []interface{}{"a"}, "deleted": nil},
+	}
+
+	value, ok := eventpkg.Lookup(event, "data.userId")
+	assert.True(t, ok)
+	assert.Equal(t, float64(1), value)
+
+	_, ok = eventpkg.Lookup(event, "data.deleted")
+	assert.False(t, ok)
+
+	_, ok = eventpkg.Lookup(event, "data.tags.0")
+	assert.False(t, ok)
+
+	_, ok = eventpkg.Lookup("not an object", "data")
+	assert.False(t, ok)
+}
+
 var newTests = []struct {
 	name          string
 	eventType     eventpkg.TypeName
@@ -361,3 +392,71 @@ var fromRequestTests = []struct {
 		},
 	},
 }
+
+var attributeTests = []struct {
+	name          string
+	event         eventpkg.Event
+	path          string
+	expectedValue interface{}
+	expectedOK    bool
+}{
+	{
+		name:          "event type",
+		event:         eventpkg.Event{EventType: "user.created"},
+		path:          "eventType",
+		expectedValue: "user.created",
+		expectedOK:    true,
+	},
+	{
+		name:          "event time",
+		event:         eventpkg.Event{EventTime: &testTime},
+		path:          "eventTime",
+		expectedValue: "1985-04-12T23:20:50Z",
+		expectedOK:    true,
+	},
+	{
+		name:       "empty optional attribute",
+		event:      eventpkg.Event{EventType: "user.created"},
+		path:       "schemaURL",
+		expectedOK: false,
+	},
+	{
+		name:          "extension",
+		event:         eventpkg.Event{Extensions: map[string]interface{}{"tenant": "acme"}},
+		path:          "extensions.tenant",
+		expectedValue: "acme",
+		expectedOK:    true,
+	},
+	{
+		name:       "missing extension",
+		event:      eventpkg.Event{},
+		path:       "extensions.tenant",
+		expectedOK: false,
+	},
+	{
+		name:          "nested data field",
+		event:         eventpkg.Event{Data: map[string]interface{}{"user": map[string]interface{}{"id": float64(1)}}},
+		path:          "data.user.id",
+		expectedValue: float64(1),
+		expectedOK:    true,
+	},
+	{
+		name:          "HTTP request data field",
+		event:         eventpkg.Event{Data: &eventpkg.HTTPRequestData{Path: "/users", Query: map[string][]string{}}},
+		path:          "data.path",
+		expectedValue: "/users",
+		expectedOK:    true,
+	},
+	{
+		name:       "field of string data",
+		event:      eventpkg.Event{Data: "hello"},
+		path:       "data.user",
+		expectedOK: false,
+	},
+	{
+		name:       "unknown attribute",
+		event:      eventpkg.Event{EventType: "user.created"},
+		path:       "unknown",
+		expectedOK: false,
+	},
+}
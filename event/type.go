@@ -3,6 +3,7 @@ package event
 import (
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/metadata"
+	"github.com/serverless/event-gateway/schema"
 	"go.uber.org/zap/zapcore"
 )
 
@@ -19,6 +20,7 @@ type Type struct {
 	Space        string       `json:"space" validate:"required,min=3,space"`
 	Name         TypeName     `json:"name" validate:"required"`
 	AuthorizerID *function.ID `json:"authorizerId,omitempty"`
+	SchemaID     *schema.ID   `json:"schemaId,omitempty"`
 
 	Metadata metadata.Metadata `json:"metadata,omitempty"`
 }
@@ -33,6 +35,9 @@ func (t Type) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if t.AuthorizerID != nil {
 		enc.AddString("authorizer", string(*t.AuthorizerID))
 	}
+	if t.SchemaID != nil {
+		enc.AddString("schema", string(*t.SchemaID))
+	}
 
 	return nil
 }
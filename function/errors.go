@@ -2,6 +2,7 @@ package function
 
 import (
 	"fmt"
+	"net/http"
 )
 
 // ErrFunctionNotFound occurs when function couldn't been found in the discovery.
@@ -77,6 +78,19 @@ func (e ErrFunctionError) Error() string {
 	return fmt.Sprintf("Function call failed because of runtime error. Error: %s", e.Original)
 }
 
+// ErrFromStatusCode returns error of HTTP function call based on the response status code. Responses with 5xx and 429
+// status codes are failed calls that can be retried, other 4xx status codes are function errors. It returns nil for
+// other status codes.
+func ErrFromStatusCode(statusCode int) error {
+	if statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests {
+		return &ErrFunctionCallFailed{Original: fmt.Errorf("HTTP status code: %d", statusCode)}
+	}
+	if statusCode >= http.StatusBadRequest {
+		return &ErrFunctionError{Original: fmt.Errorf("HTTP status code: %d", statusCode)}
+	}
+	return nil
+}
+
 // ErrFunctionHasSubscriptions occurs when function with subscription is being deleted.
 type ErrFunctionHasSubscriptions struct{}
 
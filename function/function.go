@@ -30,6 +30,16 @@ func (f *Function) Call(payload []byte) ([]byte, error) {
 	return f.Provider.Call(payload)
 }
 
+// CallAsync sends a payload to a target function without waiting for the result, if provider supports it. Otherwise
+// the result is discarded.
+func (f *Function) CallAsync(payload []byte) error {
+	if provider, ok := f.Provider.(AsyncProvider); ok {
+		return provider.CallAsync(payload)
+	}
+	_, err := f.Provider.Call(payload)
+	return err
+}
+
 // MarshalLogObject is a part of zapcore.ObjectMarshaler interface
 func (f Function) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("space", string(f.Space))
@@ -45,12 +55,18 @@ func (f *Function) MarshalJSON() ([]byte, error) {
 	// This line is needed to avoid stack overflow because of recursive MarshalJSON call
 	type functionJSON Function
 
-	config, err := json.Marshal(f.Provider)
-	if err != nil {
-		return nil, err
+	var rawConfig json.RawMessage
+	if f.ProviderConfig != nil {
+		// config with secret references is returned as is so that secret values are never marshaled
+		rawConfig = *f.ProviderConfig
+	} else {
+		config, err := json.Marshal(f.Provider)
+		if err != nil {
+			return nil, err
+		}
+		rawConfig = json.RawMessage(config)
 	}
 
-	rawConfig := json.RawMessage(config)
 	fn := functionJSON{
 		Space:          f.Space,
 		ID:             f.ID,
@@ -75,15 +91,45 @@ func (f *Function) UnmarshalJSON(data []byte) error {
 	if rawFunction.ProviderType == "" {
 		return errors.New("provider configuration not set")
 	}
+	if rawFunction.ProviderConfig == nil {
+		return &ErrFunctionValidation{Message: "provider configuration not set"}
+	}
 
 	f.ID = rawFunction.ID
 	f.Space = rawFunction.Space
 	f.Metadata = rawFunction.Metadata
 	f.ProviderType = rawFunction.ProviderType
 
-	if loader, ok := providers[rawFunction.ProviderType]; ok {
+	config := *rawFunction.ProviderConfig
+	if len(SecretRefs(config)) > 0 {
+		f.ProviderConfig = rawFunction.ProviderConfig
+		if f.Space == "" {
+			// secret references are resolved in the function space, provider is loaded by LoadProvider
+			return nil
+		}
+	}
+
+	return f.load(config)
+}
+
+// LoadProvider loads provider from config with secret references. Secrets are resolved in the function space. It's
+// needed only if the function was unmarshaled without space.
+func (f *Function) LoadProvider() error {
+	if f.ProviderConfig == nil {
+		return nil
+	}
+	return f.load(*f.ProviderConfig)
+}
+
+func (f *Function) load(config []byte) error {
+	if loader, ok := providers[f.ProviderType]; ok {
+		resolved, err := resolveSecrets(f.Space, config)
+		if err != nil {
+			return err
+		}
+
 		// err includes validation errors happening on provider side
-		provider, err := loader.Load(*rawFunction.ProviderConfig)
+		provider, err := loader.Load(resolved)
 		if err != nil {
 			return err
 		}
@@ -92,5 +138,5 @@ func (f *Function) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	return errors.New("provider " + string(rawFunction.ProviderType) + " not supported")
+	return errors.New("provider " + string(f.ProviderType) + " not supported")
 }
@@ -2,10 +2,13 @@ package function_test
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/serverless/event-gateway/function"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/serverless/event-gateway/providers/http"
 )
@@ -46,3 +49,185 @@ func TestUnmarshalJSON_NoProvider(t *testing.T) {
 
 	assert.EqualError(t, err, "provider configuration not set")
 }
+
+func TestUnmarshalJSON_NoProviderConfig(t *testing.T) {
+	for _, data := range [][]byte{
+		[]byte(`{"functionId":"testid","type":"nope"}`),
+		[]byte(`{"functionId":"testid","type":"http"}`),
+		[]byte(`{"functionId":"testid","type":"http","provider":null}`),
+	} {
+		fn := &function.Function{}
+		err := json.Unmarshal(data, fn)
+
+		assert.Equal(t, &function.ErrFunctionValidation{Message: "provider configuration not set"}, err)
+	}
+}
+
+func TestCallAsync(t *testing.T) {
+	provider := &asyncProvider{}
+	fn := &function.Function{Provider: provider}
+
+	err := fn.CallAsync([]byte("payload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "payload", provider.async)
+	assert.Equal(t, "", provider.sync)
+}
+
+func TestCallAsync_NotSupported(t *testing.T) {
+	provider := &syncProvider{}
+	fn := &function.Function{Provider: provider}
+
+	err := fn.CallAsync([]byte("payload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "payload", provider.sync)
+}
+
+type syncProvider struct {
+	sync string
+}
+
+func (p *syncProvider) Call(payload []byte) ([]byte, error) {
+	p.sync = string(payload)
+	return []byte("result"), nil
+}
+
+func (p *syncProvider) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	return nil
+}
+
+type asyncProvider struct {
+	syncProvider
+	async string
+}
+
+func (p *asyncProvider) CallAsync(payload []byte) error {
+	p.async = string(payload)
+	return nil
+}
+
+func TestUnmarshalJSON_SecretRef(t *testing.T) {
+	function.SetSecretResolver(secrets{"testspace/apitoken": "s3cr3t"})
+	defer function.SetSecretResolver(nil)
+	data := []byte(`{"space":"testspace","functionId":"testid","type":"http",` +
+		`"provider":{"url":"http://example.com","auth":{"type":"bearer","token":{"secretRef":"apitoken"}}}}`)
+
+	fn := &function.Function{}
+	err := json.Unmarshal(data, fn)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", fn.Provider.(*http.HTTP).Auth.Token)
+
+	marshaled, err := json.Marshal(fn)
+	assert.Nil(t, err)
+	assert.Equal(t, data, marshaled)
+}
+
+func TestUnmarshalJSON_SecretRefWithoutSpace(t *testing.T) {
+	function.SetSecretResolver(secrets{"testspace/apitoken": "s3cr3t"})
+	defer function.SetSecretResolver(nil)
+	data := []byte(`{"functionId":"testid","type":"http",` +
+		`"provider":{"url":"http://example.com","auth":{"type":"bearer","token":{"secretRef":"apitoken"}}}}`)
+
+	fn := &function.Function{}
+	err := json.Unmarshal(data, fn)
+
+	assert.Nil(t, err)
+	assert.Nil(t, fn.Provider)
+
+	fn.Space = "testspace"
+	err = fn.LoadProvider()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", fn.Provider.(*http.HTTP).Auth.Token)
+}
+
+func TestUnmarshalJSON_SecretNotFound(t *testing.T) {
+	function.SetSecretResolver(secrets{})
+	defer function.SetSecretResolver(nil)
+	data := []byte(`{"space":"testspace","functionId":"testid","type":"http",` +
+		`"provider":{"url":"http://example.com","auth":{"type":"bearer","token":{"secretRef":"apitoken"}}}}`)
+
+	fn := &function.Function{}
+	err := json.Unmarshal(data, fn)
+
+	assert.EqualError(t, err, `unable to resolve secret reference: secret "apitoken" not found`)
+}
+
+func TestUnmarshalJSON_SecretsNotConfigured(t *testing.T) {
+	data := []byte(`{"space":"testspace","functionId":"testid","type":"http",` +
+		`"provider":{"url":"http://example.com","auth":{"type":"bearer","token":{"secretRef":"apitoken"}}}}`)
+
+	fn := &function.Function{}
+	err := json.Unmarshal(data, fn)
+
+	assert.EqualError(t, err, "secrets are not configured")
+}
+
+func TestValidateCredentials(t *testing.T) {
+	config := json.RawMessage(`{"key":{"secretRef":"apikey"}}`)
+	for _, testCase := range []struct {
+		fn      *function.Function
+		message string
+	}{
+		{&function.Function{Provider: &credentialsProvider{}}, ""},
+		{&function.Function{Provider: &credentialsProvider{Key: "s3cr3t"}}, "key has to be a secret reference"},
+		{&function.Function{Provider: &credentialsProvider{Key: "s3cr3t"}, ProviderConfig: &config}, ""},
+		{&function.Function{Provider: &syncProvider{}}, ""},
+	} {
+		err := testCase.fn.ValidateCredentials()
+
+		if testCase.message == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.Equal(t, &function.ErrFunctionValidation{Message: testCase.message}, err)
+		}
+	}
+}
+
+type credentialsProvider struct {
+	syncProvider
+	Key string `json:"key,omitempty"`
+}
+
+func (p *credentialsProvider) CredentialFields() []string {
+	return []string{"key"}
+}
+
+func TestSecretRefs(t *testing.T) {
+	refs := function.SecretRefs([]byte(`{"token":{"secretRef":"b"},"keys":[{"secretRef":"a"},{"secretRef":"b"}],` +
+		`"other":{"secretRef":"c","name":"d"}}`))
+
+	assert.Equal(t, []string{"a", "b"}, refs)
+	assert.Nil(t, function.SecretRefs([]byte(`{"url":"http://example.com"}`)))
+}
+
+type secrets map[string]string
+
+func (s secrets) ResolveSecret(space, name string) (string, error) {
+	value, ok := s[space+"/"+name]
+	if !ok {
+		return "", fmt.Errorf("secret %q not found", name)
+	}
+	return value, nil
+}
+
+func TestErrFromStatusCode(t *testing.T) {
+	for _, testCase := range []struct {
+		status        int
+		expectedError error
+	}{
+		{200, nil},
+		{302, nil},
+		{400, &function.ErrFunctionError{Original: errors.New("HTTP status code: 400")}},
+		{404, &function.ErrFunctionError{Original: errors.New("HTTP status code: 404")}},
+		{429, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 429")}},
+		{500, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 500")}},
+		{503, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 503")}},
+	} {
+		err := function.ErrFromStatusCode(testCase.status)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
@@ -11,6 +11,19 @@ type Provider interface {
 	MarshalLogObject(enc zapcore.ObjectEncoder) error
 }
 
+// AsyncProvider is an optional interface implemented by providers that can invoke function without waiting for its
+// result. It's used for async subscriptions.
+type AsyncProvider interface {
+	CallAsync(payload []byte) error
+}
+
+// CredentialsProvider is an optional interface implemented by providers with credentials in their config. Credential
+// fields have to reference secrets so their values are never stored in plain text or returned by the Config API.
+type CredentialsProvider interface {
+	// CredentialFields returns JSON names of top-level config fields holding credentials.
+	CredentialFields() []string
+}
+
 // ProviderLoader returns Provider instance based on JSON config blob.
 type ProviderLoader interface {
 	Load(config []byte) (Provider, error)
@@ -0,0 +1,152 @@
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// SecretResolver returns value of a secret referenced in provider config.
+type SecretResolver interface {
+	ResolveSecret(space, name string) (string, error)
+}
+
+var errSecretsNotConfigured = errors.New("secrets are not configured")
+
+// secretResolver resolves secret references in provider configs.
+var secretResolver SecretResolver
+
+// SetSecretResolver sets resolver used for secret references. Provider config field can reference a secret with
+// {"secretRef": "<name>"} object instead of a plain value. References are resolved in the function space when the
+// provider is loaded.
+func SetSecretResolver(resolver SecretResolver) {
+	secretResolver = resolver
+}
+
+// SecretRefs returns sorted names of secrets referenced in provider config.
+func SecretRefs(config []byte) []string {
+	if !bytes.Contains(config, []byte(`"secretRef"`)) {
+		return nil
+	}
+
+	value, err := decodeConfig(config)
+	if err != nil {
+		return nil
+	}
+
+	names := map[string]bool{}
+	walkSecretRefs(value, func(name string) (interface{}, error) {
+		names[name] = true
+		return name, nil
+	})
+
+	refs := []string{}
+	for name := range names {
+		refs = append(refs, name)
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+// ValidateCredentials checks that credential fields of provider config reference secrets instead of having plain
+// values. See CredentialsProvider.
+func (f *Function) ValidateCredentials() error {
+	provider, ok := f.Provider.(CredentialsProvider)
+	if !ok {
+		return nil
+	}
+
+	var config []byte
+	if f.ProviderConfig != nil {
+		config = *f.ProviderConfig
+	} else {
+		var err error
+		config, err = json.Marshal(f.Provider)
+		if err != nil {
+			return err
+		}
+	}
+
+	fields := map[string]interface{}{}
+	err := json.Unmarshal(config, &fields)
+	if err != nil {
+		return err
+	}
+	for _, name := range provider.CredentialFields() {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if _, ok := secretRef(value); !ok {
+			return &ErrFunctionValidation{Message: name + " has to be a secret reference"}
+		}
+	}
+	return nil
+}
+
+// resolveSecrets replaces secret references in provider config with secret values.
+func resolveSecrets(space string, config []byte) ([]byte, error) {
+	if len(SecretRefs(config)) == 0 {
+		return config, nil
+	}
+	if secretResolver == nil {
+		return nil, errSecretsNotConfigured
+	}
+
+	value, err := decodeConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	value, err = walkSecretRefs(value, func(name string) (interface{}, error) {
+		return secretResolver.ResolveSecret(space, name)
+	})
+	if err != nil {
+		return nil, errors.New("unable to resolve secret reference: " + err.Error())
+	}
+	return json.Marshal(value)
+}
+
+func decodeConfig(config []byte) (interface{}, error) {
+	var value interface{}
+	dec := json.NewDecoder(bytes.NewReader(config))
+	dec.UseNumber()
+	err := dec.Decode(&value)
+	return value, err
+}
+
+// walkSecretRefs replaces every {"secretRef": "<name>"} object in the value with the result of fn.
+func walkSecretRefs(value interface{}, fn func(name string) (interface{}, error)) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if name, ok := secretRef(v); ok {
+			return fn(name)
+		}
+		for key, field := range v {
+			resolved, err := walkSecretRefs(field, fn)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolved
+		}
+	case []interface{}:
+		for i, item := range v {
+			resolved, err := walkSecretRefs(item, fn)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+	}
+	return value, nil
+}
+
+// secretRef returns name of the secret if the value is {"secretRef": "<name>"} object.
+func secretRef(value interface{}) (string, bool) {
+	ref, ok := value.(map[string]interface{})
+	if !ok || len(ref) != 1 {
+		return "", false
+	}
+	name, ok := ref["secretRef"].(string)
+	return name, ok
+}
@@ -0,0 +1,201 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/httpapi"
+	ihttp "github.com/serverless/event-gateway/internal/http"
+)
+
+// Emitter passes events through the routing pipeline. It's implemented by router.Router.
+type Emitter interface {
+	Emit(event *eventpkg.Event, size int64, w http.ResponseWriter, r *http.Request)
+}
+
+// API implements EventsServer. Events are emitted with requests built from gRPC metadata, so authorizers and system
+// events see the same request data as for events received by the events API.
+type API struct {
+	Emitter Emitter
+}
+
+var errHTTPRequestEvent = errors.New("http.request event can only be emitted with events API")
+
+// Emit passes event to the emitter and returns response of a sync subscriber.
+func (a *API) Emit(ctx context.Context, req *EmitRequest) (*EmitResponse, error) {
+	resp, err := a.emit(ctx, req)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	return resp, nil
+}
+
+// EmitStream passes every event from the stream to the emitter. Events that weren't accepted are reported in
+// the response after the client closes the stream.
+func (a *API) EmitStream(stream Events_EmitStreamServer) error {
+	result := &EmitStreamResponse{Errors: []*EmitError{}}
+	for index := uint64(0); ; index++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(result)
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, err := a.emit(stream.Context(), req)
+		if err != nil {
+			result.Errors = append(result.Errors, &EmitError{
+				Index:      index,
+				EventId:    req.GetEvent().GetEventId(),
+				StatusCode: http.StatusBadRequest,
+				Message:    err.Error(),
+			})
+			continue
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			result.Errors = append(result.Errors, &EmitError{
+				Index:      index,
+				EventId:    req.GetEvent().GetEventId(),
+				StatusCode: resp.StatusCode,
+				Message:    errorMessage(resp),
+			})
+			continue
+		}
+		result.Accepted++
+	}
+}
+
+func (a *API) emit(ctx context.Context, req *EmitRequest) (*EmitResponse, error) {
+	event, err := toEvent(req.GetEvent())
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newRequest(ctx, req.GetPath(), int64(len(req.GetEvent().GetData())))
+	if err != nil {
+		return nil, err
+	}
+
+	w := ihttp.NewResponseRecorder()
+	a.Emitter.Emit(event, r.ContentLength, w, r)
+	w.WriteHeader(http.StatusOK)
+
+	return &EmitResponse{
+		StatusCode: int32(w.Code),
+		Headers:    ihttp.FlattenHeader(w.HeaderMap),
+		Body:       w.Body.Bytes(),
+	}, nil
+}
+
+var errEventRequired = errors.New("event is required")
+
+// toEvent converts protobuf message to an event. Data is normalized based on content type the same way as for
+// events received in CloudEvents binary content mode.
+func toEvent(message *CloudEvent) (*eventpkg.Event, error) {
+	if message == nil {
+		return nil, errEventRequired
+	}
+
+	event := &eventpkg.Event{
+		EventType:          eventpkg.TypeName(message.EventType),
+		EventTypeVersion:   message.EventTypeVersion,
+		CloudEventsVersion: message.CloudEventsVersion,
+		Source:             message.Source,
+		EventID:            message.EventId,
+		SchemaURL:          message.SchemaUrl,
+		ContentType:        message.ContentType,
+		Data:               message.Data,
+	}
+
+	err := event.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if event.EventType == eventpkg.TypeHTTPRequest {
+		return nil, errHTTPRequestEvent
+	}
+
+	if message.EventTime != "" {
+		eventTime, err := time.Parse(time.RFC3339, message.EventTime)
+		if err != nil {
+			return nil, err
+		}
+		event.EventTime = &eventTime
+	}
+
+	if len(message.Extensions) > 0 {
+		event.Extensions = map[string]interface{}{}
+		for key, value := range message.Extensions {
+			event.Extensions[key] = value
+		}
+	}
+
+	event.NormalizeData()
+	return event, nil
+}
+
+// newRequest builds request passed to authorizers and system events. gRPC metadata is used as request headers.
+func newRequest(ctx context.Context, path string, size int64) (*http.Request, error) {
+	if path == "" {
+		path = "/"
+	}
+	target, err := url.ParseRequestURI(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &http.Request{
+		Method:        http.MethodPost,
+		URL:           target,
+		RequestURI:    path,
+		Proto:         "HTTP/2.0",
+		ProtoMajor:    2,
+		Header:        http.Header{},
+		ContentLength: size,
+	}
+
+	md, _ := metadata.FromContext(ctx)
+	for key, values := range md {
+		if strings.HasPrefix(key, ":") {
+			continue
+		}
+		for _, value := range values {
+			r.Header.Add(key, value)
+		}
+	}
+
+	if authority, ok := md[":authority"]; ok && len(authority) > 0 {
+		r.Host = authority[0]
+	} else {
+		r.Host = r.Header.Get("Host")
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.RemoteAddr = p.Addr.String()
+	}
+
+	return r.WithContext(ctx), nil
+}
+
+// errorMessage extracts error message from events API response body.
+func errorMessage(resp *EmitResponse) string {
+	body := &httpapi.Response{}
+	err := json.Unmarshal(resp.Body, body)
+	if err == nil && len(body.Errors) > 0 {
+		return body.Errors[0].Message
+	}
+	return http.StatusText(int(resp.StatusCode))
+}
@@ -0,0 +1,166 @@
+package grpcapi_test
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/grpcapi"
+	"github.com/serverless/event-gateway/httpapi"
+)
+
+func TestEmit(t *testing.T) {
+	emitter := &testEmitter{}
+	client, closeFn := setupTestServer(t, emitter)
+	defer closeFn()
+
+	t.Run("event passed to emitter", func(t *testing.T) {
+		resp, err := client.Emit(context.Background(), &grpcapi.EmitRequest{
+			Path: "/users",
+			Event: &grpcapi.CloudEvent{
+				EventType:          "user.created",
+				CloudEventsVersion: "0.1",
+				Source:             "/services/users",
+				EventId:            "1",
+				EventTime:          "2018-04-01T10:00:00Z",
+				ContentType:        "application/json",
+				Extensions:         map[string]string{"region": "eu"},
+				Data:               []byte(`{"name":"bob"}`),
+			},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(http.StatusAccepted), resp.StatusCode)
+		assert.Equal(t, "/users", emitter.request.URL.Path)
+		assert.Equal(t, int64(14), emitter.size)
+		assert.Equal(t, eventpkg.TypeName("user.created"), emitter.event.EventType)
+		assert.Equal(t, "2018-04-01T10:00:00Z", emitter.event.EventTime.Format("2006-01-02T15:04:05Z07:00"))
+		assert.Equal(t, "eu", emitter.event.Extensions["region"])
+		assert.Equal(t, map[string]interface{}{"name": "bob"}, emitter.event.Data)
+	})
+
+	t.Run("path defaults to root", func(t *testing.T) {
+		client.Emit(context.Background(), &grpcapi.EmitRequest{Event: validEvent("1")})
+
+		assert.Equal(t, "/", emitter.request.URL.Path)
+	})
+
+	t.Run("sync subscriber response returned", func(t *testing.T) {
+		resp, err := client.Emit(context.Background(), &grpcapi.EmitRequest{Event: validEvent("sync")})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(http.StatusOK), resp.StatusCode)
+		assert.Equal(t, "text/plain", resp.Headers["Content-Type"])
+		assert.Equal(t, []byte("hello"), resp.Body)
+	})
+
+	t.Run("invalid event", func(t *testing.T) {
+		_, err := client.Emit(context.Background(), &grpcapi.EmitRequest{Event: &grpcapi.CloudEvent{EventType: "user.created"}})
+
+		assert.Equal(t, "InvalidArgument", grpc.Code(err).String())
+	})
+
+	t.Run("missing event", func(t *testing.T) {
+		_, err := client.Emit(context.Background(), &grpcapi.EmitRequest{})
+
+		assert.Equal(t, "event is required", grpc.ErrorDesc(err))
+	})
+
+	t.Run("http.request event rejected", func(t *testing.T) {
+		event := validEvent("1")
+		event.EventType = "http.request"
+
+		_, err := client.Emit(context.Background(), &grpcapi.EmitRequest{Event: event})
+
+		assert.Equal(t, "InvalidArgument", grpc.Code(err).String())
+	})
+}
+
+func TestEmitStream(t *testing.T) {
+	emitter := &testEmitter{}
+	client, closeFn := setupTestServer(t, emitter)
+	defer closeFn()
+
+	stream, err := client.EmitStream(context.Background())
+	assert.Nil(t, err)
+	stream.Send(&grpcapi.EmitRequest{Event: validEvent("1")})
+	stream.Send(&grpcapi.EmitRequest{Event: &grpcapi.CloudEvent{EventId: "2"}})
+	stream.Send(&grpcapi.EmitRequest{Event: validEvent("forbidden")})
+	stream.Send(&grpcapi.EmitRequest{Event: validEvent("sync")})
+
+	resp, err := stream.CloseAndRecv()
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), resp.Accepted)
+	assert.Len(t, resp.Errors, 2)
+	assert.Equal(t, uint64(1), resp.Errors[0].Index)
+	assert.Equal(t, "2", resp.Errors[0].EventId)
+	assert.Equal(t, int32(http.StatusBadRequest), resp.Errors[0].StatusCode)
+	assert.Equal(t, &grpcapi.EmitError{
+		Index:      2,
+		EventId:    "forbidden",
+		StatusCode: http.StatusForbidden,
+		Message:    "authorization failed",
+	}, resp.Errors[1])
+}
+
+func validEvent(id string) *grpcapi.CloudEvent {
+	return &grpcapi.CloudEvent{
+		EventType:          "user.created",
+		CloudEventsVersion: "0.1",
+		Source:             "/services/users",
+		EventId:            id,
+	}
+}
+
+func setupTestServer(t *testing.T, emitter grpcapi.Emitter) (grpcapi.EventsClient, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	grpcapi.RegisterEventsServer(server, &grpcapi.API{Emitter: emitter})
+	go server.Serve(listener)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return grpcapi.NewEventsClient(conn), func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+// testEmitter responds the way router does: 202 for async events, function response for sync subscriber and error
+// response if authorization failed.
+type testEmitter struct {
+	event   *eventpkg.Event
+	size    int64
+	request *http.Request
+}
+
+func (e *testEmitter) Emit(event *eventpkg.Event, size int64, w http.ResponseWriter, r *http.Request) {
+	e.event = event
+	e.size = size
+	e.request = r
+
+	switch event.EventID {
+	case "sync":
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("hello"))
+	case "forbidden":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: "authorization failed"}}})
+	default:
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
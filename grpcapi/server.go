@@ -0,0 +1,55 @@
+package grpcapi
+
+//go:generate protoc --go_out=plugins=grpc:. events.proto
+
+import (
+	"net"
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/serverless/event-gateway/httpapi"
+)
+
+// StartEventsAPI creates a new gRPC server accepting events and listens for requests.
+func StartEventsAPI(emitter Emitter, config httpapi.ServerConfig) {
+	options := []grpc.ServerOption{}
+	if *config.TLSCrt != "" && *config.TLSKey != "" {
+		creds, err := credentials.NewServerTLSFromFile(*config.TLSCrt, *config.TLSKey)
+		if err != nil {
+			config.Log.Error("Loading gRPC server TLS certificate failed.", zap.Error(err))
+			config.ShutdownGuard.InitiateShutdown()
+			return
+		}
+		options = append(options, grpc.Creds(creds))
+	}
+
+	server := grpc.NewServer(options...)
+	RegisterEventsServer(server, &API{Emitter: emitter})
+
+	config.ShutdownGuard.Add(1)
+	go func() {
+		listen(server, config)
+		config.ShutdownGuard.Done()
+	}()
+}
+
+// listen sets up a graceful shutdown mechanism and runs the gRPC server.
+func listen(server *grpc.Server, config httpapi.ServerConfig) {
+	go func() {
+		<-config.ShutdownGuard.ShuttingDown
+		server.GracefulStop()
+	}()
+
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(int(config.Port)))
+	if err == nil {
+		err = server.Serve(listener)
+	}
+	if err != nil {
+		config.Log.Error("gRPC server failed.", zap.Error(err))
+	}
+
+	config.ShutdownGuard.InitiateShutdown()
+}
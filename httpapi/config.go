@@ -8,18 +8,24 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/internal/blob"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/event-gateway/secret"
 	"github.com/serverless/event-gateway/subscription"
 	"github.com/serverless/event-gateway/subscription/cors"
 )
 
 // StartConfigAPI creates a new configuration API server and listens for requests.
-func StartConfigAPI(eventtypes event.Service, functions function.Service, subscriptions subscription.Service, corses cors.Service, config ServerConfig) {
+func StartConfigAPI(eventtypes event.Service, functions function.Service, subscriptions subscription.Service, corses cors.Service, schemas schema.Service, secrets secret.Service, blobs blob.Store, config ServerConfig) {
 	router := httprouter.New()
 	api := &HTTPAPI{
 		EventTypes:    eventtypes,
 		Functions:     functions,
 		Subscriptions: subscriptions,
 		CORSes:        corses,
+		Schemas:       schemas,
+		Secrets:       secrets,
+		Blobs:         blobs,
 	}
 	api.RegisterRoutes(router)
 
@@ -1,23 +1,19 @@
 package httpapi
 
 import (
+	"net"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 )
 
 // StartEventsAPI creates a new gateway endpoint and listens for requests.
 func StartEventsAPI(router http.Handler, config ServerConfig) {
-	handler := &http.Server{
-		Addr:         ":" + strconv.Itoa(int(config.Port)),
-		Handler:      router,
-		ReadTimeout:  60 * time.Second,
-		WriteTimeout: 540 * time.Second,
-	}
-
 	server := Server{
-		Config:      config,
-		HTTPHandler: handler,
+		Config:       config,
+		HTTPHandler:  NewEventsServer(router, config),
+		WrapListener: EventsListener,
 	}
 
 	config.ShutdownGuard.Add(1)
@@ -26,3 +22,119 @@ func StartEventsAPI(router http.Handler, config ServerConfig) {
 		config.ShutdownGuard.Done()
 	}()
 }
+
+// NewEventsServer creates http.Server serving Events API. Handlers can remove timeouts of long-lived responses with
+// DisableTimeouts if the server is served on a listener wrapped with EventsListener.
+func NewEventsServer(router http.Handler, config ServerConfig) *http.Server {
+	readTimeout := config.ReadTimeout
+	if readTimeout == 0 {
+		readTimeout = 60 * time.Second
+	}
+	writeTimeout := config.WriteTimeout
+	if writeTimeout == 0 {
+		writeTimeout = 540 * time.Second
+	}
+
+	return &http.Server{
+		Addr:              ":" + strconv.Itoa(int(config.Port)),
+		Handler:           router,
+		ReadTimeout:       readTimeout,
+		ReadHeaderTimeout: config.ReadHeaderTimeout,
+		MaxHeaderBytes:    config.MaxHeaderBytes,
+		WriteTimeout:      writeTimeout,
+	}
+}
+
+// EventsListener wraps listener so handlers can access connection of the request with DisableTimeouts. Accepted TCP
+// connections use keep-alives the same way as with http.ListenAndServe.
+func EventsListener(listener net.Listener) net.Listener {
+	return &eventsListener{listener}
+}
+
+// DisableTimeouts removes read and write deadlines of the connection serving the request so long-lived responses
+// (e.g. Server-Sent Events streams) aren't terminated by the server timeouts. It does nothing if the request wasn't
+// received on a listener wrapped with EventsListener.
+func DisableTimeouts(r *http.Request) {
+	if addr, ok := r.Context().Value(http.LocalAddrContextKey).(*connAddr); ok {
+		addr.conn.SetDeadline(time.Time{})
+	}
+}
+
+// ShortenReadDeadline sets read deadline of the connection serving the request if it's earlier than the deadline set
+// by the server. It does nothing if the request wasn't received on a listener wrapped with EventsListener.
+func ShortenReadDeadline(r *http.Request, deadline time.Time) {
+	if addr, ok := r.Context().Value(http.LocalAddrContextKey).(*connAddr); ok {
+		addr.conn.shortenReadDeadline(deadline)
+	}
+}
+
+// RestoreReadDeadline sets read deadline of the connection serving the request back to the deadline set by the
+// server. It should be called once the request body is read, otherwise the shortened deadline cancels the request
+// context while the response is being written.
+func RestoreReadDeadline(r *http.Request) {
+	if addr, ok := r.Context().Value(http.LocalAddrContextKey).(*connAddr); ok {
+		addr.conn.restoreReadDeadline()
+	}
+}
+
+type eventsListener struct {
+	net.Listener
+}
+
+func (l *eventsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		tcp.SetKeepAlive(true)
+		tcp.SetKeepAlivePeriod(3 * time.Minute)
+	}
+	return &eventsConn{Conn: conn}, nil
+}
+
+// eventsConn returns local address pointing back to the connection. http.Server stores local address in the request
+// context, which is the only way to get from the request to its connection before Go 1.13. It also keeps the read
+// deadline set by the server so handlers can only shorten it.
+type eventsConn struct {
+	net.Conn
+	mu           sync.Mutex
+	readDeadline time.Time
+}
+
+func (c *eventsConn) LocalAddr() net.Addr {
+	return &connAddr{Addr: c.Conn.LocalAddr(), conn: c}
+}
+
+func (c *eventsConn) SetDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	return c.Conn.SetDeadline(t)
+}
+
+func (c *eventsConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	return c.Conn.SetReadDeadline(t)
+}
+
+func (c *eventsConn) shortenReadDeadline(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.readDeadline.IsZero() || t.Before(c.readDeadline) {
+		c.Conn.SetReadDeadline(t)
+	}
+}
+
+func (c *eventsConn) restoreReadDeadline() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Conn.SetReadDeadline(c.readDeadline)
+}
+
+type connAddr struct {
+	net.Addr
+	conn *eventsConn
+}
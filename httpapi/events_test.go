@@ -0,0 +1,123 @@
+package httpapi_test
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/serverless/event-gateway/httpapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventsServer_WriteTimeout(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/stream" {
+			httpapi.DisableTimeouts(r)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("event"))
+	})
+	server := newEventsServer(handler, httpapi.ServerConfig{WriteTimeout: 50 * time.Millisecond})
+	defer server.Close()
+
+	t.Run("response written after write timeout if disabled", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/stream")
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, "event", string(body))
+	})
+
+	t.Run("response cut after write timeout", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/")
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Empty(t, string(body))
+	})
+}
+
+// Before Go 1.12 the read deadline wasn't cleared after the request was read, so the request context was canceled
+// after read timeout even if the client was still connected.
+func TestEventsServer_ReadTimeout(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.DisableTimeouts(r)
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		select {
+		case <-time.After(200 * time.Millisecond):
+			w.Write([]byte("event"))
+		case <-r.Context().Done():
+		}
+	})
+	server := newEventsServer(handler, httpapi.ServerConfig{ReadTimeout: 50 * time.Millisecond})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "event", string(body))
+}
+
+func TestEventsServer_ShortenReadDeadline(t *testing.T) {
+	readErr := make(chan error, 1)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.ShortenReadDeadline(r, time.Now().Add(50*time.Millisecond))
+		_, err := ioutil.ReadAll(r.Body)
+		readErr <- err
+	})
+	server := newEventsServer(handler, httpapi.ServerConfig{})
+	defer server.Close()
+
+	body, writer := io.Pipe()
+	defer writer.Close()
+	go func() {
+		writer.Write([]byte("e"))
+		time.Sleep(200 * time.Millisecond)
+		writer.Write([]byte("vent"))
+	}()
+	go http.Post(server.URL, "text/plain", body)
+
+	err := <-readErr
+	netErr, ok := err.(net.Error)
+	assert.True(t, ok)
+	assert.True(t, netErr.Timeout())
+}
+
+func TestEventsServer_RestoreReadDeadline(t *testing.T) {
+	ctxErr := make(chan error, 1)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.ShortenReadDeadline(r, time.Now().Add(50*time.Millisecond))
+		ioutil.ReadAll(r.Body)
+		httpapi.RestoreReadDeadline(r)
+		time.Sleep(100 * time.Millisecond)
+		ctxErr <- r.Context().Err()
+	})
+	server := newEventsServer(handler, httpapi.ServerConfig{})
+	defer server.Close()
+
+	go http.Post(server.URL, "text/plain", strings.NewReader("event"))
+
+	assert.Nil(t, <-ctxErr)
+}
+
+func newEventsServer(handler http.Handler, config httpapi.ServerConfig) *httptest.Server {
+	server := httptest.NewUnstartedServer(nil)
+	server.Config = httpapi.NewEventsServer(handler, config)
+	server.Listener = httpapi.EventsListener(server.Listener)
+	server.Start()
+	return server
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/internal/blob"
 	"github.com/serverless/event-gateway/metadata"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/event-gateway/secret"
 	"github.com/serverless/event-gateway/subscription"
 	"github.com/serverless/event-gateway/subscription/cors"
 )
@@ -21,6 +24,9 @@ type HTTPAPI struct {
 	Functions     function.Service
 	Subscriptions subscription.Service
 	CORSes        cors.Service
+	Schemas       schema.Service
+	Secrets       secret.Service
+	Blobs         blob.Store
 }
 
 // EventTypesResponse is a HTTPAPI JSON response containing event types.
@@ -38,11 +44,26 @@ type SubscriptionsResponse struct {
 	Subscriptions subscription.Subscriptions `json:"subscriptions"`
 }
 
+// SwapRequest is a HTTPAPI JSON request retargeting subscriptions.
+type SwapRequest struct {
+	Subscriptions []subscription.Retarget `json:"subscriptions"`
+}
+
 // CORSResponse is a HTTPAPI JSON response containing cors configuration.
 type CORSResponse struct {
 	CORSes cors.CORSes `json:"cors"`
 }
 
+// SchemasResponse is a HTTPAPI JSON response containing schemas.
+type SchemasResponse struct {
+	Schemas schema.Schemas `json:"schemas"`
+}
+
+// SecretsResponse is a HTTPAPI JSON response containing secrets.
+type SecretsResponse struct {
+	Secrets secret.Secrets `json:"secrets"`
+}
+
 // RegisterRoutes register HTTP API routes
 func (h HTTPAPI) RegisterRoutes(router *httprouter.Router) {
 	router.GET("/v1/status", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {})
@@ -65,12 +86,26 @@ func (h HTTPAPI) RegisterRoutes(router *httprouter.Router) {
 	router.POST("/v1/spaces/:space/subscriptions", h.createSubscription)
 	router.PUT("/v1/spaces/:space/subscriptions/:id", h.updateSubscription)
 	router.DELETE("/v1/spaces/:space/subscriptions/:id", h.deleteSubscription)
+	router.POST("/v1/spaces/:space/subscriptions/swap", h.swapSubscriptions)
 
 	router.GET("/v1/spaces/:space/cors", h.listCORS)
 	router.GET("/v1/spaces/:space/cors/*id", h.getCORS)
 	router.POST("/v1/spaces/:space/cors", h.createCORS)
 	router.PUT("/v1/spaces/:space/cors/*id", h.updateCORS)
 	router.DELETE("/v1/spaces/:space/cors/*id", h.deleteCORS)
+
+	router.GET("/v1/spaces/:space/schemas", h.listSchemas)
+	router.GET("/v1/spaces/:space/schemas/:id", h.getSchema)
+	router.POST("/v1/spaces/:space/schemas", h.createSchema)
+	router.PUT("/v1/spaces/:space/schemas/:id", h.updateSchema)
+	router.DELETE("/v1/spaces/:space/schemas/:id", h.deleteSchema)
+
+	router.GET("/v1/spaces/:space/secrets", h.listSecrets)
+	router.GET("/v1/spaces/:space/secrets/:name", h.getSecret)
+	router.POST("/v1/spaces/:space/secrets", h.createSecret)
+	router.DELETE("/v1/spaces/:space/secrets/:name", h.deleteSecret)
+
+	router.GET("/v1/spaces/:space/blobs/:id", h.getBlob)
 }
 
 func (h HTTPAPI) getEventType(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
@@ -132,6 +167,8 @@ func (h HTTPAPI) createEventType(w http.ResponseWriter, r *http.Request, params
 			w.WriteHeader(http.StatusBadRequest)
 		} else if _, ok := err.(*event.ErrEventTypeAlreadyExists); ok {
 			w.WriteHeader(http.StatusConflict)
+		} else if _, ok := err.(*event.ErrSchemaDoesNotExists); ok {
+			w.WriteHeader(http.StatusBadRequest)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
@@ -171,6 +208,8 @@ func (h HTTPAPI) updateEventType(w http.ResponseWriter, r *http.Request, params
 			w.WriteHeader(http.StatusBadRequest)
 		} else if _, ok := err.(*event.ErrAuthorizerDoesNotExists); ok {
 			w.WriteHeader(http.StatusBadRequest)
+		} else if _, ok := err.(*event.ErrSchemaDoesNotExists); ok {
+			w.WriteHeader(http.StatusBadRequest)
 		} else {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
@@ -256,8 +295,10 @@ func (h HTTPAPI) createFunction(w http.ResponseWriter, r *http.Request, params h
 	err := dec.Decode(fn)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		validationErr := function.ErrFunctionValidation{Message: err.Error()}
-		encoder.Encode(&Response{Errors: []Error{{Message: validationErr.Error()}}})
+		if _, ok := err.(*function.ErrFunctionValidation); !ok {
+			err = &function.ErrFunctionValidation{Message: err.Error()}
+		}
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
 		return
 	}
 
@@ -292,8 +333,10 @@ func (h HTTPAPI) updateFunction(w http.ResponseWriter, r *http.Request, params h
 	err := dec.Decode(fn)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		validationErr := function.ErrFunctionValidation{Message: err.Error()}
-		encoder.Encode(&Response{Errors: []Error{{Message: validationErr.Error()}}})
+		if _, ok := err.(*function.ErrFunctionValidation); !ok {
+			err = &function.ErrFunctionValidation{Message: err.Error()}
+		}
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
 		return
 	}
 
@@ -447,6 +490,10 @@ func (h HTTPAPI) updateSubscription(w http.ResponseWriter, r *http.Request, para
 			w.WriteHeader(http.StatusBadRequest)
 		} else if _, ok := err.(*subscription.ErrSubscriptionNotFound); ok {
 			w.WriteHeader(http.StatusNotFound)
+		} else if _, ok := err.(*subscription.ErrSubscriptionAlreadyExists); ok {
+			w.WriteHeader(http.StatusConflict)
+		} else if _, ok := err.(*subscription.ErrSubscriptionsModified); ok {
+			w.WriteHeader(http.StatusConflict)
 		} else if _, ok := err.(*function.ErrFunctionNotFound); ok {
 			w.WriteHeader(http.StatusBadRequest)
 		} else if _, ok := err.(*subscription.ErrSubscriptionValidation); ok {
@@ -486,6 +533,46 @@ func (h HTTPAPI) deleteSubscription(w http.ResponseWriter, r *http.Request, para
 	metricConfigRequests.WithLabelValues(space, "subscription", "delete").Inc()
 }
 
+func (h HTTPAPI) swapSubscriptions(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	req := &SwapRequest{}
+	dec := json.NewDecoder(r.Body)
+	err := dec.Decode(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		validationErr := subscription.ErrSubscriptionValidation{Message: err.Error()}
+		encoder.Encode(&Response{Errors: []Error{{Message: validationErr.Error()}}})
+		return
+	}
+
+	space := params.ByName("space")
+	subs, err := h.Subscriptions.SwapSubscriptions(space, req.Subscriptions)
+	if err != nil {
+		if _, ok := err.(*subscription.ErrSubscriptionNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+		} else if _, ok := err.(*subscription.ErrSubscriptionsModified); ok {
+			w.WriteHeader(http.StatusConflict)
+		} else if _, ok := err.(*subscription.ErrSubscriptionAlreadyExists); ok {
+			w.WriteHeader(http.StatusConflict)
+		} else if _, ok := err.(*function.ErrFunctionNotFound); ok {
+			w.WriteHeader(http.StatusBadRequest)
+		} else if _, ok := err.(*subscription.ErrSubscriptionValidation); ok {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		w.WriteHeader(http.StatusOK)
+		encoder.Encode(&SubscriptionsResponse{Subscriptions: subs})
+	}
+
+	metricConfigRequests.WithLabelValues(space, "subscription", "swap").Inc()
+}
+
 func (h HTTPAPI) listCORS(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
 	w.Header().Set("Content-Type", "application/json")
 	encoder := json.NewEncoder(w)
@@ -618,6 +705,264 @@ func (h HTTPAPI) deleteCORS(w http.ResponseWriter, r *http.Request, params httpr
 	metricConfigRequests.WithLabelValues(space, "cors", "delete").Inc()
 }
 
+func (h HTTPAPI) getSchema(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	space := params.ByName("space")
+	s, err := h.Schemas.GetSchema(space, schema.ID(params.ByName("id")))
+	if err != nil {
+		if _, ok := err.(*schema.ErrSchemaNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		encoder.Encode(s)
+	}
+
+	metricConfigRequests.WithLabelValues(space, "schema", "get").Inc()
+}
+
+func (h HTTPAPI) listSchemas(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	space := params.ByName("space")
+	filters := extractMetadataFilters(r.URL.Query())
+	schemas, err := h.Schemas.ListSchemas(space, filters...)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		encoder.Encode(&SchemasResponse{Schemas: schemas})
+	}
+
+	metricConfigRequests.WithLabelValues(space, "schema", "list").Inc()
+}
+
+func (h HTTPAPI) createSchema(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	s := &schema.Schema{}
+	dec := json.NewDecoder(r.Body)
+	err := dec.Decode(s)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		validationErr := schema.ErrSchemaValidation{Message: err.Error()}
+		encoder.Encode(&Response{Errors: []Error{{Message: validationErr.Error()}}})
+		return
+	}
+
+	s.Space = params.ByName("space")
+	output, err := h.Schemas.CreateSchema(s)
+	if err != nil {
+		if _, ok := err.(*schema.ErrSchemaAlreadyExists); ok {
+			w.WriteHeader(http.StatusConflict)
+		} else if _, ok := err.(*schema.ErrSchemaValidation); ok {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		w.WriteHeader(http.StatusCreated)
+		encoder.Encode(output)
+
+		metricSchemas.WithLabelValues(s.Space).Inc()
+	}
+
+	metricConfigRequests.WithLabelValues(s.Space, "schema", "create").Inc()
+}
+
+func (h HTTPAPI) updateSchema(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	s := &schema.Schema{}
+	dec := json.NewDecoder(r.Body)
+	err := dec.Decode(s)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		validationErr := schema.ErrSchemaValidation{Message: err.Error()}
+		encoder.Encode(&Response{Errors: []Error{{Message: validationErr.Error()}}})
+		return
+	}
+
+	s.Space = params.ByName("space")
+	s.ID = schema.ID(params.ByName("id"))
+	output, err := h.Schemas.UpdateSchema(s)
+	if err != nil {
+		if _, ok := err.(*schema.ErrSchemaNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+		} else if _, ok := err.(*schema.ErrSchemaValidation); ok {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		w.WriteHeader(http.StatusOK)
+		encoder.Encode(output)
+	}
+
+	metricConfigRequests.WithLabelValues(s.Space, "schema", "update").Inc()
+}
+
+func (h HTTPAPI) deleteSchema(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	space := params.ByName("space")
+	err := h.Schemas.DeleteSchema(space, schema.ID(params.ByName("id")))
+	if err != nil {
+		if _, ok := err.(*schema.ErrSchemaNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+		} else if _, ok := err.(*schema.ErrSchemaInUse); ok {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		w.WriteHeader(http.StatusNoContent)
+
+		metricSchemas.WithLabelValues(space).Dec()
+	}
+
+	metricConfigRequests.WithLabelValues(space, "schema", "delete").Inc()
+}
+
+func (h HTTPAPI) getSecret(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	space := params.ByName("space")
+	s, err := h.Secrets.GetSecret(space, secret.Name(params.ByName("name")))
+	if err != nil {
+		if _, ok := err.(*secret.ErrSecretNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		encoder.Encode(s)
+	}
+
+	metricConfigRequests.WithLabelValues(space, "secret", "get").Inc()
+}
+
+func (h HTTPAPI) listSecrets(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	space := params.ByName("space")
+	filters := extractMetadataFilters(r.URL.Query())
+	secrets, err := h.Secrets.ListSecrets(space, filters...)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		encoder.Encode(&SecretsResponse{Secrets: secrets})
+	}
+
+	metricConfigRequests.WithLabelValues(space, "secret", "list").Inc()
+}
+
+func (h HTTPAPI) createSecret(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	s := &secret.Secret{}
+	dec := json.NewDecoder(r.Body)
+	err := dec.Decode(s)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		validationErr := secret.ErrSecretValidation{Message: err.Error()}
+		encoder.Encode(&Response{Errors: []Error{{Message: validationErr.Error()}}})
+		return
+	}
+
+	s.Space = params.ByName("space")
+	output, err := h.Secrets.CreateSecret(s)
+	if err != nil {
+		if _, ok := err.(*secret.ErrSecretAlreadyExists); ok {
+			w.WriteHeader(http.StatusConflict)
+		} else if _, ok := err.(*secret.ErrSecretValidation); ok {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		w.WriteHeader(http.StatusCreated)
+		encoder.Encode(output)
+
+		metricSecrets.WithLabelValues(s.Space).Inc()
+	}
+
+	metricConfigRequests.WithLabelValues(s.Space, "secret", "create").Inc()
+}
+
+func (h HTTPAPI) deleteSecret(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+
+	space := params.ByName("space")
+	err := h.Secrets.DeleteSecret(space, secret.Name(params.ByName("name")))
+	if err != nil {
+		if _, ok := err.(*secret.ErrSecretNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+		} else if _, ok := err.(*secret.ErrSecretInUse); ok {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		encoder.Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+	} else {
+		w.WriteHeader(http.StatusNoContent)
+
+		metricSecrets.WithLabelValues(space).Dec()
+	}
+
+	metricConfigRequests.WithLabelValues(space, "secret", "delete").Inc()
+}
+
+// getBlob returns event payload offloaded to the blob store.
+func (h HTTPAPI) getBlob(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if h.Blobs == nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&Response{Errors: []Error{{Message: blob.ErrBlobNotFound.Error()}}})
+		return
+	}
+
+	data, err := h.Blobs.Get(params.ByName("space"), blob.ID(params.ByName("id")))
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		if err == blob.ErrBlobNotFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(&Response{Errors: []Error{{Message: err.Error()}}})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(data)
+}
+
 // httprouter weirdness: params are based on Request.URL.Path, not Request.URL.RawPath
 func extractCORSID(rawPath string) cors.ID {
 	segments := strings.Split(rawPath, "/")
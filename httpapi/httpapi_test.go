@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -13,8 +15,11 @@ import (
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/httpapi"
+	"github.com/serverless/event-gateway/internal/blob"
 	"github.com/serverless/event-gateway/metadata"
 	"github.com/serverless/event-gateway/mock"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/event-gateway/secret"
 	"github.com/serverless/event-gateway/subscription"
 	"github.com/serverless/event-gateway/subscription/cors"
 	"github.com/stretchr/testify/assert"
@@ -25,7 +30,7 @@ import (
 func TestGetEventType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, eventTypes, _, _, _ := setup(ctrl)
+	router, eventTypes, _, _, _, _, _ := setup(ctrl)
 
 	t.Run("event type returned", func(t *testing.T) {
 		returnedType := &event.Type{
@@ -70,7 +75,7 @@ func TestGetEventType(t *testing.T) {
 func TestListEventTypes(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, eventTypes, _, _, _ := setup(ctrl)
+	router, eventTypes, _, _, _, _, _ := setup(ctrl)
 
 	t.Run("list returned", func(t *testing.T) {
 		returnedList := event.Types{{
@@ -103,7 +108,7 @@ func TestListEventTypes(t *testing.T) {
 func TestCreateEventType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, eventTypes, _, _, _ := setup(ctrl)
+	router, eventTypes, _, _, _, _, _ := setup(ctrl)
 
 	typePayload := []byte(`{"name":"test.event","space":"test1"}`)
 
@@ -170,7 +175,7 @@ func TestCreateEventType(t *testing.T) {
 func TestUpdateEventType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, eventTypes, _, _, _ := setup(ctrl)
+	router, eventTypes, _, _, _, _, _ := setup(ctrl)
 
 	typePayload := []byte(`{"name":"test.event","space":"test1"}`)
 
@@ -250,7 +255,7 @@ func TestUpdateEventType(t *testing.T) {
 func TestDeleteEventType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, eventTypes, _, _, _ := setup(ctrl)
+	router, eventTypes, _, _, _, _, _ := setup(ctrl)
 
 	t.Run("event type deleted", func(t *testing.T) {
 		eventTypes.EXPECT().DeleteEventType("default", event.TypeName("test.event")).Return(nil)
@@ -299,7 +304,7 @@ func TestDeleteEventType(t *testing.T) {
 func TestGetFunction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, functions, _, _ := setup(ctrl)
+	router, _, functions, _, _, _, _ := setup(ctrl)
 
 	t.Run("function returned", func(t *testing.T) {
 		returnedFn := &function.Function{
@@ -348,7 +353,7 @@ func TestGetFunction(t *testing.T) {
 func TestListFunctions(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, functions, _, _ := setup(ctrl)
+	router, _, functions, _, _, _, _ := setup(ctrl)
 
 	t.Run("list returned", func(t *testing.T) {
 		returnedList := function.Functions{{
@@ -383,7 +388,7 @@ func TestListFunctions(t *testing.T) {
 func TestRegisterFunction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, functions, _, _ := setup(ctrl)
+	router, _, functions, _, _, _, _ := setup(ctrl)
 
 	fnPayload := []byte(`{"functionId":"func1","space":"test1","type":"http","provider":{"url":"http://example.com"}}`)
 
@@ -457,7 +462,7 @@ func TestRegisterFunction(t *testing.T) {
 func TestDeleteFunction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, functions, _, _ := setup(ctrl)
+	router, _, functions, _, _, _, _ := setup(ctrl)
 
 	t.Run("function deleted", func(t *testing.T) {
 		functions.EXPECT().DeleteFunction("default", function.ID("func1")).Return(nil)
@@ -517,7 +522,7 @@ func TestDeleteFunction(t *testing.T) {
 func TestListSubscriptions(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, subscriptions, _ := setup(ctrl)
+	router, _, _, subscriptions, _, _, _ := setup(ctrl)
 
 	t.Run("list returned", func(t *testing.T) {
 		returnedList := subscription.Subscriptions{{
@@ -555,7 +560,7 @@ func TestListSubscriptions(t *testing.T) {
 func TestCreateSubscription(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, subscriptions, _ := setup(ctrl)
+	router, _, _, subscriptions, _, _, _ := setup(ctrl)
 	subPayload := []byte(`{"type":"sync","eventType":"http.request",` +
 		`"functionId":"func","method":"GET","path":"/"}`)
 
@@ -669,7 +674,7 @@ func TestCreateSubscription(t *testing.T) {
 func TestUpdateSubscription(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, subscriptions, _ := setup(ctrl)
+	router, _, _, subscriptions, _, _, _ := setup(ctrl)
 
 	updateSub := &subscription.Subscription{
 		Space:      "default",
@@ -723,6 +728,14 @@ func TestUpdateSubscription(t *testing.T) {
 		assert.Equal(t, `Subscription "testid" not found.`, httpresp.Errors[0].Message)
 	})
 
+	t.Run("subscription modified concurrently", func(t *testing.T) {
+		subscriptions.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil, &subscription.ErrSubscriptionsModified{})
+
+		resp := request(router, http.MethodPut, "/v1/spaces/default/subscriptions/testid", updatedValue)
+
+		assert.Equal(t, http.StatusConflict, resp.Code)
+	})
+
 	t.Run("function not found", func(t *testing.T) {
 		subscriptions.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil, &function.ErrFunctionNotFound{ID: function.ID("func")})
 
@@ -760,7 +773,7 @@ func TestUpdateSubscription(t *testing.T) {
 func TestDeleteSubscription(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, subscriptions, _ := setup(ctrl)
+	router, _, _, subscriptions, _, _, _ := setup(ctrl)
 
 	t.Run("subscription deleted", func(t *testing.T) {
 		subscriptions.EXPECT().DeleteSubscription("default", subscription.ID("testid")).Return(nil)
@@ -795,10 +808,78 @@ func TestDeleteSubscription(t *testing.T) {
 	})
 }
 
+func TestSwapSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, _, subscriptions, _, _, _ := setup(ctrl)
+
+	retargets := []subscription.Retarget{{SubscriptionID: "testid", FunctionID: "green"}}
+	payload := []byte(`{"subscriptions":[{"subscriptionId":"testid","functionId":"green"}]}`)
+
+	t.Run("subscriptions swapped", func(t *testing.T) {
+		swapped := subscription.Subscriptions{{Space: "default", ID: "testid", FunctionID: "green"}}
+		subscriptions.EXPECT().SwapSubscriptions("default", retargets).Return(swapped, nil)
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/subscriptions/swap", payload)
+
+		subs := &httpapi.SubscriptionsResponse{}
+		json.Unmarshal(resp.Body.Bytes(), subs)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, function.ID("green"), subs.Subscriptions[0].FunctionID)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		resp := request(router, http.MethodPost, "/v1/spaces/default/subscriptions/swap", []byte(`{"subscriptions":[`))
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("subscription not found", func(t *testing.T) {
+		subscriptions.EXPECT().SwapSubscriptions(gomock.Any(), gomock.Any()).Return(nil, &subscription.ErrSubscriptionNotFound{ID: subscription.ID("testid")})
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/subscriptions/swap", payload)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, `Subscription "testid" not found.`, httpresp.Errors[0].Message)
+	})
+
+	t.Run("subscriptions modified concurrently", func(t *testing.T) {
+		subscriptions.EXPECT().SwapSubscriptions(gomock.Any(), gomock.Any()).Return(nil, &subscription.ErrSubscriptionsModified{})
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/subscriptions/swap", payload)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusConflict, resp.Code)
+		assert.Equal(t, "Subscriptions were modified concurrently. Changes were not applied.", httpresp.Errors[0].Message)
+	})
+
+	t.Run("function not found", func(t *testing.T) {
+		subscriptions.EXPECT().SwapSubscriptions(gomock.Any(), gomock.Any()).Return(nil, &function.ErrFunctionNotFound{ID: function.ID("green")})
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/subscriptions/swap", payload)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		subscriptions.EXPECT().SwapSubscriptions(gomock.Any(), gomock.Any()).Return(nil, errors.New("processing failed"))
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/subscriptions/swap", payload)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "processing failed", httpresp.Errors[0].Message)
+	})
+}
+
 func TestGetCORS(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, _, corses := setup(ctrl)
+	router, _, _, _, corses, _, _ := setup(ctrl)
 
 	t.Run("CORS config returned", func(t *testing.T) {
 		returnedConfig := &cors.CORS{
@@ -850,7 +931,7 @@ func TestGetCORS(t *testing.T) {
 func TestListCORS(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, _, corses := setup(ctrl)
+	router, _, _, _, corses, _, _ := setup(ctrl)
 
 	t.Run("CORS configurations returned", func(t *testing.T) {
 		returnedList := cors.CORSes{{
@@ -883,7 +964,7 @@ func TestListCORS(t *testing.T) {
 func TestCreateCORS(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, _, corses := setup(ctrl)
+	router, _, _, _, corses, _, _ := setup(ctrl)
 
 	config := &cors.CORS{
 		Space:          "default",
@@ -968,7 +1049,7 @@ func TestCreateCORS(t *testing.T) {
 func TestUpdateCORS(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, _, corses := setup(ctrl)
+	router, _, _, _, corses, _, _ := setup(ctrl)
 
 	updateCORS := &cors.CORS{
 		Space:          "default",
@@ -1035,7 +1116,7 @@ func TestUpdateCORS(t *testing.T) {
 func TestDeleteCORS(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	router, _, _, _, corses := setup(ctrl)
+	router, _, _, _, corses, _, _ := setup(ctrl)
 
 	t.Run("CORS deleted", func(t *testing.T) {
 		corses.EXPECT().DeleteCORS("default", cors.ID("GET%2Fhello")).Return(nil)
@@ -1079,26 +1160,226 @@ func request(router *httprouter.Router, method string, url string, payload []byt
 	return resp
 }
 
+func TestCreateSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, _, _, _, schemas, _ := setup(ctrl)
+
+	s := &schema.Schema{Space: "default", ID: "user", Format: schema.FormatAvro, Definition: `"string"`}
+	payload := []byte(`{"schemaId":"user","format":"avro","definition":"\"string\""}`)
+
+	t.Run("schema created", func(t *testing.T) {
+		schemas.EXPECT().CreateSchema(s).Return(s, nil)
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/schemas", payload)
+
+		returned := &schema.Schema{}
+		json.Unmarshal(resp.Body.Bytes(), returned)
+		assert.Equal(t, http.StatusCreated, resp.Code)
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		assert.Equal(t, schema.ID("user"), returned.ID)
+	})
+
+	t.Run("schema already exists", func(t *testing.T) {
+		schemas.EXPECT().CreateSchema(gomock.Any()).Return(nil, &schema.ErrSchemaAlreadyExists{ID: "user"})
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/schemas", payload)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusConflict, resp.Code)
+		assert.Equal(t, `Schema "user" already exists.`, httpresp.Errors[0].Message)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		schemas.EXPECT().CreateSchema(gomock.Any()).Return(nil, &schema.ErrSchemaValidation{Message: "invalid definition"})
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/schemas", payload)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, "Schema doesn't validate. Validation error: invalid definition", httpresp.Errors[0].Message)
+	})
+}
+
+func TestDeleteSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, _, _, _, schemas, _ := setup(ctrl)
+
+	t.Run("schema deleted", func(t *testing.T) {
+		schemas.EXPECT().DeleteSchema("default", schema.ID("user")).Return(nil)
+
+		resp := request(router, http.MethodDelete, "/v1/spaces/default/schemas/user", nil)
+
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+	})
+
+	t.Run("schema in use", func(t *testing.T) {
+		schemas.EXPECT().DeleteSchema("default", schema.ID("user")).
+			Return(&schema.ErrSchemaInUse{ID: "user", EventType: "user.created"})
+
+		resp := request(router, http.MethodDelete, "/v1/spaces/default/schemas/user", nil)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, "Schema user cannot be deleted because is used by user.created event type.", httpresp.Errors[0].Message)
+	})
+}
+
+func TestCreateSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, _, _, _, _, secrets := setup(ctrl)
+
+	t.Run("secret created", func(t *testing.T) {
+		secrets.EXPECT().CreateSecret(&secret.Secret{Space: "default", Name: "awskey", Value: "s3cr3t"}).
+			Return(&secret.Secret{Space: "default", Name: "awskey"}, nil)
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/secrets", []byte(`{"name":"awskey","value":"s3cr3t"}`))
+
+		assert.Equal(t, http.StatusCreated, resp.Code)
+		assert.JSONEq(t, `{"space":"default","name":"awskey"}`, resp.Body.String())
+	})
+
+	t.Run("secret already exists", func(t *testing.T) {
+		secrets.EXPECT().CreateSecret(gomock.Any()).Return(nil, &secret.ErrSecretAlreadyExists{Name: "awskey"})
+
+		resp := request(router, http.MethodPost, "/v1/spaces/default/secrets", []byte(`{"name":"awskey","value":"s3cr3t"}`))
+
+		assert.Equal(t, http.StatusConflict, resp.Code)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		resp := request(router, http.MethodPost, "/v1/spaces/default/secrets", []byte(`{"name":"aws`))
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, _, _, _, _, secrets := setup(ctrl)
+
+	t.Run("secret returned", func(t *testing.T) {
+		secrets.EXPECT().GetSecret("default", secret.Name("awskey")).Return(&secret.Secret{Space: "default", Name: "awskey"}, nil)
+
+		resp := request(router, http.MethodGet, "/v1/spaces/default/secrets/awskey", nil)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"space":"default","name":"awskey"}`, resp.Body.String())
+	})
+
+	t.Run("secret not found", func(t *testing.T) {
+		secrets.EXPECT().GetSecret("default", secret.Name("awskey")).Return(nil, &secret.ErrSecretNotFound{Name: "awskey"})
+
+		resp := request(router, http.MethodGet, "/v1/spaces/default/secrets/awskey", nil)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
+func TestListSecrets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, _, _, _, _, secrets := setup(ctrl)
+
+	secrets.EXPECT().ListSecrets("default").Return(secret.Secrets{{Space: "default", Name: "awskey"}}, nil)
+
+	resp := request(router, http.MethodGet, "/v1/spaces/default/secrets", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, `{"secrets":[{"space":"default","name":"awskey"}]}`, resp.Body.String())
+}
+
+func TestDeleteSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, _, _, _, _, secrets := setup(ctrl)
+
+	t.Run("secret deleted", func(t *testing.T) {
+		secrets.EXPECT().DeleteSecret("default", secret.Name("awskey")).Return(nil)
+
+		resp := request(router, http.MethodDelete, "/v1/spaces/default/secrets/awskey", nil)
+
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+	})
+
+	t.Run("secret in use", func(t *testing.T) {
+		secrets.EXPECT().DeleteSecret("default", secret.Name("awskey")).
+			Return(&secret.ErrSecretInUse{Name: "awskey", FunctionID: "func"})
+
+		resp := request(router, http.MethodDelete, "/v1/spaces/default/secrets/awskey", nil)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, "Secret awskey cannot be deleted because is used by func function.", httpresp.Errors[0].Message)
+	})
+}
+
+func TestGetBlob(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "blobs")
+	defer os.RemoveAll(dir)
+	blobs, _ := blob.NewFileStore(dir, 0)
+	id, _ := blobs.Put("default", []byte("payload"))
+	router := httprouter.New()
+	api := &httpapi.HTTPAPI{Blobs: blobs}
+	api.RegisterRoutes(router)
+
+	t.Run("blob returned", func(t *testing.T) {
+		resp := request(router, http.MethodGet, "/v1/spaces/default/blobs/"+string(id), nil)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/octet-stream", resp.Header().Get("Content-Type"))
+		assert.Equal(t, "payload", resp.Body.String())
+	})
+
+	t.Run("blob not found", func(t *testing.T) {
+		resp := request(router, http.MethodGet, "/v1/spaces/default/blobs/0000", nil)
+
+		httpresp := &httpapi.Response{}
+		json.Unmarshal(resp.Body.Bytes(), httpresp)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, "blob not found", httpresp.Errors[0].Message)
+	})
+
+	t.Run("blob from other space not found", func(t *testing.T) {
+		resp := request(router, http.MethodGet, "/v1/spaces/other/blobs/"+string(id), nil)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
 func setup(ctrl *gomock.Controller) (
 	*httprouter.Router,
 	*mock.MockEventTypeService,
 	*mock.MockFunctionService,
 	*mock.MockSubscriptionService,
 	*mock.MockCORSService,
+	*mock.MockSchemaService,
+	*mock.MockSecretService,
 ) {
 	router := httprouter.New()
 	eventTypes := mock.NewMockEventTypeService(ctrl)
 	functions := mock.NewMockFunctionService(ctrl)
 	subscriptions := mock.NewMockSubscriptionService(ctrl)
 	cors := mock.NewMockCORSService(ctrl)
+	schemas := mock.NewMockSchemaService(ctrl)
+	secrets := mock.NewMockSecretService(ctrl)
 
 	httpapi := &httpapi.HTTPAPI{
 		EventTypes:    eventTypes,
 		Functions:     functions,
 		Subscriptions: subscriptions,
 		CORSes:        cors,
+		Schemas:       schemas,
+		Secrets:       secrets,
 	}
 	httpapi.RegisterRoutes(router)
 
-	return router, eventTypes, functions, subscriptions, cors
+	return router, eventTypes, functions, subscriptions, cors, schemas, secrets
 }
@@ -9,6 +9,8 @@ func init() {
 	prometheus.MustRegister(metricFunctions)
 	prometheus.MustRegister(metricSubscriptions)
 	prometheus.MustRegister(metricCORS)
+	prometheus.MustRegister(metricSchemas)
+	prometheus.MustRegister(metricSecrets)
 
 	prometheus.MustRegister(metricConfigRequests)
 	prometheus.MustRegister(metricConfigRequestDuration)
@@ -54,6 +56,26 @@ var metricCORS = prometheus.NewGaugeVec(
 		Help:      "Gauge of created CORS configurations count.",
 	}, []string{"space"})
 
+// Schemas
+
+var metricSchemas = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "eventgateway",
+		Subsystem: "schemas",
+		Name:      "total",
+		Help:      "Gauge of registered schemas count.",
+	}, []string{"space"})
+
+// Secrets
+
+var metricSecrets = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "eventgateway",
+		Subsystem: "secrets",
+		Name:      "total",
+		Help:      "Gauge of created secrets count.",
+	}, []string{"space"})
+
 // Config API
 
 var metricConfigRequests = prometheus.NewCounterVec(
@@ -3,7 +3,9 @@ package httpapi
 import (
 	"context"
 	"crypto/tls"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/serverless/event-gateway/internal/sync"
 	"go.uber.org/zap"
@@ -16,6 +18,13 @@ type ServerConfig struct {
 	TLSKey        *string
 	Port          uint
 	ShutdownGuard *sync.ShutdownGuard
+
+	// ReadTimeout, ReadHeaderTimeout, WriteTimeout and MaxHeaderBytes are passed to http.Server. Zero value means
+	// default.
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	MaxHeaderBytes    int
 }
 
 var tlsConf = &tls.Config{
@@ -34,6 +43,8 @@ var tlsConf = &tls.Config{
 type Server struct {
 	Config      ServerConfig
 	HTTPHandler *http.Server
+	// WrapListener is optional and wraps the TCP listener the server is served on.
+	WrapListener func(net.Listener) net.Listener
 }
 
 // Listen sets up a graceful shutdown mechanism and runs the http.Server.
@@ -43,17 +54,33 @@ func (s Server) Listen() {
 		s.HTTPHandler.Shutdown(context.Background())
 	}()
 
-	var err error
+	err := s.serve()
+	s.Config.Log.Error("HTTP server failed.", zap.Error(err))
+
+	s.Config.ShutdownGuard.InitiateShutdown()
+}
 
-	if *s.Config.TLSCrt != "" && *s.Config.TLSKey != "" {
+func (s Server) serve() error {
+	tlsEnabled := *s.Config.TLSCrt != "" && *s.Config.TLSKey != ""
+	if tlsEnabled {
 		s.HTTPHandler.TLSConfig = tlsConf
 		s.HTTPHandler.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+	}
 
-		err = s.HTTPHandler.ListenAndServeTLS(*s.Config.TLSCrt, *s.Config.TLSKey)
-	} else {
-		err = s.HTTPHandler.ListenAndServe()
+	if s.WrapListener == nil {
+		if tlsEnabled {
+			return s.HTTPHandler.ListenAndServeTLS(*s.Config.TLSCrt, *s.Config.TLSKey)
+		}
+		return s.HTTPHandler.ListenAndServe()
 	}
-	s.Config.Log.Error("HTTP server failed.", zap.Error(err))
 
-	s.Config.ShutdownGuard.InitiateShutdown()
+	listener, err := net.Listen("tcp", s.HTTPHandler.Addr)
+	if err != nil {
+		return err
+	}
+	listener = s.WrapListener(listener)
+	if tlsEnabled {
+		return s.HTTPHandler.ServeTLS(listener, *s.Config.TLSCrt, *s.Config.TLSKey)
+	}
+	return s.HTTPHandler.Serve(listener)
 }
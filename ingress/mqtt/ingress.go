@@ -0,0 +1,186 @@
+// Package mqtt implements MQTT ingress. It subscribes to MQTT topics and emits received messages as events.
+package mqtt
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/satori/go.uuid"
+	"go.uber.org/zap"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	ihttp "github.com/serverless/event-gateway/internal/http"
+)
+
+const connectTimeout = 10 * time.Second
+
+// Emitter passes events through the routing pipeline. It's implemented by router.Router.
+type Emitter interface {
+	Emit(event *eventpkg.Event, size int64, w http.ResponseWriter, r *http.Request)
+}
+
+// Config configures MQTT ingress.
+type Config struct {
+	// Broker is a broker URL e.g. "tcp://localhost:1883".
+	Broker   string
+	ClientID string
+	Username string
+	Password string
+	QoS      byte
+	// Group of shared subscriptions. Topics are subscribed as "$share/<group>/<filter>" so the broker delivers every
+	// message to only one of the clients (e.g. Event Gateway nodes) in the group. Empty disables shared subscriptions.
+	Group string
+	// Topics maps topic filters to event types. If event type is empty, it's created from message topic by replacing
+	// "/" with "." (e.g. "devices/1/status" becomes "devices.1.status").
+	Topics map[string]eventpkg.TypeName
+	// ContentType of messages payload.
+	ContentType string
+	// Path on which events are emitted.
+	Path string
+	Log  *zap.Logger
+}
+
+// Ingress subscribes to MQTT topic filters and emits every received message as an event.
+type Ingress struct {
+	config     Config
+	mimeType   string
+	emitter    Emitter
+	client     paho.Client
+	subscribed chan error
+}
+
+// New returns new MQTT ingress.
+func New(emitter Emitter, config Config) *Ingress {
+	if config.ClientID == "" {
+		config.ClientID = "event-gateway-" + uuid.NewV4().String()
+	}
+	if config.ContentType == "" {
+		config.ContentType = "application/json"
+	}
+	if config.Path == "" {
+		config.Path = "/"
+	}
+
+	// payload is normalized by media type without parameters the same way as for events received over HTTP
+	mimeType, _, err := mime.ParseMediaType(config.ContentType)
+	if err != nil {
+		mimeType = "application/octet-stream"
+	}
+
+	ingress := &Ingress{config: config, mimeType: mimeType, emitter: emitter, subscribed: make(chan error, 1)}
+	options := paho.NewClientOptions().
+		AddBroker(config.Broker).
+		SetClientID(config.ClientID).
+		SetUsername(config.Username).
+		SetPassword(config.Password).
+		SetConnectTimeout(connectTimeout).
+		SetAutoReconnect(true).
+		SetOnConnectHandler(ingress.onConnect).
+		SetConnectionLostHandler(func(client paho.Client, err error) {
+			config.Log.Info("Connection to MQTT broker lost.", zap.String("broker", config.Broker), zap.Error(err))
+		})
+	ingress.client = paho.NewClient(options)
+	return ingress
+}
+
+var errConnectTimeout = errors.New("connecting to MQTT broker timed out")
+
+// Start connects to the broker and waits until topics are subscribed. Topics are subscribed every time the connection
+// is (re-)established.
+func (i *Ingress) Start() error {
+	token := i.client.Connect()
+	if !token.WaitTimeout(connectTimeout) {
+		return errConnectTimeout
+	}
+	if token.Error() != nil {
+		return token.Error()
+	}
+
+	select {
+	case err := <-i.subscribed:
+		return err
+	case <-time.After(connectTimeout):
+		return errSubscribeTimeout
+	}
+}
+
+// Shutdown disconnects from the broker.
+func (i *Ingress) Shutdown() {
+	i.client.Disconnect(250)
+}
+
+var errSubscribeTimeout = errors.New("subscribing to MQTT topic timed out")
+
+func (i *Ingress) subscribe(client paho.Client) error {
+	for filter, eventType := range i.config.Topics {
+		eventType := eventType
+		if i.config.Group != "" {
+			filter = "$share/" + i.config.Group + "/" + filter
+		}
+		token := client.Subscribe(filter, i.config.QoS, func(client paho.Client, message paho.Message) {
+			i.handle(eventType, message)
+		})
+		if !token.WaitTimeout(connectTimeout) {
+			return errSubscribeTimeout
+		}
+		if token.Error() != nil {
+			return token.Error()
+		}
+		i.config.Log.Debug("Subscribed to MQTT topic.", zap.String("filter", filter))
+	}
+	return nil
+}
+
+func (i *Ingress) onConnect(client paho.Client) {
+	err := i.subscribe(client)
+	if err != nil {
+		i.config.Log.Error("Subscribing to MQTT topics failed.", zap.String("broker", i.config.Broker), zap.Error(err))
+	}
+
+	// Start waits for the result of the first subscription only.
+	select {
+	case i.subscribed <- err:
+	default:
+	}
+}
+
+func (i *Ingress) handle(eventType eventpkg.TypeName, message paho.Message) {
+	event := i.toEvent(eventType, message)
+
+	r := &http.Request{
+		Method:        http.MethodPost,
+		URL:           &url.URL{Path: i.config.Path},
+		Header:        http.Header{"Content-Type": []string{i.config.ContentType}},
+		ContentLength: int64(len(message.Payload())),
+	}
+	w := ihttp.NewResponseRecorder()
+	i.emitter.Emit(event, r.ContentLength, w, r)
+
+	if w.Code >= http.StatusBadRequest {
+		i.config.Log.Info("MQTT message rejected.",
+			zap.String("topic", message.Topic()),
+			zap.Int("status", w.Code),
+			zap.String("response", w.Body.String()))
+	}
+}
+
+// toEvent creates event from the message. MQTT message details are added to "mqtt" extension.
+func (i *Ingress) toEvent(eventType eventpkg.TypeName, message paho.Message) *eventpkg.Event {
+	if eventType == "" {
+		eventType = eventpkg.TypeName(strings.Replace(message.Topic(), "/", ".", -1))
+	}
+
+	event := eventpkg.New(eventType, i.mimeType, message.Payload())
+	event.Source = strings.TrimSuffix(i.config.Broker, "/") + "/" + message.Topic()
+	event.Extensions["mqtt"] = map[string]interface{}{
+		"topic":    message.Topic(),
+		"qos":      message.Qos(),
+		"retained": message.Retained(),
+	}
+	return event
+}
@@ -0,0 +1,146 @@
+// +build integration
+
+package mqtt_test
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/ingress/mqtt"
+)
+
+// TestIngress subscribes to MQTT broker (Mosquitto 1.6 or newer for shared subscriptions) running on MQTT_URL, by
+// default tcp://localhost:1883.
+func TestIngress(t *testing.T) {
+	url := brokerURL()
+	emitter := &testEmitter{events: make(chan emitted, 10)}
+	ingress := mqtt.New(emitter, mqtt.Config{
+		Broker: url,
+		QoS:    1,
+		Group:  "event-gateway",
+		Topics: map[string]eventpkg.TypeName{
+			"devices/+/telemetry": "device.telemetry",
+			"alerts/#":            "",
+		},
+		ContentType: "application/json; charset=utf-8",
+		Log:         zap.NewNop(),
+	})
+	err := ingress.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ingress.Shutdown()
+	publisher := connect(t, url)
+	defer publisher.Disconnect(250)
+
+	t.Run("message emitted as event of mapped type", func(t *testing.T) {
+		publish(t, publisher, "devices/1/telemetry", []byte(`{"temperature":21}`))
+
+		result := receive(t, emitter)
+
+		assert.Equal(t, eventpkg.TypeName("device.telemetry"), result.event.EventType)
+		assert.Equal(t, url+"/devices/1/telemetry", result.event.Source)
+		assert.Equal(t, "application/json", result.event.ContentType)
+		assert.Equal(t, map[string]interface{}{"temperature": float64(21)}, result.event.Data)
+		assert.Equal(t, map[string]interface{}{"topic": "devices/1/telemetry", "qos": byte(1), "retained": false},
+			result.event.Extensions["mqtt"])
+		assert.Equal(t, "/", result.path)
+		assert.Equal(t, int64(18), result.size)
+	})
+
+	t.Run("event type created from topic if not mapped", func(t *testing.T) {
+		publish(t, publisher, "alerts/fire", []byte(`{}`))
+
+		result := receive(t, emitter)
+
+		assert.Equal(t, eventpkg.TypeName("alerts.fire"), result.event.EventType)
+	})
+}
+
+func TestIngress_SharedSubscription(t *testing.T) {
+	url := brokerURL()
+	emitters := []*testEmitter{}
+	for i := 0; i < 2; i++ {
+		emitter := &testEmitter{events: make(chan emitted, 10)}
+		ingress := mqtt.New(emitter, mqtt.Config{
+			Broker: url,
+			Group:  "event-gateway",
+			Topics: map[string]eventpkg.TypeName{"devices/+/status": "device.status"},
+			Log:    zap.NewNop(),
+		})
+		err := ingress.Start()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ingress.Shutdown()
+		emitters = append(emitters, emitter)
+	}
+	publisher := connect(t, url)
+	defer publisher.Disconnect(250)
+
+	for i := 0; i < 10; i++ {
+		publish(t, publisher, "devices/1/status", []byte(`{}`))
+	}
+
+	// every message is emitted exactly once and the broker balances messages across the group
+	time.Sleep(500 * time.Millisecond)
+	assert.Equal(t, 10, len(emitters[0].events)+len(emitters[1].events))
+	assert.NotEmpty(t, emitters[0].events)
+	assert.NotEmpty(t, emitters[1].events)
+}
+
+func brokerURL() string {
+	url := os.Getenv("MQTT_URL")
+	if url == "" {
+		url = "tcp://localhost:1883"
+	}
+	return url
+}
+
+func connect(t *testing.T, url string) paho.Client {
+	client := paho.NewClient(paho.NewClientOptions().AddBroker(url))
+	token := client.Connect()
+	if token.WaitTimeout(5*time.Second) && token.Error() != nil {
+		t.Fatal(token.Error())
+	}
+	return client
+}
+
+func publish(t *testing.T, client paho.Client, topic string, payload []byte) {
+	token := client.Publish(topic, 1, false, payload)
+	if token.WaitTimeout(5*time.Second) && token.Error() != nil {
+		t.Fatal(token.Error())
+	}
+}
+
+func receive(t *testing.T, emitter *testEmitter) emitted {
+	select {
+	case result := <-emitter.events:
+		return result
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not emitted")
+		return emitted{}
+	}
+}
+
+type emitted struct {
+	event *eventpkg.Event
+	size  int64
+	path  string
+}
+
+type testEmitter struct {
+	events chan emitted
+}
+
+func (e *testEmitter) Emit(event *eventpkg.Event, size int64, w http.ResponseWriter, r *http.Request) {
+	e.events <- emitted{event: event, size: size, path: r.URL.Path}
+	w.WriteHeader(http.StatusAccepted)
+}
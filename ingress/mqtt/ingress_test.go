@@ -0,0 +1,71 @@
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestToEvent(t *testing.T) {
+	for _, testCase := range toEventTests {
+		ingress := New(nil, Config{Broker: "tcp://localhost:1883", ContentType: testCase.contentType, Log: zap.NewNop()})
+
+		event := ingress.toEvent("device.telemetry", testMessage{topic: "devices/1/telemetry", payload: testCase.payload})
+
+		assert.Equal(t, testCase.expectedContentType, event.ContentType)
+		assert.Equal(t, testCase.expectedData, event.Data)
+		assert.Equal(t, "tcp://localhost:1883/devices/1/telemetry", event.Source)
+	}
+}
+
+var toEventTests = []struct {
+	contentType         string
+	payload             []byte
+	expectedContentType string
+	expectedData        interface{}
+}{
+	{
+		"",
+		[]byte(`{"temperature":21}`),
+		"application/json",
+		map[string]interface{}{"temperature": float64(21)},
+	},
+	{
+		"application/json; charset=utf-8",
+		[]byte(`{"temperature":21}`),
+		"application/json",
+		map[string]interface{}{"temperature": float64(21)},
+	},
+	{
+		"application/vnd.device+json",
+		[]byte(`{"temperature":21}`),
+		"application/vnd.device+json",
+		map[string]interface{}{"temperature": float64(21)},
+	},
+	{
+		"text/plain",
+		[]byte("21"),
+		"text/plain",
+		[]byte("21"),
+	},
+	{
+		"application/json; charset",
+		[]byte(`{"temperature":21}`),
+		"application/octet-stream",
+		[]byte(`{"temperature":21}`),
+	},
+}
+
+type testMessage struct {
+	topic   string
+	payload []byte
+}
+
+func (m testMessage) Duplicate() bool   { return false }
+func (m testMessage) Qos() byte         { return 0 }
+func (m testMessage) Retained() bool    { return false }
+func (m testMessage) Topic() string     { return m.topic }
+func (m testMessage) MessageID() uint16 { return 0 }
+func (m testMessage) Payload() []byte   { return m.payload }
+func (m testMessage) Ack()              {}
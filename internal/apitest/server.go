@@ -0,0 +1,62 @@
+// Package apitest provides a base for in-process stand-ins of cloud provider HTTP APIs used in provider tests.
+package apitest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+)
+
+// Server is an HTTP test server with a mux for API endpoints and functions called by tests. It counts requests to
+// the token endpoint so tests can check that tokens are cached.
+type Server struct {
+	URL string
+
+	server        *httptest.Server
+	mux           *http.ServeMux
+	tokenRequests int32
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	return &Server{URL: server.URL, server: server, mux: mux}
+}
+
+// HandleFunc registers handler for the given pattern e.g. to stand in for a function.
+func (s *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// TokenEndpoint wraps handler of the token endpoint so its requests are counted.
+func (s *Server) TokenEndpoint(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.tokenRequests, 1)
+		handler(w, r)
+	}
+}
+
+// TokenRequests returns number of requests to the token endpoint.
+func (s *Server) TokenRequests() int {
+	return int(atomic.LoadInt32(&s.tokenRequests))
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// BearerToken returns bearer token from Authorization header of the request.
+func BearerToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+// WriteJSON writes body encoded as JSON with given status.
+func WriteJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,193 @@
+// Package azuretest provides an in-process stand-in for Azure APIs used by Azure providers. It issues Azure AD access
+// tokens with client credentials flow and implements Service Bus send message REST endpoint.
+package azuretest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/serverless/event-gateway/internal/apitest"
+)
+
+// Message is a message sent to Service Bus queue or topic.
+type Message struct {
+	Body             []byte
+	ContentType      string
+	BrokerProperties map[string]interface{}
+	// Properties contains custom properties as sent in HTTP headers. Names are canonicalized and string values are
+	// quoted.
+	Properties map[string]string
+}
+
+// Server is a stand-in for Azure AD token endpoint and Service Bus REST API.
+type Server struct {
+	*apitest.Server
+
+	mutex        sync.Mutex
+	applications map[string]string
+	tokens       map[string]string
+	keys         map[string]string
+	entities     map[string][]Message
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Server:       apitest.NewServer(),
+		applications: map[string]string{},
+		tokens:       map[string]string{},
+		keys:         map[string]string{},
+		entities:     map[string][]Message{},
+	}
+	s.HandleFunc("/", s.handle)
+	return s
+}
+
+// AddApplication registers Azure AD application that can get tokens from the tenant.
+func (s *Server) AddApplication(tenantID, clientID, clientSecret string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.applications[tenantID+"/"+clientID] = clientSecret
+}
+
+// Scope returns scope of the access token that authenticated the request. It returns false if the request doesn't
+// carry access token issued by the server.
+func (s *Server) Scope(r *http.Request) (string, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	scope, ok := s.tokens[apitest.BearerToken(r)]
+	return scope, ok
+}
+
+// AddSharedAccessKey registers Service Bus shared access key.
+func (s *Server) AddSharedAccessKey(name, key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.keys[name] = key
+}
+
+// CreateEntity creates Service Bus queue or topic.
+func (s *Server) CreateEntity(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.entities[name] = []Message{}
+}
+
+// Messages returns messages sent to the queue or topic.
+func (s *Server) Messages(entity string) []Message {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Message(nil), s.entities[entity]...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/oauth2/v2.0/token"):
+		s.TokenEndpoint(s.token)(w, r)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/messages"):
+		s.send(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// token implements client credentials grant.
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tenantID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), "/oauth2/v2.0/token")
+	secret, ok := s.applications[tenantID+"/"+r.PostFormValue("client_id")]
+	if !ok || secret != r.PostFormValue("client_secret") || r.PostFormValue("grant_type") != "client_credentials" {
+		apitest.WriteJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	token := "access-token-" + strconv.Itoa(len(s.tokens)+1)
+	s.tokens[token] = r.PostFormValue("scope")
+	apitest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+var standardHeaders = map[string]bool{
+	"Accept-Encoding":   true,
+	"Authorization":     true,
+	"Brokerproperties":  true,
+	"Content-Length":    true,
+	"Content-Type":      true,
+	"User-Agent":        true,
+	"X-Forwarded-For":   true,
+	"Transfer-Encoding": true,
+}
+
+// send implements Service Bus send message method.
+func (s *Server) send(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.authorized(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	entity := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), "/messages")
+	messages, ok := s.entities[entity]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	msg := Message{Body: body, ContentType: r.Header.Get("Content-Type"), Properties: map[string]string{}}
+	if brokerProperties := r.Header.Get("BrokerProperties"); brokerProperties != "" {
+		if err := json.Unmarshal([]byte(brokerProperties), &msg.BrokerProperties); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	for name := range r.Header {
+		if !standardHeaders[name] {
+			msg.Properties[name] = r.Header.Get(name)
+		}
+	}
+
+	s.entities[entity] = append(messages, msg)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// authorized verifies shared access signature of the request.
+func (s *Server) authorized(r *http.Request) bool {
+	token := r.Header.Get("Authorization")
+	if !strings.HasPrefix(token, "SharedAccessSignature ") {
+		return false
+	}
+	params, err := url.ParseQuery(strings.TrimPrefix(token, "SharedAccessSignature "))
+	if err != nil {
+		return false
+	}
+
+	key, ok := s.keys[params.Get("skn")]
+	if !ok {
+		return false
+	}
+	expiry, err := strconv.ParseInt(params.Get("se"), 10, 64)
+	if err != nil || time.Unix(expiry, 0).Before(time.Now()) {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(url.QueryEscape(params.Get("sr")) + "\n" + params.Get("se")))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)) == params.Get("sig")
+}
@@ -0,0 +1,151 @@
+package blob
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/satori/go.uuid"
+)
+
+// ID uniquely identifies a blob.
+type ID string
+
+// Store stores event payloads offloaded from events (claim check pattern). Blobs are scoped to a space.
+type Store interface {
+	Put(space string, data []byte) (ID, error)
+	Get(space string, id ID) ([]byte, error)
+}
+
+// ErrBlobNotFound occurs when blob cannot be found.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// ErrInvalidSpace occurs when space name cannot be used as a blob namespace.
+var ErrInvalidSpace = errors.New("invalid space name")
+
+var (
+	blobIDPattern = regexp.MustCompile(`^[a-f0-9\-]+$`)
+	spacePattern  = regexp.MustCompile(`^[a-zA-Z0-9\.\-_]+$`)
+)
+
+// FileStore is a Store keeping blobs as files in a local directory. Every space has its own subdirectory.
+type FileStore struct {
+	dir string
+	ttl time.Duration
+}
+
+// NewFileStore returns FileStore using dir as a storage. The directory is created if it doesn't exist. Blobs older
+// than ttl are not returned and are removed by Collect. 0 means blobs never expire.
+func NewFileStore(dir string, ttl time.Duration) (*FileStore, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileStore{dir: dir, ttl: ttl}, nil
+}
+
+// Put stores data and returns ID of created blob.
+func (s *FileStore) Put(space string, data []byte) (ID, error) {
+	if !isValidSpace(space) {
+		return "", ErrInvalidSpace
+	}
+
+	err := os.MkdirAll(filepath.Join(s.dir, space), 0700)
+	if err != nil {
+		return "", err
+	}
+
+	id := ID(uuid.NewV4().String())
+	err = ioutil.WriteFile(s.path(space, id), data, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// Get returns data of the blob.
+func (s *FileStore) Get(space string, id ID) ([]byte, error) {
+	if !isValidSpace(space) || !blobIDPattern.MatchString(string(id)) {
+		return nil, ErrBlobNotFound
+	}
+
+	info, err := os.Stat(s.path(space, id))
+	if os.IsNotExist(err) || (err == nil && s.expired(info)) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(s.path(space, id))
+	if os.IsNotExist(err) {
+		return nil, ErrBlobNotFound
+	}
+
+	return data, err
+}
+
+// Collect removes expired blobs.
+func (s *FileStore) Collect() error {
+	if s.ttl <= 0 {
+		return nil
+	}
+
+	return filepath.Walk(s.dir, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() && s.expired(info) {
+			err = os.Remove(path)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// StartCollector removes expired blobs every interval until shutdown channel is closed. Errors are passed to
+// onError.
+func (s *FileStore) StartCollector(interval time.Duration, shutdown <-chan struct{}, onError func(error)) {
+	if s.ttl <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.Collect(); err != nil {
+					onError(err)
+				}
+			case <-shutdown:
+				return
+			}
+		}
+	}()
+}
+
+func (s *FileStore) expired(info os.FileInfo) bool {
+	return s.ttl > 0 && time.Since(info.ModTime()) > s.ttl
+}
+
+func (s *FileStore) path(space string, id ID) string {
+	return filepath.Join(s.dir, space, string(id))
+}
+
+func isValidSpace(space string) bool {
+	return spacePattern.MatchString(space) && space != "." && space != ".."
+}
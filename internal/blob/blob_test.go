@@ -0,0 +1,82 @@
+package blob_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/serverless/event-gateway/internal/blob"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "blobs")
+	defer os.RemoveAll(dir)
+	store, err := blob.NewFileStore(dir, 0)
+	assert.Nil(t, err)
+
+	t.Run("stored blob returned", func(t *testing.T) {
+		id, err := store.Put("default", []byte("payload"))
+		assert.Nil(t, err)
+
+		data, err := store.Get("default", id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("payload"), data)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := store.Get("default", blob.ID("a4a6d3b8-0000-0000-0000-000000000000"))
+
+		assert.Equal(t, blob.ErrBlobNotFound, err)
+	})
+
+	t.Run("blob from other space not found", func(t *testing.T) {
+		id, _ := store.Put("default", []byte("payload"))
+
+		_, err := store.Get("other", id)
+
+		assert.Equal(t, blob.ErrBlobNotFound, err)
+	})
+
+	t.Run("path outside of store is rejected", func(t *testing.T) {
+		_, err := store.Get("default", blob.ID("../passwd"))
+
+		assert.Equal(t, blob.ErrBlobNotFound, err)
+	})
+
+	t.Run("invalid space is rejected", func(t *testing.T) {
+		_, err := store.Put("..", []byte("payload"))
+
+		assert.Equal(t, blob.ErrInvalidSpace, err)
+	})
+}
+
+func TestFileStore_Expiration(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "blobs")
+	defer os.RemoveAll(dir)
+	store, _ := blob.NewFileStore(dir, time.Hour)
+	expired, _ := store.Put("default", []byte("expired"))
+	fresh, _ := store.Put("default", []byte("fresh"))
+	past := time.Now().Add(-2 * time.Hour)
+	os.Chtimes(filepath.Join(dir, "default", string(expired)), past, past)
+
+	t.Run("expired blob not found", func(t *testing.T) {
+		_, err := store.Get("default", expired)
+
+		assert.Equal(t, blob.ErrBlobNotFound, err)
+	})
+
+	t.Run("expired blobs collected", func(t *testing.T) {
+		err := store.Collect()
+		assert.Nil(t, err)
+
+		_, err = os.Stat(filepath.Join(dir, "default", string(expired)))
+		assert.True(t, os.IsNotExist(err))
+		data, err := store.Get("default", fresh)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("fresh"), data)
+	})
+}
@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/serverless/event-gateway/libkv"
+	"github.com/serverless/event-gateway/schema"
+	"go.uber.org/zap"
+)
+
+type schemaCache struct {
+	sync.RWMutex
+	cache map[libkv.SchemaKey]*schema.Schema
+	log   *zap.Logger
+}
+
+func newSchemaCache(log *zap.Logger) *schemaCache {
+	return &schemaCache{
+		cache: map[libkv.SchemaKey]*schema.Schema{},
+		log:   log,
+	}
+}
+
+func (c *schemaCache) Modified(k string, v []byte) {
+	s := &schema.Schema{}
+	err := json.NewDecoder(bytes.NewReader(v)).Decode(s)
+	if err != nil {
+		c.log.Error("Could not deserialize Schema state.", zap.Error(err), zap.String("key", k), zap.String("value", string(v)))
+		return
+	}
+
+	err = s.Compile()
+	if err != nil {
+		c.log.Error("Could not compile Schema.", zap.Error(err), zap.String("key", k), zap.Object("value", s))
+		return
+	}
+
+	c.log.Debug("Schema local cache received value update.", zap.String("key", k), zap.Object("value", s))
+
+	c.Lock()
+	defer c.Unlock()
+	segments := strings.Split(k, "/")
+	c.cache[libkv.SchemaKey{Space: segments[0], ID: schema.ID(segments[1])}] = s
+}
+
+func (c *schemaCache) Deleted(k string, v []byte) {
+	c.Lock()
+	defer c.Unlock()
+	segments := strings.Split(k, "/")
+	delete(c.cache, libkv.SchemaKey{Space: segments[0], ID: schema.ID(segments[1])})
+}
@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/serverless/event-gateway/libkv"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/stretchr/testify/assert"
+
+	"go.uber.org/zap"
+)
+
+func TestSchemaCacheModified(t *testing.T) {
+	t.Run("added and compiled", func(t *testing.T) {
+		schemasCache := newSchemaCache(zap.NewNop())
+
+		schemasCache.Modified("default/user", []byte(`{"schemaId":"user","space":"default","format":"avro","definition":"\"string\""}`))
+
+		s := schemasCache.cache[libkv.SchemaKey{Space: "default", ID: "user"}]
+		data, err := s.Decode([]byte{0x02, 'a'})
+		assert.Nil(t, err)
+		assert.Equal(t, "a", data)
+	})
+
+	t.Run("invalid definition", func(t *testing.T) {
+		schemasCache := newSchemaCache(zap.NewNop())
+
+		schemasCache.Modified("default/user", []byte(`{"schemaId":"user","space":"default","format":"avro","definition":"{"}`))
+
+		assert.Equal(t, map[libkv.SchemaKey]*schema.Schema{}, schemasCache.cache)
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		schemasCache := newSchemaCache(zap.NewNop())
+
+		schemasCache.Modified("default/user", []byte(`{"schemaId":"user","space":"default","format":"avro","definition":"\"string\""}`))
+		schemasCache.Deleted("default/user", nil)
+
+		assert.Equal(t, map[libkv.SchemaKey]*schema.Schema{}, schemasCache.cache)
+	})
+}
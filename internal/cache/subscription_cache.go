@@ -3,6 +3,7 @@ package cache
 import (
 	"bytes"
 	"encoding/json"
+	"reflect"
 	"sync"
 
 	eventpkg "github.com/serverless/event-gateway/event"
@@ -12,20 +13,31 @@ import (
 	"go.uber.org/zap"
 )
 
+// subscriber is a function key (space + function ID) with subscription specific delivery settings.
+type subscriber struct {
+	libkv.FunctionKey
+	PayloadMode subscription.PayloadMode
+	Targets     []subscription.Target
+	Sticky      *subscription.Sticky
+}
+
 type subscriptionCache struct {
 	sync.RWMutex
-	// async maps method, path and event type to function key (space + function ID) (async subscriptions)
-	async map[string]map[string]map[eventpkg.TypeName][]libkv.FunctionKey
+	// async maps method, path and event type to subscribers (async subscriptions)
+	async map[string]map[string]map[eventpkg.TypeName][]subscriber
 	// sync maps method and event type to internal/pathtree (sync subscriptions)
 	sync map[string]map[eventpkg.TypeName]*pathtree.Node
-	log  *zap.Logger
+	// subscriptions maps keys to subscriptions currently in the cache. Used for replacing modified subscriptions.
+	subscriptions map[string]subscription.Subscription
+	log           *zap.Logger
 }
 
 func newSubscriptionCache(log *zap.Logger) *subscriptionCache {
 	return &subscriptionCache{
-		async: map[string]map[string]map[eventpkg.TypeName][]libkv.FunctionKey{},
-		sync:  map[string]map[eventpkg.TypeName]*pathtree.Node{},
-		log:   log,
+		async:         map[string]map[string]map[eventpkg.TypeName][]subscriber{},
+		sync:          map[string]map[eventpkg.TypeName]*pathtree.Node{},
+		subscriptions: map[string]subscription.Subscription{},
+		log:           log,
 	}
 }
 
@@ -41,7 +53,22 @@ func (c *subscriptionCache) Modified(k string, v []byte) {
 
 	c.Lock()
 	defer c.Unlock()
-	key := libkv.FunctionKey{Space: s.Space, ID: s.FunctionID}
+
+	// replace previous version of the subscription e.g. when it was retargeted to a different function
+	if old, exists := c.subscriptions[k]; exists {
+		if reflect.DeepEqual(old, s) {
+			return
+		}
+		c.remove(old)
+	}
+	c.subscriptions[k] = s
+
+	key := subscriber{
+		FunctionKey: libkv.FunctionKey{Space: s.Space, ID: s.FunctionID},
+		PayloadMode: s.PayloadMode,
+		Targets:     s.Targets,
+		Sticky:      s.Sticky,
+	}
 
 	if s.Type == subscription.TypeSync {
 		c.ensureSyncMethod(s.Method)
@@ -50,7 +77,7 @@ func (c *subscriptionCache) Modified(k string, v []byte) {
 			root = pathtree.NewNode()
 			c.sync[s.Method][s.EventType] = root
 		}
-		err := root.AddRoute(s.Path, libkv.FunctionKey{Space: s.Space, ID: s.FunctionID})
+		err := root.AddRoute(s.Path, key)
 		if err != nil {
 			c.log.Error("Could not add path to the tree.", zap.Error(err), zap.String("path", s.Path), zap.String("method", s.Method), zap.String("eventType", string(s.EventType)))
 		}
@@ -60,7 +87,7 @@ func (c *subscriptionCache) Modified(k string, v []byte) {
 		if exists {
 			ids = append(ids, key)
 		} else {
-			ids = []libkv.FunctionKey{key}
+			ids = []subscriber{key}
 		}
 		c.async[s.Method][s.Path][s.EventType] = ids
 	}
@@ -77,22 +104,27 @@ func (c *subscriptionCache) Deleted(k string, v []byte) {
 		return
 	}
 
-	if oldSub.Type == subscription.TypeSync {
-		c.deleteEndpoint(oldSub)
+	delete(c.subscriptions, k)
+	c.remove(oldSub)
+}
+
+func (c *subscriptionCache) remove(sub subscription.Subscription) {
+	if sub.Type == subscription.TypeSync {
+		c.deleteEndpoint(sub)
 	} else {
-		c.deleteSubscription(oldSub)
+		c.deleteSubscription(sub)
 	}
 }
 
 func (c *subscriptionCache) ensureAsyncMethodPath(method, path string) {
 	_, exists := c.async[method]
 	if !exists {
-		c.async[method] = map[string]map[eventpkg.TypeName][]libkv.FunctionKey{}
+		c.async[method] = map[string]map[eventpkg.TypeName][]subscriber{}
 	}
 
 	_, exists = c.async[method][path]
 	if !exists {
-		c.async[method][path] = map[eventpkg.TypeName][]libkv.FunctionKey{}
+		c.async[method][path] = map[eventpkg.TypeName][]subscriber{}
 	}
 }
 
@@ -119,7 +151,7 @@ func (c *subscriptionCache) deleteSubscription(sub subscription.Subscription) {
 	if exists {
 		for i, id := range ids {
 			key := libkv.FunctionKey{Space: sub.Space, ID: sub.FunctionID}
-			if id == key {
+			if id.FunctionKey == key {
 				ids = append(ids[:i], ids[i+1:]...)
 				break
 			}
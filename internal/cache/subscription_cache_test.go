@@ -6,6 +6,7 @@ import (
 	eventpkg "github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/libkv"
+	"github.com/serverless/event-gateway/subscription"
 	"github.com/stretchr/testify/assert"
 
 	"go.uber.org/zap"
@@ -33,13 +34,35 @@ func TestSubscriptionCacheModified(t *testing.T) {
 		"method": "GET",
 		"path": "/"}`))
 
-		expected := []libkv.FunctionKey{
-			libkv.FunctionKey{Space: "space1", ID: "testfunc1"},
-			libkv.FunctionKey{Space: "space1", ID: "testfunc2"},
+		expected := []subscriber{
+			{FunctionKey: libkv.FunctionKey{Space: "space1", ID: "testfunc1"}},
+			{FunctionKey: libkv.FunctionKey{Space: "space1", ID: "testfunc2"}},
 		}
 		assert.Equal(t, expected, scache.async["GET"]["/"]["test.event"])
 	})
 
+	t.Run("async added with targets", func(t *testing.T) {
+		scache := newSubscriptionCache(zap.NewNop())
+
+		scache.Modified("testsub1", []byte(`{
+		"subscriptionId":"testsub1",
+		"space": "space1",
+		"type": "async",
+		"eventType": "test.event",
+		"functionId": "testfunc1",
+		"targets": [{"functionId": "testfunc2", "weight": 10}],
+		"sticky": {"attribute": "source"},
+		"method": "GET",
+		"path": "/"}`))
+
+		expected := []subscriber{{
+			FunctionKey: libkv.FunctionKey{Space: "space1", ID: "testfunc1"},
+			Targets:     []subscription.Target{{FunctionID: "testfunc2", Weight: 10}},
+			Sticky:      &subscription.Sticky{Attribute: "source"},
+		}}
+		assert.Equal(t, expected, scache.async["GET"]["/"]["test.event"])
+	})
+
 	t.Run("sync added", func(t *testing.T) {
 		scache := newSubscriptionCache(zap.NewNop())
 
@@ -61,17 +84,74 @@ func TestSubscriptionCacheModified(t *testing.T) {
 		"method": "GET"}`))
 
 		value, _ := scache.sync["GET"][eventpkg.TypeHTTPRequest].Resolve("/a")
-		key := value.(libkv.FunctionKey)
+		key := value.(subscriber)
 		assert.Equal(t, function.ID("testfunc1"), key.ID)
 		assert.Equal(t, "default", key.Space)
 	})
 
+	t.Run("async retargeted", func(t *testing.T) {
+		scache := newSubscriptionCache(zap.NewNop())
+
+		scache.Modified("testsub1", []byte(`{
+		"subscriptionId":"testsub1",
+		"space": "space1",
+		"type": "async",
+		"eventType": "test.event",
+		"functionId": "testfunc1",
+		"method": "GET",
+		"path": "/"}`))
+		scache.Modified("testsub1", []byte(`{
+		"subscriptionId":"testsub1",
+		"space": "space1",
+		"type": "async",
+		"eventType": "test.event",
+		"functionId": "testfunc2",
+		"method": "GET",
+		"path": "/"}`))
+		scache.Modified("testsub1", []byte(`{
+		"subscriptionId":"testsub1",
+		"space": "space1",
+		"type": "async",
+		"eventType": "test.event",
+		"functionId": "testfunc2",
+		"method": "GET",
+		"path": "/"}`))
+
+		expected := []subscriber{{FunctionKey: libkv.FunctionKey{Space: "space1", ID: "testfunc2"}}}
+		assert.Equal(t, expected, scache.async["GET"]["/"]["test.event"])
+	})
+
+	t.Run("sync retargeted", func(t *testing.T) {
+		scache := newSubscriptionCache(zap.NewNop())
+
+		scache.Modified("testsub1", []byte(`{
+		"subscriptionId":"testsub1",
+		"type":"sync",
+		"space": "default",
+		"eventType": "http.request",
+		"functionId": "testfunc1",
+		"path": "/a",
+		"method": "GET"}`))
+		scache.Modified("testsub1", []byte(`{
+		"subscriptionId":"testsub1",
+		"type":"sync",
+		"space": "default",
+		"eventType": "http.request",
+		"functionId": "testfunc2",
+		"path": "/a",
+		"method": "GET"}`))
+
+		value, _ := scache.sync["GET"][eventpkg.TypeHTTPRequest].Resolve("/a")
+		key := value.(subscriber)
+		assert.Equal(t, function.ID("testfunc2"), key.ID)
+	})
+
 	t.Run("wrong payload", func(t *testing.T) {
 		scache := newSubscriptionCache(zap.NewNop())
 
 		scache.Modified("testsub", []byte(`not json`))
 
-		assert.Equal(t, []libkv.FunctionKey(nil), scache.async["POST"]["/"]["test.event"])
+		assert.Equal(t, []subscriber(nil), scache.async["POST"]["/"]["test.event"])
 	})
 
 	t.Run("async deleted", func(t *testing.T) {
@@ -102,7 +182,7 @@ func TestSubscriptionCacheModified(t *testing.T) {
 			"method": "POST",
 			"path": "/"}`))
 
-		assert.Equal(t, []libkv.FunctionKey{{Space: "space1", ID: function.ID("testfunc2")}}, scache.async["POST"]["/"]["test.event"])
+		assert.Equal(t, []subscriber{{FunctionKey: libkv.FunctionKey{Space: "space1", ID: function.ID("testfunc2")}}}, scache.async["POST"]["/"]["test.event"])
 	})
 
 	t.Run("sync deleted", func(t *testing.T) {
@@ -147,6 +227,6 @@ func TestSubscriptionCacheModified(t *testing.T) {
 			"method": "POST",
 			"path": "/"}`))
 
-		assert.Equal(t, []libkv.FunctionKey(nil), scache.async["POST"]["/"]["test.event"])
+		assert.Equal(t, []subscriber(nil), scache.async["POST"]["/"]["test.event"])
 	})
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/libkv"
 	"github.com/serverless/event-gateway/router"
+	"github.com/serverless/event-gateway/schema"
 	"github.com/serverless/event-gateway/subscription/cors"
 )
 
@@ -22,6 +23,7 @@ type Target struct {
 	functionCache     *functionCache
 	subscriptionCache *subscriptionCache
 	corsCache         *corsCache
+	schemaCache       *schemaCache
 }
 
 // EventType takes a event type name and returns a deserialized instance of event type, if it exists
@@ -47,11 +49,14 @@ func (tc *Target) SyncSubscriber(method, path string, eventType eventpkg.TypeNam
 		return nil
 	}
 
-	key := value.(libkv.FunctionKey)
+	key := value.(subscriber)
 	return &router.SyncSubscriber{
-		Space:      key.Space,
-		FunctionID: key.ID,
-		Params:     params,
+		Space:       key.Space,
+		FunctionID:  key.ID,
+		Params:      params,
+		PayloadMode: key.PayloadMode,
+		Targets:     key.Targets,
+		Sticky:      key.Sticky,
 	}
 }
 
@@ -71,13 +76,44 @@ func (tc *Target) AsyncSubscribers(method, path string, eventType eventpkg.TypeN
 	subscribers := []router.AsyncSubscriber{}
 	for _, key := range keys {
 		subscribers = append(subscribers, router.AsyncSubscriber{
-			Space:      key.Space,
-			FunctionID: key.ID,
+			Space:       key.Space,
+			FunctionID:  key.ID,
+			PayloadMode: key.PayloadMode,
+			Targets:     key.Targets,
+			Sticky:      key.Sticky,
 		})
 	}
 	return subscribers
 }
 
+// Spaces returns spaces of sync and async subscriptions matching method and path regardless of event type.
+func (tc *Target) Spaces(method, path string) []string {
+	tc.subscriptionCache.RLock()
+	defer tc.subscriptionCache.RUnlock()
+
+	spaces := []string{}
+	seen := map[string]bool{}
+	add := func(space string) {
+		if !seen[space] {
+			seen[space] = true
+			spaces = append(spaces, space)
+		}
+	}
+
+	for _, root := range tc.subscriptionCache.sync[method] {
+		value, _ := root.Resolve(path)
+		if value != nil {
+			add(value.(subscriber).Space)
+		}
+	}
+	for _, keys := range tc.subscriptionCache.async[method][path] {
+		for _, key := range keys {
+			add(key.Space)
+		}
+	}
+	return spaces
+}
+
 // CORS returns CORS configuration for method and path pair
 func (tc *Target) CORS(method, path string) *cors.CORS {
 	tc.corsCache.RLock()
@@ -97,6 +133,13 @@ func (tc *Target) CORS(method, path string) *cors.CORS {
 	return &config
 }
 
+// Schema takes a schema ID and returns compiled schema, if it exists
+func (tc *Target) Schema(space string, id schema.ID) *schema.Schema {
+	tc.schemaCache.RLock()
+	defer tc.schemaCache.RUnlock()
+	return tc.schemaCache.cache[libkv.SchemaKey{Space: space, ID: id}]
+}
+
 // Shutdown causes all state watchers to clean up their state.
 func (tc *Target) Shutdown() {
 	close(tc.shutdown)
@@ -113,6 +156,7 @@ func NewTarget(path string, kvstore store.Store, log *zap.Logger) *Target {
 	functionPathWatcher := NewWatcher(path+"functions", kvstore, log)
 	subscriptionPathWatcher := NewWatcher(path+"subscriptions", kvstore, log)
 	corsPathWatcher := NewWatcher(path+"cors", kvstore, log)
+	schemaPathWatcher := NewWatcher(path+"schemas", kvstore, log)
 
 	// serves lookups for event types
 	eventTypeCache := newEventTypeCache(log)
@@ -122,6 +166,8 @@ func NewTarget(path string, kvstore store.Store, log *zap.Logger) *Target {
 	subscriptionCache := newSubscriptionCache(log)
 	// serves lookups for cors configuration
 	corsCache := newCORSCache(log)
+	// serves lookups for compiled schemas
+	schemaCache := newSchemaCache(log)
 
 	// start reacting to changes
 	shutdown := make(chan struct{})
@@ -129,6 +175,7 @@ func NewTarget(path string, kvstore store.Store, log *zap.Logger) *Target {
 	functionPathWatcher.React(functionCache, shutdown)
 	subscriptionPathWatcher.React(subscriptionCache, shutdown)
 	corsPathWatcher.React(corsCache, shutdown)
+	schemaPathWatcher.React(schemaCache, shutdown)
 
 	return &Target{
 		log:               log,
@@ -137,5 +184,6 @@ func NewTarget(path string, kvstore store.Store, log *zap.Logger) *Target {
 		functionCache:     functionCache,
 		subscriptionCache: subscriptionCache,
 		corsCache:         corsCache,
+		schemaCache:       schemaCache,
 	}
 }
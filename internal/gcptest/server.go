@@ -0,0 +1,236 @@
+// Package gcptest provides an in-process stand-in for Google APIs used by Google Cloud providers. It issues OAuth2
+// access and ID tokens for a generated service account and implements Pub/Sub publish endpoint.
+package gcptest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/serverless/event-gateway/internal/apitest"
+)
+
+// Message is a published Pub/Sub message.
+type Message struct {
+	ID          string
+	Data        []byte
+	Attributes  map[string]string
+	OrderingKey string
+}
+
+// Server is a stand-in for Google OAuth2 token endpoint and Pub/Sub API.
+type Server struct {
+	*apitest.Server
+	// Credentials is a JSON key of the service account. Tokens for the service account are issued by the server.
+	Credentials string
+
+	key *rsa.PrivateKey
+
+	mutex        sync.Mutex
+	accessTokens map[string]struct{}
+	idTokens     map[string]string
+	topics       map[string][]Message
+	lastID       int
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic("gcptest: failed to generate key: " + err.Error())
+	}
+
+	s := &Server{
+		Server:       apitest.NewServer(),
+		key:          key,
+		accessTokens: map[string]struct{}{},
+		idTokens:     map[string]string{},
+		topics:       map[string][]Message{},
+	}
+	s.HandleFunc("/token", s.TokenEndpoint(s.token))
+	s.HandleFunc("/v1/projects/", s.publish)
+
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	credentials, _ := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "test",
+		"private_key_id": "1",
+		"private_key":    string(privateKey),
+		"client_email":   "invoker@test.iam.gserviceaccount.com",
+		"client_id":      "1",
+		"token_uri":      s.URL + "/token",
+	})
+	s.Credentials = string(credentials)
+	return s
+}
+
+// Audience returns audience of ID token that authenticated the request. It returns false if the request doesn't
+// carry ID token issued by the server.
+func (s *Server) Audience(r *http.Request) (string, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	audience, ok := s.idTokens[apitest.BearerToken(r)]
+	return audience, ok
+}
+
+// CreateTopic creates Pub/Sub topic.
+func (s *Server) CreateTopic(project, topic string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.topics[topicName(project, topic)] = []Message{}
+}
+
+// Messages returns messages published to the topic.
+func (s *Server) Messages(project, topic string) []Message {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Message(nil), s.topics[topicName(project, topic)]...)
+}
+
+// token implements JWT bearer grant. ID token is issued if assertion contains target_audience claim, access token
+// otherwise.
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	claims, err := s.verify(r.PostFormValue("assertion"))
+	if err != nil || r.PostFormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+		apitest.WriteJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	if audience, ok := claims["target_audience"].(string); ok {
+		idToken := s.sign(map[string]interface{}{
+			"aud": audience,
+			"iss": "https://accounts.google.com",
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"iat": time.Now().Unix(),
+			"sub": strconv.Itoa(len(s.idTokens) + 1),
+		})
+		s.idTokens[idToken] = audience
+		apitest.WriteJSON(w, http.StatusOK, map[string]string{"id_token": idToken})
+		return
+	}
+
+	accessToken := "access-token-" + strconv.Itoa(len(s.accessTokens)+1)
+	s.accessTokens[accessToken] = struct{}{}
+	apitest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+type publishRequest struct {
+	Messages []struct {
+		Data        []byte            `json:"data"`
+		Attributes  map[string]string `json:"attributes"`
+		OrderingKey string            `json:"orderingKey"`
+	} `json:"messages"`
+}
+
+// publish implements Pub/Sub publish method. Like the emulator, it accepts requests without credentials.
+func (s *Server) publish(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		if _, ok := s.accessTokens[apitest.BearerToken(r)]; !ok {
+			writeError(w, http.StatusUnauthorized, "Request had invalid authentication credentials.")
+			return
+		}
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/v1/")
+	if r.Method != http.MethodPost || !strings.HasSuffix(name, ":publish") {
+		writeError(w, http.StatusNotFound, "Method not found.")
+		return
+	}
+	name = strings.TrimSuffix(name, ":publish")
+	messages, ok := s.topics[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Topic not found")
+		return
+	}
+
+	req := &publishRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil || len(req.Messages) == 0 {
+		writeError(w, http.StatusBadRequest, "Invalid publish request.")
+		return
+	}
+
+	ids := []string{}
+	for _, msg := range req.Messages {
+		s.lastID++
+		id := strconv.Itoa(s.lastID)
+		messages = append(messages, Message{
+			ID:          id,
+			Data:        msg.Data,
+			Attributes:  msg.Attributes,
+			OrderingKey: msg.OrderingKey,
+		})
+		ids = append(ids, id)
+	}
+	s.topics[name] = messages
+
+	apitest.WriteJSON(w, http.StatusOK, map[string][]string{"messageIds": ids})
+}
+
+var errInvalidAssertion = errors.New("gcptest: invalid assertion")
+
+// verify checks signature of JWT assertion and returns its claims.
+func (s *Server) verify(assertion string) (map[string]interface{}, error) {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidAssertion
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidAssertion
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if rsa.VerifyPKCS1v15(&s.key.PublicKey, crypto.SHA256, hash[:], signature) != nil {
+		return nil, errInvalidAssertion
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errInvalidAssertion
+	}
+	claims := map[string]interface{}{}
+	if json.Unmarshal(payload, &claims) != nil {
+		return nil, errInvalidAssertion
+	}
+	return claims, nil
+}
+
+// sign returns RS256 signed JWT with given claims.
+func (s *Server) sign(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, _ := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hash[:])
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func topicName(project, topic string) string {
+	return "projects/" + project + "/topics/" + topic
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	apitest.WriteJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{"code": status, "message": message},
+	})
+}
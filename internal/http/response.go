@@ -0,0 +1,37 @@
+package http
+
+import (
+	"bytes"
+	httppkg "net/http"
+)
+
+// ResponseRecorder is an implementation of http.ResponseWriter that records response written by a handler invoked
+// outside of HTTP server (e.g. router called by other events API).
+type ResponseRecorder struct {
+	Code      int
+	HeaderMap httppkg.Header
+	Body      bytes.Buffer
+}
+
+// NewResponseRecorder returns an initialized ResponseRecorder.
+func NewResponseRecorder() *ResponseRecorder {
+	return &ResponseRecorder{HeaderMap: httppkg.Header{}}
+}
+
+// Header returns response headers.
+func (r *ResponseRecorder) Header() httppkg.Header {
+	return r.HeaderMap
+}
+
+// Write records response body. Status code defaults to 200.
+func (r *ResponseRecorder) Write(body []byte) (int, error) {
+	r.WriteHeader(httppkg.StatusOK)
+	return r.Body.Write(body)
+}
+
+// WriteHeader records status code. Only the first status code is recorded.
+func (r *ResponseRecorder) WriteHeader(code int) {
+	if r.Code == 0 {
+		r.Code = code
+	}
+}
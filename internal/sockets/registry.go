@@ -0,0 +1,349 @@
+package sockets
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/satori/go.uuid"
+	"github.com/serverless/libkv/store"
+	"go.uber.org/zap"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/internal/cache"
+)
+
+const (
+	// subscriptionTTL is a time after which subscriptions of a node that stopped refreshing them expire.
+	subscriptionTTL = 30 * time.Second
+	// messageTTL is a time after which undelivered messages are removed from node's inbox.
+	messageTTL = 30 * time.Second
+	// outboxSize is a number of events waiting to be written to inboxes of other nodes. Events published when the
+	// outbox is full are dropped.
+	outboxSize = 1024
+	// maxMessageSize is a size of the biggest message written to an inbox. etcd rejects requests bigger than 1.5 MiB
+	// by default.
+	maxMessageSize = 1024 * 1024
+)
+
+var (
+	errOutboxFull      = errors.New("too many events waiting to be published to other nodes")
+	errMessageTooLarge = errors.New("event is too large to be published to other nodes")
+)
+
+// Registry is an implementation of router.SocketRegistry using libkv store. Every node stores event types its clients
+// are subscribed to under "<path>/sockets/subscriptions/<space>/<event type>/<node ID>" key. Keys are stored with TTL
+// and refreshed periodically so registrations of nodes that crashed expire. Events for clients connected to other
+// nodes are written to "<path>/sockets/inbox/<node ID>/" directory watched by the node. Writes to the store happen in
+// background so callers are never blocked on the store.
+type Registry struct {
+	sync.RWMutex
+	nodeID        string
+	kv            store.Store
+	subscriptions string
+	inboxes       string
+	log           *zap.Logger
+	shutdown      chan struct{}
+
+	// local is a set of subscriptions registered by this node.
+	local map[key]struct{}
+	// dirty is a set of subscriptions that has to be written to or removed from the store.
+	dirty   map[key]struct{}
+	changed chan struct{}
+	outbox  chan outgoing
+	// nodes maps subscriptions to IDs of nodes having clients subscribed.
+	nodes   map[key]map[string]struct{}
+	deliver func(space string, event eventpkg.Event)
+}
+
+type key struct {
+	space     string
+	eventType eventpkg.TypeName
+}
+
+type outgoing struct {
+	nodes   []string
+	payload []byte
+}
+
+type message struct {
+	Space string         `json:"space"`
+	Event eventpkg.Event `json:"event"`
+}
+
+// NewRegistry instantiates a new Registry, rooted at a particular location. Node ID has to be unique in the cluster.
+func NewRegistry(nodeID, path string, kvstore store.Store, log *zap.Logger) *Registry {
+	if !strings.HasSuffix(path, "/") {
+		path = path + "/"
+	}
+
+	registry := &Registry{
+		nodeID:        nodeID,
+		kv:            kvstore,
+		subscriptions: path + "sockets/subscriptions/",
+		inboxes:       path + "sockets/inbox/",
+		log:           log,
+		shutdown:      make(chan struct{}),
+		local:         map[key]struct{}{},
+		dirty:         map[key]struct{}{},
+		changed:       make(chan struct{}, 1),
+		nodes:         map[key]map[string]struct{}{},
+		outbox:        make(chan outgoing, outboxSize),
+	}
+
+	cache.NewWatcher(registry.subscriptions, kvstore, log).React(&subscriptionsReactor{registry}, registry.shutdown)
+	cache.NewWatcher(registry.inbox(nodeID), kvstore, log).React(&inboxReactor{registry}, registry.shutdown)
+	go registry.sync()
+	go registry.send()
+
+	return registry
+}
+
+// Subscribe registers that the node has clients subscribed to the event type in the space.
+func (r *Registry) Subscribe(space string, eventType eventpkg.TypeName) {
+	r.Lock()
+	defer r.Unlock()
+
+	k := key{space: space, eventType: eventType}
+	r.local[k] = struct{}{}
+	r.markDirty(k)
+}
+
+// Unsubscribe removes registration after the last client on the node unsubscribed from the event type.
+func (r *Registry) Unsubscribe(space string, eventType eventpkg.TypeName) {
+	r.Lock()
+	defer r.Unlock()
+
+	k := key{space: space, eventType: eventType}
+	delete(r.local, k)
+	r.markDirty(k)
+}
+
+// Spaces returns spaces in which clients connected to any node are subscribed to the event type.
+func (r *Registry) Spaces(eventType eventpkg.TypeName) []string {
+	r.RLock()
+	defer r.RUnlock()
+
+	spaces := []string{}
+	for k := range r.nodes {
+		if k.eventType == eventType {
+			spaces = append(spaces, k.space)
+		}
+	}
+	return spaces
+}
+
+// Publish queues the event for other nodes that have clients subscribed to the event type in the space. It doesn't
+// block. An error is returned if the event can't be queued.
+func (r *Registry) Publish(space string, event eventpkg.Event) error {
+	r.RLock()
+	nodes := []string{}
+	for nodeID := range r.nodes[key{space: space, eventType: event.EventType}] {
+		if nodeID != r.nodeID {
+			nodes = append(nodes, nodeID)
+		}
+	}
+	r.RUnlock()
+
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(message{Space: space, Event: event})
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxMessageSize {
+		return errMessageTooLarge
+	}
+
+	select {
+	case r.outbox <- outgoing{nodes: nodes, payload: payload}:
+		return nil
+	default:
+		return errOutboxFull
+	}
+}
+
+// Receive sets a callback invoked with events published by other nodes.
+func (r *Registry) Receive(deliver func(space string, event eventpkg.Event)) {
+	r.Lock()
+	defer r.Unlock()
+	r.deliver = deliver
+}
+
+// Shutdown stops watching for changes in the registry.
+func (r *Registry) Shutdown() {
+	close(r.shutdown)
+}
+
+func (r *Registry) inbox(nodeID string) string {
+	return r.inboxes + nodeID + "/"
+}
+
+func (r *Registry) subscriptionKey(space string, eventType eventpkg.TypeName) string {
+	return r.subscriptions + space + "/" + string(eventType) + "/" + r.nodeID
+}
+
+// markDirty schedules writing subscription to the store. It has to be called with the lock held.
+func (r *Registry) markDirty(k key) {
+	r.dirty[k] = struct{}{}
+	select {
+	case r.changed <- struct{}{}:
+	default:
+	}
+}
+
+// sync writes subscriptions of this node to the store and periodically renews their TTL. It's the only goroutine
+// writing subscriptions so a refresh never recreates a subscription removed in the meantime.
+func (r *Registry) sync() {
+	ticker := time.NewTicker(subscriptionTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.changed:
+		case <-ticker.C:
+			r.Lock()
+			for k := range r.local {
+				r.dirty[k] = struct{}{}
+			}
+			r.Unlock()
+		case <-r.shutdown:
+			return
+		}
+
+		r.Lock()
+		dirty := r.dirty
+		r.dirty = map[key]struct{}{}
+		r.Unlock()
+
+		for k := range dirty {
+			r.write(k)
+		}
+	}
+}
+
+// write puts subscription to the store if the node still has clients subscribed, otherwise it removes it. If the
+// subscription changes while it's being written, it's marked dirty again and written in the next round.
+func (r *Registry) write(k key) {
+	r.RLock()
+	_, subscribed := r.local[k]
+	r.RUnlock()
+
+	var err error
+	if subscribed {
+		err = r.kv.Put(r.subscriptionKey(k.space, k.eventType), []byte(r.nodeID), &store.WriteOptions{TTL: subscriptionTTL})
+	} else {
+		err = r.kv.Delete(r.subscriptionKey(k.space, k.eventType))
+		if err == store.ErrKeyNotFound {
+			err = nil
+		}
+	}
+	if err != nil {
+		r.log.Error("Could not write WebSocket subscription.",
+			zap.String("space", k.space),
+			zap.String("eventType", string(k.eventType)),
+			zap.Error(err))
+	}
+}
+
+// send writes queued events to inboxes of other nodes.
+func (r *Registry) send() {
+	for {
+		select {
+		case out := <-r.outbox:
+			for _, nodeID := range out.nodes {
+				err := r.kv.Put(r.inbox(nodeID)+uuid.NewV4().String(), out.payload, &store.WriteOptions{TTL: messageTTL})
+				if err != nil {
+					r.log.Error("Could not publish event to other node.", zap.String("nodeID", nodeID), zap.Error(err))
+				}
+			}
+		case <-r.shutdown:
+			return
+		}
+	}
+}
+
+// subscriptionsReactor keeps track of subscriptions of all nodes.
+type subscriptionsReactor struct {
+	registry *Registry
+}
+
+func (s *subscriptionsReactor) Modified(k string, v []byte) {
+	subscription, nodeID, ok := parseSubscriptionKey(k)
+	if !ok {
+		s.registry.log.Error("Could not parse WebSocket subscription key.", zap.String("key", k))
+		return
+	}
+
+	s.registry.Lock()
+	defer s.registry.Unlock()
+
+	nodes, exists := s.registry.nodes[subscription]
+	if !exists {
+		nodes = map[string]struct{}{}
+		s.registry.nodes[subscription] = nodes
+	}
+	nodes[nodeID] = struct{}{}
+}
+
+func (s *subscriptionsReactor) Deleted(k string, v []byte) {
+	subscription, nodeID, ok := parseSubscriptionKey(k)
+	if !ok {
+		return
+	}
+
+	s.registry.Lock()
+	defer s.registry.Unlock()
+
+	nodes := s.registry.nodes[subscription]
+	delete(nodes, nodeID)
+	if len(nodes) == 0 {
+		delete(s.registry.nodes, subscription)
+	}
+}
+
+// parseSubscriptionKey parses "<space>/<event type>/<node ID>" key.
+func parseSubscriptionKey(k string) (key, string, bool) {
+	first := strings.Index(k, "/")
+	last := strings.LastIndex(k, "/")
+	if first == -1 || first == last {
+		return key{}, "", false
+	}
+	return key{space: k[:first], eventType: eventpkg.TypeName(k[first+1 : last])}, k[last+1:], true
+}
+
+// inboxReactor delivers events published by other nodes and removes them from the inbox.
+type inboxReactor struct {
+	registry *Registry
+}
+
+func (i *inboxReactor) Modified(k string, v []byte) {
+	defer func() {
+		err := i.registry.kv.Delete(i.registry.inbox(i.registry.nodeID) + k)
+		if err != nil {
+			i.registry.log.Debug("Could not remove delivered message from inbox.", zap.String("key", k), zap.Error(err))
+		}
+	}()
+
+	msg := message{}
+	err := json.NewDecoder(bytes.NewReader(v)).Decode(&msg)
+	if err != nil {
+		i.registry.log.Error("Could not deserialize WebSocket message.", zap.Error(err), zap.String("key", k))
+		return
+	}
+
+	i.registry.RLock()
+	deliver := i.registry.deliver
+	i.registry.RUnlock()
+
+	if deliver != nil {
+		deliver(msg.Space, msg.Event)
+	}
+}
+
+func (i *inboxReactor) Deleted(k string, v []byte) {}
@@ -0,0 +1,178 @@
+package sockets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/serverless/libkv/store"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/mock"
+)
+
+func TestSubscriptionsReactor(t *testing.T) {
+	t.Run("node added", func(t *testing.T) {
+		registry := newTestRegistry(nil)
+		reactor := &subscriptionsReactor{registry}
+
+		reactor.Modified("default/user.created/node1", []byte("node1"))
+
+		assert.Equal(t, []string{"default"}, registry.Spaces("user.created"))
+		assert.Equal(t, []string{}, registry.Spaces("user.deleted"))
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		registry := newTestRegistry(nil)
+		reactor := &subscriptionsReactor{registry}
+
+		reactor.Modified("default", []byte("node1"))
+
+		assert.Empty(t, registry.nodes)
+	})
+
+	t.Run("node removed", func(t *testing.T) {
+		registry := newTestRegistry(nil)
+		reactor := &subscriptionsReactor{registry}
+		reactor.Modified("default/user.created/node1", []byte("node1"))
+		reactor.Modified("default/user.created/node2", []byte("node2"))
+
+		reactor.Deleted("default/user.created/node1", []byte("node1"))
+		assert.Equal(t, []string{"default"}, registry.Spaces("user.created"))
+
+		reactor.Deleted("default/user.created/node2", []byte("node2"))
+		assert.Equal(t, []string{}, registry.Spaces("user.created"))
+	})
+}
+
+func TestRegistryPublish(t *testing.T) {
+	t.Run("event queued for other nodes", func(t *testing.T) {
+		registry := newTestRegistry(nil)
+		reactor := &subscriptionsReactor{registry}
+		reactor.Modified("default/user.created/node1", []byte("node1"))
+		reactor.Modified("default/user.created/node2", []byte("node2"))
+
+		err := registry.Publish("default", *eventpkg.New("user.created", "application/json", nil))
+
+		assert.Nil(t, err)
+		out := <-registry.outbox
+		assert.Equal(t, []string{"node2"}, out.nodes)
+	})
+
+	t.Run("no other nodes", func(t *testing.T) {
+		registry := newTestRegistry(nil)
+		reactor := &subscriptionsReactor{registry}
+		reactor.Modified("default/user.created/node1", []byte("node1"))
+
+		err := registry.Publish("default", *eventpkg.New("user.created", "application/json", nil))
+
+		assert.Nil(t, err)
+		assert.Len(t, registry.outbox, 0)
+	})
+
+	t.Run("error if outbox is full", func(t *testing.T) {
+		registry := newTestRegistry(nil)
+		reactor := &subscriptionsReactor{registry}
+		reactor.Modified("default/user.created/node2", []byte("node2"))
+		registry.Publish("default", *eventpkg.New("user.created", "application/json", nil))
+
+		err := registry.Publish("default", *eventpkg.New("user.created", "application/json", nil))
+
+		assert.Equal(t, errOutboxFull, err)
+	})
+
+	t.Run("error if event is too large", func(t *testing.T) {
+		registry := newTestRegistry(nil)
+		reactor := &subscriptionsReactor{registry}
+		reactor.Modified("default/user.created/node2", []byte("node2"))
+
+		err := registry.Publish("default", *eventpkg.New("user.created", "text/plain", strings.Repeat("a", maxMessageSize)))
+
+		assert.Equal(t, errMessageTooLarge, err)
+	})
+}
+
+func TestRegistrySend(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	written := make(chan struct{})
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().Put(gomock.Any(), []byte("payload"), &store.WriteOptions{TTL: messageTTL}).
+		Do(func(key string, value []byte, options *store.WriteOptions) {
+			assert.Contains(t, key, "/eg/sockets/inbox/node2/")
+			close(written)
+		}).
+		Return(nil)
+	registry := newTestRegistry(kv)
+	go registry.send()
+	defer registry.Shutdown()
+
+	registry.outbox <- outgoing{nodes: []string{"node2"}, payload: []byte("payload")}
+
+	<-written
+}
+
+func TestRegistryWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("subscription written", func(t *testing.T) {
+		kv := mock.NewMockStore(ctrl)
+		kv.EXPECT().Put("/eg/sockets/subscriptions/default/user.created/node1", []byte("node1"),
+			&store.WriteOptions{TTL: subscriptionTTL}).Return(nil)
+		registry := newTestRegistry(kv)
+
+		registry.Subscribe("default", "user.created")
+
+		registry.write(key{space: "default", eventType: "user.created"})
+	})
+
+	t.Run("removed subscription not refreshed", func(t *testing.T) {
+		kv := mock.NewMockStore(ctrl)
+		kv.EXPECT().Delete("/eg/sockets/subscriptions/default/user.created/node1").Return(nil)
+		registry := newTestRegistry(kv)
+		registry.Subscribe("default", "user.created")
+
+		registry.Unsubscribe("default", "user.created")
+
+		registry.write(key{space: "default", eventType: "user.created"})
+	})
+}
+
+func TestInboxReactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().Delete("/eg/sockets/inbox/node1/msg1").Return(nil)
+	registry := newTestRegistry(kv)
+	var received eventpkg.TypeName
+	registry.Receive(func(space string, event eventpkg.Event) {
+		assert.Equal(t, "default", space)
+		received = event.EventType
+	})
+	reactor := &inboxReactor{registry}
+
+	reactor.Modified("msg1", []byte(`{"space":"default","event":{"eventType":"user.created"}}`))
+
+	assert.Equal(t, eventpkg.TypeName("user.created"), received)
+}
+
+func newTestRegistry(kv store.Store) *Registry {
+	return &Registry{
+		nodeID:        "node1",
+		kv:            kv,
+		subscriptions: "/eg/sockets/subscriptions/",
+		inboxes:       "/eg/sockets/inbox/",
+		log:           zap.NewNop(),
+		shutdown:      make(chan struct{}),
+		local:         map[key]struct{}{},
+		dirty:         map[key]struct{}{},
+		changed:       make(chan struct{}, 1),
+		nodes:         map[key]map[string]struct{}{},
+		outbox:        make(chan outgoing, 1),
+	}
+}
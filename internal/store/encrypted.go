@@ -0,0 +1,365 @@
+package store
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/serverless/libkv/store"
+	"go.uber.org/zap"
+)
+
+// encryptedPrefix marks values encrypted by Encrypted store. Values without the prefix are considered plaintext values
+// written before encryption was enabled.
+const encryptedPrefix = "eg:encrypted:v1:"
+
+// errNotEncrypted is returned in strict mode for values stored in plaintext.
+var errNotEncrypted = errors.New("value is not encrypted")
+
+// dataKeySize is a size of AES-256 key generated for every value.
+const dataKeySize = 32
+
+// Encrypted encrypts values stored in a libkv Store. Every value is encrypted with a random data key which is wrapped
+// with a master key provided by KMS (envelope encryption). Keys and metadata are stored as is. Both the value and the
+// wrapped data key are bound to the key of the value, so encrypted value copied to other key cannot be decrypted.
+type Encrypted struct {
+	kv     store.Store
+	kms    KMS
+	log    *zap.Logger
+	strict bool
+}
+
+var _ Transactional = (*Encrypted)(nil)
+
+// NewEncrypted creates a new encrypting libkv Store.
+func NewEncrypted(kv store.Store, kms KMS, log *zap.Logger) *Encrypted {
+	return &Encrypted{
+		kv:  kv,
+		kms: kms,
+		log: log,
+	}
+}
+
+// SetStrict enables rejecting values stored in plaintext. It should be enabled once all existing values are encrypted
+// with Reencrypt, otherwise anyone with write access to the database could replace encrypted values with plaintext.
+func (es *Encrypted) SetStrict(strict bool) {
+	es.strict = strict
+}
+
+// envelope is an encrypted value together with its wrapped data key.
+type envelope struct {
+	KeyID   string `json:"keyId"`
+	DataKey []byte `json:"dataKey"`
+	Data    []byte `json:"data"`
+}
+
+// Put encrypts value and passes requests to the underlying libkv implementation.
+func (es *Encrypted) Put(key string, value []byte, options *store.WriteOptions) error {
+	encrypted, err := es.encrypt(key, value)
+	if err != nil {
+		return err
+	}
+	return es.kv.Put(key, encrypted, options)
+}
+
+// Get passes requests to the underlying libkv implementation and decrypts returned value.
+func (es *Encrypted) Get(key string, options *store.ReadOptions) (*store.KVPair, error) {
+	kv, err := es.kv.Get(key, options)
+	if err != nil {
+		return nil, err
+	}
+	return es.decryptPair(kv)
+}
+
+// Delete passes requests to the underlying libkv implementation.
+func (es *Encrypted) Delete(key string) error {
+	return es.kv.Delete(key)
+}
+
+// Exists passes requests to the underlying libkv implementation.
+func (es *Encrypted) Exists(key string, options *store.ReadOptions) (bool, error) {
+	return es.kv.Exists(key, options)
+}
+
+// Watch passes requests to the underlying libkv implementation and decrypts values of received pairs. Pairs that
+// cannot be decrypted are logged and skipped.
+func (es *Encrypted) Watch(key string, stopCh <-chan struct{}, options *store.ReadOptions) (<-chan *store.KVPair, error) {
+	encrypted, err := es.kv.Watch(key, stopCh, options)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := make(chan *store.KVPair)
+	go func() {
+		defer close(decrypted)
+		for kv := range encrypted {
+			pair, err := es.decryptPair(kv)
+			if err != nil {
+				es.logDecryptionError(kv.Key, err)
+				continue
+			}
+
+			select {
+			case decrypted <- pair:
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+	return decrypted, nil
+}
+
+// WatchTree passes requests to the underlying libkv implementation and decrypts values of received pairs. Pairs that
+// cannot be decrypted are logged and skipped.
+func (es *Encrypted) WatchTree(directory string, stopCh <-chan struct{}, options *store.ReadOptions) (<-chan []*store.KVPair, error) {
+	encrypted, err := es.kv.WatchTree(directory, stopCh, options)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := make(chan []*store.KVPair)
+	go func() {
+		defer close(decrypted)
+		for kvs := range encrypted {
+			pairs := []*store.KVPair{}
+			for _, kv := range kvs {
+				pair, err := es.decryptPair(kv)
+				if err != nil {
+					es.logDecryptionError(kv.Key, err)
+					continue
+				}
+				pairs = append(pairs, pair)
+			}
+
+			select {
+			case decrypted <- pairs:
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+	return decrypted, nil
+}
+
+// NewLock passes requests to the underlying libkv implementation.
+func (es *Encrypted) NewLock(key string, options *store.LockOptions) (store.Locker, error) {
+	return es.kv.NewLock(key, options)
+}
+
+// List passes requests to the underlying libkv implementation and decrypts returned values. Pairs that cannot be
+// decrypted are logged and skipped.
+func (es *Encrypted) List(directory string, options *store.ReadOptions) ([]*store.KVPair, error) {
+	kvs, err := es.kv.List(directory, options)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := []*store.KVPair{}
+	for _, kv := range kvs {
+		pair, err := es.decryptPair(kv)
+		if err != nil {
+			es.logDecryptionError(kv.Key, err)
+			continue
+		}
+		decrypted = append(decrypted, pair)
+	}
+	return decrypted, nil
+}
+
+// DeleteTree passes requests to the underlying libkv implementation.
+func (es *Encrypted) DeleteTree(directory string) error {
+	return es.kv.DeleteTree(directory)
+}
+
+// AtomicPut encrypts value and passes requests to the underlying libkv implementation. Returned pair contains
+// plaintext value.
+func (es *Encrypted) AtomicPut(key string, value []byte, previous *store.KVPair, options *store.WriteOptions) (bool, *store.KVPair, error) {
+	encrypted, err := es.encrypt(key, value)
+	if err != nil {
+		return false, nil, err
+	}
+
+	ok, kv, err := es.kv.AtomicPut(key, encrypted, previous, options)
+	if kv != nil {
+		kv = &store.KVPair{Key: kv.Key, Value: value, LastIndex: kv.LastIndex}
+	}
+	return ok, kv, err
+}
+
+// AtomicDelete passes requests to the underlying libkv implementation.
+func (es *Encrypted) AtomicDelete(key string, previous *store.KVPair) (bool, error) {
+	return es.kv.AtomicDelete(key, previous)
+}
+
+// AtomicPutAll encrypts values and passes requests to the underlying store if it supports transactions.
+func (es *Encrypted) AtomicPutAll(ops []Op) (bool, error) {
+	txn, ok := es.kv.(Transactional)
+	if !ok {
+		return false, ErrTransactionsNotSupported
+	}
+
+	encrypted := []Op{}
+	for _, op := range ops {
+		value, err := es.encrypt(op.Key, op.Value)
+		if err != nil {
+			return false, err
+		}
+		encrypted = append(encrypted, Op{Key: op.Key, Value: value, Previous: op.Previous})
+	}
+	return txn.AtomicPutAll(encrypted)
+}
+
+// Close closes the underlying libkv client.
+func (es *Encrypted) Close() {
+	es.kv.Close()
+}
+
+// Reencrypt encrypts with the current master key all values in the directory that are stored in plaintext or were
+// encrypted with a different master key. It's used for encrypting existing configuration and after master key
+// rotation. Values modified concurrently are skipped as they are already written with the current master key. Values
+// that cannot be decrypted are logged and skipped. It returns number of re-encrypted and skipped values.
+func (es *Encrypted) Reencrypt(directory string) (reencrypted int, skipped int, err error) {
+	kvs, err := es.kv.List(directory, &store.ReadOptions{Consistent: true})
+	if err == store.ErrKeyNotFound {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, kv := range kvs {
+		if len(kv.Value) == 0 {
+			continue
+		}
+
+		env, err := parseEnvelope(kv.Value)
+		if err != nil {
+			es.logDecryptionError(kv.Key, err)
+			skipped++
+			continue
+		}
+		if env != nil && env.KeyID == es.kms.KeyID() {
+			continue
+		}
+
+		value := kv.Value
+		if env != nil {
+			value, err = es.openEnvelope(kv.Key, env)
+			if err != nil {
+				es.logDecryptionError(kv.Key, err)
+				skipped++
+				continue
+			}
+		}
+		encrypted, err := es.encrypt(kv.Key, value)
+		if err != nil {
+			return reencrypted, skipped, err
+		}
+
+		ok, _, err := es.kv.AtomicPut(kv.Key, encrypted, kv, nil)
+		if err == store.ErrKeyModified || (err == nil && !ok) {
+			continue
+		}
+		if err != nil {
+			return reencrypted, skipped, err
+		}
+		reencrypted++
+	}
+	return reencrypted, skipped, nil
+}
+
+func (es *Encrypted) encrypt(key string, value []byte) ([]byte, error) {
+	// empty values are used for directories
+	if len(value) == 0 {
+		return value, nil
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := seal(aead, value, additionalData(key))
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, wrapped, err := es.kms.Encrypt(dataKey, additionalData(key))
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(envelope{KeyID: keyID, DataKey: wrapped, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(encryptedPrefix), payload...), nil
+}
+
+func (es *Encrypted) decrypt(key string, value []byte) ([]byte, error) {
+	env, err := parseEnvelope(value)
+	if err != nil {
+		return nil, err
+	}
+	if env == nil {
+		// empty values are used for directories
+		if es.strict && len(value) > 0 {
+			return nil, errNotEncrypted
+		}
+		return value, nil
+	}
+	return es.openEnvelope(key, env)
+}
+
+func (es *Encrypted) openEnvelope(key string, env *envelope) ([]byte, error) {
+	dataKey, err := es.kms.Decrypt(env.KeyID, env.DataKey, additionalData(key))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, env.Data, additionalData(key))
+}
+
+func (es *Encrypted) decryptPair(kv *store.KVPair) (*store.KVPair, error) {
+	value, err := es.decrypt(kv.Key, kv.Value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt %s: %s", kv.Key, err)
+	}
+	return &store.KVPair{Key: kv.Key, Value: value, LastIndex: kv.LastIndex}, nil
+}
+
+func (es *Encrypted) logDecryptionError(key string, err error) {
+	es.log.Error("Cannot decrypt value received from database.",
+		zap.String("event", "db"),
+		zap.String("key", key),
+		zap.Error(err))
+}
+
+// additionalData returns key of the value in the format returned by the store. It's authenticated when encrypting the
+// value and wrapping its data key.
+func additionalData(key string) []byte {
+	return []byte(normalize(key))
+}
+
+// parseEnvelope returns nil if value is not encrypted.
+func parseEnvelope(value []byte) (*envelope, error) {
+	if !bytes.HasPrefix(value, []byte(encryptedPrefix)) {
+		return nil, nil
+	}
+
+	env := &envelope{}
+	err := json.Unmarshal(value[len(encryptedPrefix):], env)
+	if err != nil {
+		return nil, err
+	}
+	return env, nil
+}
@@ -0,0 +1,349 @@
+package store
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/serverless/event-gateway/mock"
+	"github.com/serverless/libkv/store"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestEncryptedPutGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var stored []byte
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().Put("testkey", gomock.Any(), nil).Do(func(key string, value []byte, options *store.WriteOptions) {
+		stored = value
+	})
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+
+	err := es.Put("testkey", []byte(`{"token":"secret"}`), nil)
+	assert.Nil(t, err)
+	assert.True(t, bytes.HasPrefix(stored, []byte(encryptedPrefix)))
+	assert.NotContains(t, string(stored), "secret")
+
+	kv.EXPECT().Get("testkey", nil).Return(&store.KVPair{Key: "testkey", Value: stored, LastIndex: 3}, nil)
+	value, err := es.Get("testkey", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, &store.KVPair{Key: "testkey", Value: []byte(`{"token":"secret"}`), LastIndex: 3}, value)
+}
+
+func TestEncryptedPut_EmptyValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().Put("testdir/", []byte(nil), nil).Return(nil)
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+
+	err := es.Put("testdir/", nil, nil)
+	assert.Nil(t, err)
+}
+
+func TestEncryptedGet_Plaintext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().Get("testkey", nil).Return(&store.KVPair{Key: "testkey", Value: []byte(`{"id":"1"}`)}, nil)
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+
+	value, err := es.Get("testkey", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"id":"1"}`), value.Value)
+}
+
+func TestEncryptedGet_UnknownKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	other := NewEncrypted(nil, newTestKMS(t, "key2"), zap.NewNop())
+	encrypted, _ := other.encrypt("testkey", []byte("value"))
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().Get("testkey", nil).Return(&store.KVPair{Key: "testkey", Value: encrypted}, nil)
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+
+	value, err := es.Get("testkey", nil)
+	assert.Nil(t, value)
+	assert.EqualError(t, err, "unable to decrypt testkey: unknown key key2")
+}
+
+func TestEncryptedGet_MovedValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kv := mock.NewMockStore(ctrl)
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+	encrypted, _ := es.encrypt("/testdir/key1", []byte("value"))
+	kv.EXPECT().Get("testdir/key2", nil).Return(&store.KVPair{Key: "testdir/key2", Value: encrypted}, nil)
+	kv.EXPECT().Get("testdir/key1", nil).Return(&store.KVPair{Key: "testdir/key1", Value: encrypted}, nil)
+
+	value, err := es.Get("testdir/key2", nil)
+	assert.Nil(t, value)
+	assert.EqualError(t, err, "unable to decrypt testdir/key2: cipher: message authentication failed")
+
+	value, err = es.Get("testdir/key1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value.Value)
+}
+
+func TestEncryptedList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	es := NewEncrypted(nil, newTestKMS(t, "key1"), zap.NewNop())
+	encrypted, _ := es.encrypt("testdir/key1", []byte("value1"))
+	moved, _ := es.encrypt("testdir/key1", []byte("value3"))
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().List("testdir", nil).Return([]*store.KVPair{
+		{Key: "testdir/", Value: nil},
+		{Key: "testdir/key1", Value: encrypted},
+		{Key: "testdir/key2", Value: []byte("value2")},
+		{Key: "testdir/key3", Value: moved},
+		{Key: "testdir/key4", Value: []byte(encryptedPrefix + "invalid")},
+	}, nil)
+	es.kv = kv
+
+	values, err := es.List("testdir", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []*store.KVPair{
+		{Key: "testdir/", Value: nil},
+		{Key: "testdir/key1", Value: []byte("value1")},
+		{Key: "testdir/key2", Value: []byte("value2")},
+	}, values)
+}
+
+func TestEncryptedWatchTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	es := NewEncrypted(nil, newTestKMS(t, "key1"), zap.NewNop())
+	encrypted, _ := es.encrypt("testdir/key1", []byte("value1"))
+	events := make(chan []*store.KVPair, 1)
+	events <- []*store.KVPair{
+		{Key: "testdir/key1", Value: encrypted},
+		{Key: "testdir/key2", Value: []byte(encryptedPrefix + "invalid")},
+		{Key: "testdir/key3", Value: nil},
+	}
+	close(events)
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().WatchTree("testdir", gomock.Any(), nil).Return((<-chan []*store.KVPair)(events), nil)
+	es.kv = kv
+
+	decrypted, err := es.WatchTree("testdir", make(chan struct{}), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []*store.KVPair{
+		{Key: "testdir/key1", Value: []byte("value1")},
+		{Key: "testdir/key3", Value: nil},
+	}, <-decrypted)
+	_, ok := <-decrypted
+	assert.False(t, ok)
+}
+
+func TestEncryptedAtomicPutAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kv := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: true}
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+
+	ok, err := es.AtomicPutAll([]Op{{Key: "key1", Value: []byte("value1")}})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	value, err := es.decrypt("key1", kv.ops[0].Value)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+}
+
+func TestEncryptedReencrypt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	keyFile := writeTestKeyFile(t, "key1", "key2")
+	defer os.Remove(keyFile)
+	rotated, _ := NewLocalKMS(keyFile)
+	old := NewEncrypted(nil, &fixedKeyKMS{LocalKMS: rotated, id: "key2"}, zap.NewNop())
+	oldValue, _ := old.encrypt("testdir/key2", []byte("value2"))
+	es := NewEncrypted(nil, rotated, zap.NewNop())
+	currentValue, _ := es.encrypt("testdir/key3", []byte("value3"))
+
+	kvs := []*store.KVPair{
+		{Key: "testdir/", Value: nil},
+		{Key: "testdir/key1", Value: []byte("value1"), LastIndex: 1},
+		{Key: "testdir/key2", Value: oldValue, LastIndex: 2},
+		{Key: "testdir/key3", Value: currentValue, LastIndex: 3},
+	}
+	written := map[string][]byte{}
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().List("testdir", &store.ReadOptions{Consistent: true}).Return(kvs, nil)
+	kv.EXPECT().AtomicPut("testdir/key1", gomock.Any(), kvs[1], nil).Do(
+		func(key string, value []byte, previous *store.KVPair, options *store.WriteOptions) {
+			written[key] = value
+		}).Return(true, nil, nil)
+	kv.EXPECT().AtomicPut("testdir/key2", gomock.Any(), kvs[2], nil).Do(
+		func(key string, value []byte, previous *store.KVPair, options *store.WriteOptions) {
+			written[key] = value
+		}).Return(true, nil, nil)
+	es.kv = kv
+
+	count, skipped, err := es.Reencrypt("testdir")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 0, skipped)
+	for key, plaintext := range map[string]string{"testdir/key1": "value1", "testdir/key2": "value2"} {
+		env, _ := parseEnvelope(written[key])
+		assert.Equal(t, "key1", env.KeyID)
+		value, _ := es.decrypt(key, written[key])
+		assert.Equal(t, []byte(plaintext), value)
+	}
+}
+
+func TestEncryptedReencrypt_Modified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kvs := []*store.KVPair{{Key: "testdir/key1", Value: []byte("value1"), LastIndex: 1}}
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().List("testdir", &store.ReadOptions{Consistent: true}).Return(kvs, nil)
+	kv.EXPECT().AtomicPut("testdir/key1", gomock.Any(), kvs[0], nil).Return(false, nil, store.ErrKeyModified)
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+
+	count, skipped, err := es.Reencrypt("testdir")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 0, skipped)
+}
+
+func TestEncryptedReencrypt_Undecryptable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	other := NewEncrypted(nil, newTestKMS(t, "otherkey"), zap.NewNop())
+	otherValue, _ := other.encrypt("testdir/key1", []byte("value1"))
+	kvs := []*store.KVPair{
+		{Key: "testdir/key1", Value: otherValue, LastIndex: 1},
+		{Key: "testdir/key2", Value: []byte(encryptedPrefix + "notjson"), LastIndex: 2},
+		{Key: "testdir/key3", Value: []byte("value3"), LastIndex: 3},
+	}
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().List("testdir", &store.ReadOptions{Consistent: true}).Return(kvs, nil)
+	kv.EXPECT().AtomicPut("testdir/key3", gomock.Any(), kvs[2], nil).Return(true, nil, nil)
+	es := NewEncrypted(kv, newTestKMS(t, "key1"), zap.NewNop())
+
+	count, skipped, err := es.Reencrypt("testdir")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 2, skipped)
+}
+
+func TestEncryptedStrict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	es := NewEncrypted(nil, newTestKMS(t, "key1"), zap.NewNop())
+	encrypted, _ := es.encrypt("testdir/key2", []byte("value2"))
+	kvs := []*store.KVPair{
+		{Key: "testdir/", Value: nil},
+		{Key: "testdir/key1", Value: []byte("value1"), LastIndex: 1},
+		{Key: "testdir/key2", Value: encrypted, LastIndex: 2},
+	}
+	kv := mock.NewMockStore(ctrl)
+	kv.EXPECT().Get("testdir/key1", nil).Return(kvs[1], nil)
+	kv.EXPECT().List("testdir", nil).Return(kvs, nil)
+	es.kv = kv
+	es.SetStrict(true)
+
+	_, err := es.Get("testdir/key1", nil)
+	assert.EqualError(t, err, "unable to decrypt testdir/key1: value is not encrypted")
+
+	values, err := es.List("testdir", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []*store.KVPair{
+		{Key: "testdir/", Value: nil},
+		{Key: "testdir/key2", Value: []byte("value2"), LastIndex: 2},
+	}, values)
+}
+
+func TestNewLocalKMS(t *testing.T) {
+	keyFile := writeTestKeyFile(t, "key1", "key2")
+	defer os.Remove(keyFile)
+
+	kms, err := NewLocalKMS(keyFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "key1", kms.KeyID())
+
+	keyID, wrapped, err := kms.Encrypt([]byte("datakey"), []byte("testkey"))
+	assert.Nil(t, err)
+	assert.Equal(t, "key1", keyID)
+	dataKey, err := kms.Decrypt(keyID, wrapped, []byte("testkey"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("datakey"), dataKey)
+	_, err = kms.Decrypt(keyID, wrapped, []byte("otherkey"))
+	assert.EqualError(t, err, "cipher: message authentication failed")
+}
+
+func TestNewLocalKMS_Invalid(t *testing.T) {
+	for _, testCase := range []struct {
+		content string
+		err     string
+	}{
+		{"", "key file doesn't contain any key"},
+		{"# comment\n", "key file doesn't contain any key"},
+		{"key1", `invalid key line "key1", expected "id:key" format`},
+		{"key1:notbase64", "invalid key key1: illegal base64 data at input byte 8"},
+		{"key1:" + base64.StdEncoding.EncodeToString([]byte("short")), "invalid key key1: key must be 32 bytes long"},
+		{"key1:" + testKey + "\nkey1:" + testKey, "key key1 defined more than once"},
+	} {
+		dir, _ := ioutil.TempDir("", "keys")
+		keyFile := filepath.Join(dir, "keys")
+		ioutil.WriteFile(keyFile, []byte(testCase.content), 0600)
+
+		kms, err := NewLocalKMS(keyFile)
+		assert.Nil(t, kms)
+		assert.EqualError(t, err, testCase.err)
+		os.RemoveAll(dir)
+	}
+}
+
+var testKey = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("k"), 32))
+
+func writeTestKeyFile(t *testing.T, ids ...string) string {
+	file, err := ioutil.TempFile("", "keys")
+	assert.Nil(t, err)
+	defer file.Close()
+
+	for i, id := range ids {
+		key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{byte(i)}, 32))
+		file.WriteString(id + ":" + key + "\n")
+	}
+	return file.Name()
+}
+
+func newTestKMS(t *testing.T, id string) *LocalKMS {
+	keyFile := writeTestKeyFile(t, id)
+	defer os.Remove(keyFile)
+
+	kms, err := NewLocalKMS(keyFile)
+	assert.Nil(t, err)
+	return kms
+}
+
+// fixedKeyKMS encrypts with a key other than the current one to simulate values written before key rotation.
+type fixedKeyKMS struct {
+	*LocalKMS
+	id string
+}
+
+func (k *fixedKeyKMS) Encrypt(dataKey, context []byte) (string, []byte, error) {
+	wrapped, err := seal(k.keys[k.id], dataKey, wrappingData(k.id, context))
+	return k.id, wrapped, err
+}
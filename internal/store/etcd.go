@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/serverless/libkv/store"
+)
+
+// requestTimeout is a timeout of a single etcd transaction.
+const requestTimeout = 10 * time.Second
+
+// Etcd adds transactions to etcd v3 backed libkv Store.
+type Etcd struct {
+	store.Store
+	client *clientv3.Client
+}
+
+var _ Transactional = (*Etcd)(nil)
+
+// NewEtcd creates a new transactional libkv Store. Client has to be connected to the same etcd cluster as kv.
+func NewEtcd(kv store.Store, client *clientv3.Client) *Etcd {
+	return &Etcd{
+		Store:  kv,
+		client: client,
+	}
+}
+
+// AtomicPutAll writes all keys in a single etcd transaction. Transaction succeeds only if every key is still at the
+// revision of its previous pair.
+func (e *Etcd) AtomicPutAll(ops []Op) (bool, error) {
+	cmps := []clientv3.Cmp{}
+	puts := []clientv3.Op{}
+	for _, op := range ops {
+		key := normalize(op.Key)
+		if op.Previous == nil {
+			cmps = append(cmps, clientv3.Compare(clientv3.CreateRevision(key), "=", 0))
+		} else {
+			cmps = append(cmps, clientv3.Compare(clientv3.ModRevision(key), "=", int64(op.Previous.LastIndex)))
+		}
+		puts = append(puts, clientv3.OpPut(key, string(op.Value)))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	resp, err := e.client.Txn(ctx).If(cmps...).Then(puts...).Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// Close closes the underlying libkv store and etcd client.
+func (e *Etcd) Close() {
+	e.Store.Close()
+	e.client.Close()
+}
+
+// normalize converts key to the format used by libkv etcd v3 store.
+func normalize(key string) string {
+	return strings.TrimPrefix(store.Normalize(key), "/")
+}
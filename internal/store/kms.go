@@ -0,0 +1,143 @@
+package store
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// KMS wraps and unwraps data keys used for encrypting values. Implementations may keep master keys locally or call
+// external key management service. Wrapped data key is bound to the context (e.g. AES-GCM additional data or AWS KMS
+// encryption context), so it can be unwrapped only with the same context.
+type KMS interface {
+	// KeyID returns ID of the master key currently used for wrapping data keys.
+	KeyID() string
+	// Encrypt wraps data key with the current master key. It returns ID of the master key used.
+	Encrypt(dataKey, context []byte) (keyID string, wrapped []byte, err error)
+	// Decrypt unwraps data key with the master key of provided ID.
+	Decrypt(keyID string, wrapped, context []byte) ([]byte, error)
+}
+
+// LocalKMS is a KMS using AES-256 master keys loaded from a local file.
+type LocalKMS struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+var _ KMS = (*LocalKMS)(nil)
+
+// NewLocalKMS loads master keys from a file. Each line of the file contains key ID and base64 encoded 32 bytes key in
+// "id:key" format. Empty lines and lines starting with "#" are ignored. The first key is used for encrypting, the rest
+// are used only for decrypting values encrypted before key rotation.
+func NewLocalKMS(path string) (*LocalKMS, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	kms := &LocalKMS{keys: map[string]cipher.AEAD{}}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid key line %q, expected \"id:key\" format", line)
+		}
+		id := parts[0]
+		if _, exists := kms.keys[id]; exists {
+			return nil, fmt.Errorf("key %s defined more than once", id)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %s", id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("invalid key %s: key must be 32 bytes long", id)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+
+		kms.keys[id] = aead
+		if kms.current == "" {
+			kms.current = id
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if kms.current == "" {
+		return nil, errors.New("key file doesn't contain any key")
+	}
+
+	return kms, nil
+}
+
+// KeyID returns ID of the first key in the key file.
+func (k *LocalKMS) KeyID() string {
+	return k.current
+}
+
+// Encrypt wraps data key with the first key in the key file. Key ID and context are authenticated as additional data.
+func (k *LocalKMS) Encrypt(dataKey, context []byte) (string, []byte, error) {
+	wrapped, err := seal(k.keys[k.current], dataKey, wrappingData(k.current, context))
+	if err != nil {
+		return "", nil, err
+	}
+	return k.current, wrapped, nil
+}
+
+// Decrypt unwraps data key with the key of provided ID.
+func (k *LocalKMS) Decrypt(keyID string, wrapped, context []byte) ([]byte, error) {
+	aead, exists := k.keys[keyID]
+	if !exists {
+		return nil, fmt.Errorf("unknown key %s", keyID)
+	}
+	return open(aead, wrapped, wrappingData(keyID, context))
+}
+
+// wrappingData returns additional data authenticated when wrapping data key. Key ID is separated from the context
+// with a NUL byte.
+func wrappingData(keyID string, context []byte) []byte {
+	return append([]byte(keyID+"\x00"), context...)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext, authenticates additional data and prepends random nonce to the ciphertext.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts ciphertext created with seal. Additional data has to be the same as when sealing.
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, data := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, data, additionalData)
+}
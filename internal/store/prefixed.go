@@ -97,6 +97,21 @@ func (ps *Prefixed) AtomicDelete(key string, previous *store.KVPair) (bool, erro
 	return ps.kv.AtomicDelete(ps.root+key, previous)
 }
 
+// AtomicPutAll passes requests to the underlying store if it supports transactions, appending the root to paths for
+// isolation.
+func (ps *Prefixed) AtomicPutAll(ops []Op) (bool, error) {
+	txn, ok := ps.kv.(Transactional)
+	if !ok {
+		return false, ErrTransactionsNotSupported
+	}
+
+	prefixed := []Op{}
+	for _, op := range ops {
+		prefixed = append(prefixed, Op{Key: ps.root + op.Key, Value: op.Value, Previous: op.Previous})
+	}
+	return txn.AtomicPutAll(prefixed)
+}
+
 // Close closes the underlying libkv client.
 func (ps *Prefixed) Close() {
 	ps.kv.Close()
@@ -43,3 +43,42 @@ func TestPrefixedList_Error(t *testing.T) {
 	assert.Nil(t, values)
 	assert.EqualError(t, err, "KV error")
 }
+
+func TestPrefixedAtomicPutAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kv := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: true}
+	ps := NewPrefixed("testroot", kv)
+	previous := &store.KVPair{Key: "testroot/key1", LastIndex: 5}
+
+	ok, err := ps.AtomicPutAll([]Op{{Key: "key1", Value: []byte("value1"), Previous: previous}, {Key: "key2"}})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []Op{
+		{Key: "testroot/key1", Value: []byte("value1"), Previous: previous},
+		{Key: "testroot/key2"},
+	}, kv.ops)
+}
+
+func TestPrefixedAtomicPutAll_NotSupported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ps := NewPrefixed("testroot", mock.NewMockStore(ctrl))
+
+	ok, err := ps.AtomicPutAll([]Op{{Key: "key1"}})
+	assert.False(t, ok)
+	assert.Equal(t, ErrTransactionsNotSupported, err)
+}
+
+type txnStore struct {
+	*mock.MockStore
+	ok  bool
+	ops []Op
+}
+
+func (s *txnStore) AtomicPutAll(ops []Op) (bool, error) {
+	s.ops = ops
+	return s.ok, nil
+}
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"errors"
+
+	"github.com/serverless/libkv/store"
+)
+
+// ErrTransactionsNotSupported occurs when the underlying store cannot write multiple keys atomically.
+var ErrTransactionsNotSupported = errors.New("store doesn't support transactions")
+
+// Op is a single write in a transaction.
+type Op struct {
+	Key   string
+	Value []byte
+	// Previous is a previously read pair. Transaction fails if the key was modified since it was read. If Previous is
+	// nil the key must not exist.
+	Previous *store.KVPair
+}
+
+// Transactional is implemented by stores that can write multiple keys atomically.
+type Transactional interface {
+	// AtomicPutAll writes all keys or none of them. It returns false if any of the keys was modified concurrently.
+	AtomicPutAll(ops []Op) (bool, error)
+}
@@ -41,6 +41,13 @@ func (service Service) CreateEventType(eventType *event.Type) (*event.Type, erro
 		}
 	}
 
+	if eventType.SchemaID != nil {
+		s, _ := service.GetSchema(eventType.Space, *eventType.SchemaID)
+		if s == nil {
+			return nil, &event.ErrSchemaDoesNotExists{}
+		}
+	}
+
 	byt, err := json.Marshal(eventType)
 	if err != nil {
 		return nil, &event.ErrEventTypeValidation{Message: err.Error()}
@@ -119,6 +126,13 @@ func (service Service) UpdateEventType(newEventType *event.Type) (*event.Type, e
 		}
 	}
 
+	if newEventType.SchemaID != nil {
+		s, _ := service.GetSchema(newEventType.Space, *newEventType.SchemaID)
+		if s == nil {
+			return nil, &event.ErrSchemaDoesNotExists{}
+		}
+	}
+
 	buf, err := json.Marshal(newEventType)
 	if err != nil {
 		return nil, &event.ErrEventTypeValidation{Message: err.Error()}
@@ -128,8 +128,10 @@ func (service Service) DeleteFunction(space string, id function.ID) error {
 		return err
 	}
 	for _, sub := range subs {
-		if id == sub.FunctionID {
-			return &function.ErrFunctionHasSubscriptions{}
+		for _, subscribed := range sub.FunctionIDs() {
+			if id == subscribed {
+				return &function.ErrFunctionHasSubscriptions{}
+			}
 		}
 	}
 
@@ -166,7 +168,16 @@ func validateFunction(fn *function.Function) error {
 		return &function.ErrFunctionValidation{Message: err.Error()}
 	}
 
-	return nil
+	// provider with secret references is loaded again as secrets have to exist in the function space
+	err = fn.LoadProvider()
+	if _, ok := err.(*function.ErrFunctionValidation); ok {
+		return err
+	}
+	if err != nil {
+		return &function.ErrFunctionValidation{Message: err.Error()}
+	}
+
+	return fn.ValidateCredentials()
 }
 
 // functionIDValidator validates if field contains allowed characters for function ID
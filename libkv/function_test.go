@@ -8,6 +8,7 @@ import (
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/metadata"
 	"github.com/serverless/event-gateway/mock"
+	"github.com/serverless/event-gateway/providers/awslambda"
 	"github.com/serverless/event-gateway/providers/http"
 	"github.com/serverless/libkv/store"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,18 @@ func TestCreateFunction(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("inline credentials rejected", func(t *testing.T) {
+		service := &Service{Log: zap.NewNop()}
+
+		_, err := service.CreateFunction(&function.Function{
+			ID:           "testid",
+			ProviderType: awslambda.Type,
+			Provider:     &awslambda.AWSLambda{ARN: "arn", Region: "us-east-1", AWSSecretAccessKey: "s3cr3t"},
+		})
+
+		assert.Equal(t, &function.ErrFunctionValidation{Message: "awsSecretAccessKey has to be a secret reference"}, err)
+	})
+
 	t.Run("function already exists", func(t *testing.T) {
 		db := mock.NewMockStore(ctrl)
 		db.EXPECT().Get("default/testid", gomock.Any()).Return(nil, nil)
@@ -262,6 +275,19 @@ func TestDeleteFunction(t *testing.T) {
 		assert.Equal(t, err, &function.ErrFunctionHasSubscriptions{})
 	})
 
+	t.Run("function is subscription target", func(t *testing.T) {
+		kvs := []*store.KVPair{
+			{Value: []byte(`{"subscriptionId":"s1","functionId":"stable","targets":[{"functionId":"testid","weight":10}]}`)}}
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().List("default/", &store.ReadOptions{Consistent: true}).Return(kvs, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		service := &Service{FunctionStore: functionsDB, SubscriptionStore: subscriptionsDB, Log: zap.NewNop()}
+
+		err := service.DeleteFunction("default", function.ID("testid"))
+
+		assert.Equal(t, err, &function.ErrFunctionHasSubscriptions{})
+	})
+
 	t.Run("function is authorizer", func(t *testing.T) {
 		kvs := []*store.KVPair{
 			{Value: []byte(`{"name":"test.event.noauth"}`)},
@@ -0,0 +1,170 @@
+package libkv
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"go.uber.org/zap"
+
+	"github.com/serverless/event-gateway/metadata"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/libkv/store"
+)
+
+// SchemaKey is a key under which schema data is stored KV store.
+type SchemaKey struct {
+	Space string
+	ID    schema.ID
+}
+
+func (key SchemaKey) String() string {
+	return key.Space + "/" + string(key.ID)
+}
+
+// CreateSchema registers schema in configuration.
+func (service Service) CreateSchema(s *schema.Schema) (*schema.Schema, error) {
+	if err := validateSchema(s); err != nil {
+		return nil, err
+	}
+
+	_, err := service.SchemaStore.Get(SchemaKey{Space: s.Space, ID: s.ID}.String(), &store.ReadOptions{Consistent: true})
+	if err == nil {
+		return nil, &schema.ErrSchemaAlreadyExists{ID: s.ID}
+	}
+
+	byt, err := json.Marshal(s)
+	if err != nil {
+		return nil, &schema.ErrSchemaValidation{Message: err.Error()}
+	}
+
+	_, _, err = service.SchemaStore.AtomicPut(SchemaKey{Space: s.Space, ID: s.ID}.String(), byt, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	service.Log.Debug("Schema created.", zap.Object("schema", s))
+
+	return s, nil
+}
+
+// GetSchema returns schema from configuration.
+func (service Service) GetSchema(space string, id schema.ID) (*schema.Schema, error) {
+	kv, err := service.SchemaStore.Get(SchemaKey{Space: space, ID: id}.String(), &store.ReadOptions{Consistent: true})
+	if err != nil {
+		if err.Error() == errKeyNotFound {
+			return nil, &schema.ErrSchemaNotFound{ID: id}
+		}
+		return nil, err
+	}
+
+	s := schema.Schema{}
+	dec := json.NewDecoder(bytes.NewReader(kv.Value))
+	err = dec.Decode(&s)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// ListSchemas returns an array of all schemas in the space.
+func (service Service) ListSchemas(space string, filters ...metadata.Filter) (schema.Schemas, error) {
+	schemas := []*schema.Schema{}
+
+	kvs, err := service.SchemaStore.List(spacePath(space), &store.ReadOptions{Consistent: true})
+	if err != nil && err.Error() != errKeyNotFound {
+		return nil, err
+	}
+
+	for _, kv := range kvs {
+		s := &schema.Schema{}
+		dec := json.NewDecoder(bytes.NewReader(kv.Value))
+		err = dec.Decode(s)
+		if err != nil {
+			return nil, err
+		}
+
+		if !s.Metadata.Check(filters...) {
+			continue
+		}
+		schemas = append(schemas, s)
+	}
+
+	return schema.Schemas(schemas), nil
+}
+
+// UpdateSchema updates schema configuration.
+func (service Service) UpdateSchema(s *schema.Schema) (*schema.Schema, error) {
+	if err := validateSchema(s); err != nil {
+		return nil, err
+	}
+
+	_, err := service.GetSchema(s.Space, s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := json.Marshal(s)
+	if err != nil {
+		return nil, &schema.ErrSchemaValidation{Message: err.Error()}
+	}
+
+	err = service.SchemaStore.Put(SchemaKey{Space: s.Space, ID: s.ID}.String(), buf, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	service.Log.Debug("Schema updated.", zap.Object("schema", s))
+
+	return s, nil
+}
+
+// DeleteSchema deletes schema from the configuration.
+func (service Service) DeleteSchema(space string, id schema.ID) error {
+	types, err := service.ListEventTypes(space)
+	if err != nil {
+		return err
+	}
+	for _, eventType := range types {
+		if eventType.SchemaID != nil && *eventType.SchemaID == id {
+			return &schema.ErrSchemaInUse{ID: id, EventType: string(eventType.Name)}
+		}
+	}
+
+	err = service.SchemaStore.Delete(SchemaKey{Space: space, ID: id}.String())
+	if err != nil {
+		return &schema.ErrSchemaNotFound{ID: id}
+	}
+
+	service.Log.Debug("Schema deleted.", zap.String("space", space), zap.String("schemaId", string(id)))
+
+	return nil
+}
+
+func validateSchema(s *schema.Schema) error {
+	if s.Space == "" {
+		s.Space = defaultSpace
+	}
+
+	validate := validator.New()
+	validate.RegisterValidation("schemaid", schemaIDValidator)
+	validate.RegisterValidation("space", spaceValidator)
+	err := validate.Struct(s)
+	if err != nil {
+		return &schema.ErrSchemaValidation{Message: err.Error()}
+	}
+
+	err = s.Compile()
+	if err != nil {
+		return &schema.ErrSchemaValidation{Message: err.Error()}
+	}
+
+	return nil
+}
+
+// schemaIDValidator validates if field contains allowed characters for schema ID
+func schemaIDValidator(fl validator.FieldLevel) bool {
+	return regexp.MustCompile(`^[a-zA-Z0-9\.\-_]+$`).MatchString(fl.Field().String())
+}
@@ -0,0 +1,120 @@
+package libkv
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/serverless/event-gateway/mock"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/libkv/store"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+const testAvroDefinition = `{"type":"record","name":"User","fields":[{"name":"name","type":"string"}]}`
+
+func TestCreateSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testSchema := &schema.Schema{ID: "user", Format: schema.FormatAvro, Definition: testAvroDefinition}
+
+	t.Run("schema created", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().
+			Get("default/user", &store.ReadOptions{Consistent: true}).
+			Return(nil, errors.New("KV schema not found"))
+		db.EXPECT().AtomicPut("default/user", gomock.Any(), nil, nil).Return(true, nil, nil)
+		service := &Service{SchemaStore: db, Log: zap.NewNop()}
+
+		_, err := service.CreateSchema(testSchema)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("schema already exists", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+		service := &Service{SchemaStore: db, Log: zap.NewNop()}
+
+		_, err := service.CreateSchema(testSchema)
+
+		assert.Equal(t, &schema.ErrSchemaAlreadyExists{ID: "user"}, err)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		service := &Service{Log: zap.NewNop()}
+
+		_, err := service.CreateSchema(&schema.Schema{ID: "user", Format: schema.FormatAvro})
+
+		assert.Equal(t, &schema.ErrSchemaValidation{
+			Message: "Key: 'Schema.Definition' Error:Field validation for 'Definition' failed on the 'required' tag",
+		}, err)
+	})
+
+	t.Run("invalid definition", func(t *testing.T) {
+		service := &Service{Log: zap.NewNop()}
+
+		_, err := service.CreateSchema(&schema.Schema{ID: "user", Format: schema.FormatAvro, Definition: `"unknown"`})
+
+		assert.Equal(t, &schema.ErrSchemaValidation{Message: `unknown Avro type "unknown"`}, err)
+	})
+}
+
+func TestGetSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("schema returned", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Get("default/user", gomock.Any()).Return(&store.KVPair{
+			Value: []byte(`{"space":"default","schemaId":"user","format":"avro","definition":"\"string\""}`),
+		}, nil)
+		service := &Service{SchemaStore: db, Log: zap.NewNop()}
+
+		s, err := service.GetSchema("default", "user")
+
+		assert.Nil(t, err)
+		assert.Equal(t, &schema.Schema{Space: "default", ID: "user", Format: schema.FormatAvro, Definition: `"string"`}, s)
+	})
+
+	t.Run("schema not found", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Get("default/user", gomock.Any()).Return(nil, errors.New("Key not found in store"))
+		service := &Service{SchemaStore: db, Log: zap.NewNop()}
+
+		_, err := service.GetSchema("default", "user")
+
+		assert.Equal(t, &schema.ErrSchemaNotFound{ID: "user"}, err)
+	})
+}
+
+func TestDeleteSchema(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("schema deleted", func(t *testing.T) {
+		eventTypesDB := mock.NewMockStore(ctrl)
+		eventTypesDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{}, nil)
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Delete("default/user").Return(nil)
+		service := &Service{SchemaStore: db, EventTypeStore: eventTypesDB, Log: zap.NewNop()}
+
+		err := service.DeleteSchema("default", "user")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("schema used by event type", func(t *testing.T) {
+		eventTypesDB := mock.NewMockStore(ctrl)
+		eventTypesDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{{
+			Value: []byte(`{"space":"default","name":"user.created","schemaId":"user"}`),
+		}}, nil)
+		service := &Service{EventTypeStore: eventTypesDB, Log: zap.NewNop()}
+
+		err := service.DeleteSchema("default", "user")
+
+		assert.Equal(t, &schema.ErrSchemaInUse{ID: "user", EventType: "user.created"}, err)
+	})
+}
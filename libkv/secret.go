@@ -0,0 +1,175 @@
+package libkv
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+
+	validator "gopkg.in/go-playground/validator.v9"
+
+	"go.uber.org/zap"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/metadata"
+	"github.com/serverless/event-gateway/secret"
+	"github.com/serverless/libkv/store"
+)
+
+// SecretKey is a key under which secret data is stored KV store.
+type SecretKey struct {
+	Space string
+	Name  secret.Name
+}
+
+func (key SecretKey) String() string {
+	return key.Space + "/" + string(key.Name)
+}
+
+// CreateSecret stores secret in configuration. Returned secret doesn't include value.
+func (service Service) CreateSecret(s *secret.Secret) (*secret.Secret, error) {
+	if err := validateSecret(s); err != nil {
+		return nil, err
+	}
+
+	_, err := service.SecretStore.Get(SecretKey{Space: s.Space, Name: s.Name}.String(), &store.ReadOptions{Consistent: true})
+	if err == nil {
+		return nil, &secret.ErrSecretAlreadyExists{Name: s.Name}
+	}
+
+	byt, err := json.Marshal(s)
+	if err != nil {
+		return nil, &secret.ErrSecretValidation{Message: err.Error()}
+	}
+
+	_, _, err = service.SecretStore.AtomicPut(SecretKey{Space: s.Space, Name: s.Name}.String(), byt, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	service.Log.Debug("Secret created.", zap.Object("secret", s))
+
+	return withoutValue(s), nil
+}
+
+// GetSecret returns secret from configuration. Returned secret doesn't include value.
+func (service Service) GetSecret(space string, name secret.Name) (*secret.Secret, error) {
+	s, err := service.getSecret(space, name)
+	if err != nil {
+		return nil, err
+	}
+	return withoutValue(s), nil
+}
+
+// ListSecrets returns an array of all secrets in the space. Returned secrets don't include values.
+func (service Service) ListSecrets(space string, filters ...metadata.Filter) (secret.Secrets, error) {
+	secrets := []*secret.Secret{}
+
+	kvs, err := service.SecretStore.List(spacePath(space), &store.ReadOptions{Consistent: true})
+	if err != nil && err.Error() != errKeyNotFound {
+		return nil, err
+	}
+
+	for _, kv := range kvs {
+		s := &secret.Secret{}
+		dec := json.NewDecoder(bytes.NewReader(kv.Value))
+		err = dec.Decode(s)
+		if err != nil {
+			return nil, err
+		}
+
+		if !s.Metadata.Check(filters...) {
+			continue
+		}
+		secrets = append(secrets, withoutValue(s))
+	}
+
+	return secret.Secrets(secrets), nil
+}
+
+// DeleteSecret deletes secret from the configuration.
+func (service Service) DeleteSecret(space string, name secret.Name) error {
+	kvs, err := service.FunctionStore.List(spacePath(space), &store.ReadOptions{Consistent: true})
+	if err != nil && err.Error() != errKeyNotFound {
+		return err
+	}
+	for _, kv := range kvs {
+		// raw config is checked so that function providers are not loaded
+		fn := struct {
+			ID     function.ID     `json:"functionId"`
+			Config json.RawMessage `json:"provider"`
+		}{}
+		err = json.Unmarshal(kv.Value, &fn)
+		if err != nil {
+			return err
+		}
+		for _, ref := range function.SecretRefs(fn.Config) {
+			if ref == string(name) {
+				return &secret.ErrSecretInUse{Name: name, FunctionID: string(fn.ID)}
+			}
+		}
+	}
+
+	err = service.SecretStore.Delete(SecretKey{Space: space, Name: name}.String())
+	if err != nil {
+		return &secret.ErrSecretNotFound{Name: name}
+	}
+
+	service.Log.Debug("Secret deleted.", zap.String("space", space), zap.String("name", string(name)))
+
+	return nil
+}
+
+// ResolveSecret returns value of the secret. It's used for resolving secret references in function provider configs.
+func (service Service) ResolveSecret(space, name string) (string, error) {
+	s, err := service.getSecret(space, secret.Name(name))
+	if err != nil {
+		return "", err
+	}
+	return s.Value, nil
+}
+
+func (service Service) getSecret(space string, name secret.Name) (*secret.Secret, error) {
+	kv, err := service.SecretStore.Get(SecretKey{Space: space, Name: name}.String(), &store.ReadOptions{Consistent: true})
+	if err != nil {
+		if err.Error() == errKeyNotFound {
+			return nil, &secret.ErrSecretNotFound{Name: name}
+		}
+		return nil, err
+	}
+
+	s := secret.Secret{}
+	dec := json.NewDecoder(bytes.NewReader(kv.Value))
+	err = dec.Decode(&s)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// withoutValue returns copy of the secret without value.
+func withoutValue(s *secret.Secret) *secret.Secret {
+	c := *s
+	c.Value = ""
+	return &c
+}
+
+func validateSecret(s *secret.Secret) error {
+	if s.Space == "" {
+		s.Space = defaultSpace
+	}
+
+	validate := validator.New()
+	validate.RegisterValidation("secretname", secretNameValidator)
+	validate.RegisterValidation("space", spaceValidator)
+	err := validate.Struct(s)
+	if err != nil {
+		return &secret.ErrSecretValidation{Message: err.Error()}
+	}
+
+	return nil
+}
+
+// secretNameValidator validates if field contains allowed characters for secret name
+func secretNameValidator(fl validator.FieldLevel) bool {
+	return regexp.MustCompile(`^[a-zA-Z0-9\.\-_]+$`).MatchString(fl.Field().String())
+}
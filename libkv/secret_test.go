@@ -0,0 +1,163 @@
+package libkv
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/serverless/event-gateway/mock"
+	"github.com/serverless/event-gateway/secret"
+	"github.com/serverless/libkv/store"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestCreateSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("secret created", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().
+			Get("default/awskey", &store.ReadOptions{Consistent: true}).
+			Return(nil, errors.New("Key not found in store"))
+		db.EXPECT().
+			AtomicPut("default/awskey", []byte(`{"space":"default","name":"awskey","value":"s3cr3t"}`), nil, nil).
+			Return(true, nil, nil)
+		service := &Service{SecretStore: db, Log: zap.NewNop()}
+
+		s, err := service.CreateSecret(&secret.Secret{Name: "awskey", Value: "s3cr3t"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &secret.Secret{Space: "default", Name: "awskey"}, s)
+	})
+
+	t.Run("secret already exists", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, nil)
+		service := &Service{SecretStore: db, Log: zap.NewNop()}
+
+		_, err := service.CreateSecret(&secret.Secret{Name: "awskey", Value: "s3cr3t"})
+
+		assert.Equal(t, &secret.ErrSecretAlreadyExists{Name: "awskey"}, err)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		service := &Service{Log: zap.NewNop()}
+
+		_, err := service.CreateSecret(&secret.Secret{Name: "aws/key"})
+
+		assert.Equal(t, &secret.ErrSecretValidation{
+			Message: "Key: 'Secret.Name' Error:Field validation for 'Name' failed on the 'secretname' tag" +
+				"\nKey: 'Secret.Value' Error:Field validation for 'Value' failed on the 'required' tag",
+		}, err)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("secret returned without value", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().
+			Get("default/awskey", &store.ReadOptions{Consistent: true}).
+			Return(&store.KVPair{Value: []byte(`{"space":"default","name":"awskey","value":"s3cr3t"}`)}, nil)
+		service := &Service{SecretStore: db, Log: zap.NewNop()}
+
+		s, err := service.GetSecret("default", "awskey")
+
+		assert.Nil(t, err)
+		assert.Equal(t, &secret.Secret{Space: "default", Name: "awskey"}, s)
+	})
+
+	t.Run("secret not found", func(t *testing.T) {
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("Key not found in store"))
+		service := &Service{SecretStore: db, Log: zap.NewNop()}
+
+		_, err := service.GetSecret("default", "awskey")
+
+		assert.Equal(t, &secret.ErrSecretNotFound{Name: "awskey"}, err)
+	})
+}
+
+func TestListSecrets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock.NewMockStore(ctrl)
+	db.EXPECT().List("default/", &store.ReadOptions{Consistent: true}).Return([]*store.KVPair{
+		{Value: []byte(`{"space":"default","name":"awskey","value":"s3cr3t"}`)},
+		{Value: []byte(`{"space":"default","name":"token","value":"t0k3n"}`)},
+	}, nil)
+	service := &Service{SecretStore: db, Log: zap.NewNop()}
+
+	secrets, err := service.ListSecrets("default")
+
+	assert.Nil(t, err)
+	assert.Equal(t, secret.Secrets{
+		{Space: "default", Name: "awskey"},
+		{Space: "default", Name: "token"},
+	}, secrets)
+}
+
+func TestDeleteSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("secret deleted", func(t *testing.T) {
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{
+			{Value: []byte(`{"functionId":"func","type":"http","provider":{"url":"http://example.com"}}`)},
+		}, nil)
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Delete("default/awskey").Return(nil)
+		service := &Service{SecretStore: db, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		err := service.DeleteSecret("default", "awskey")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("secret in use", func(t *testing.T) {
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{
+			{Value: []byte(`{"functionId":"func","type":"awslambda",` +
+				`"provider":{"arn":"arn","region":"us-east-1","awsSecretAccessKey":{"secretRef":"awskey"}}}`)},
+		}, nil)
+		service := &Service{FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		err := service.DeleteSecret("default", "awskey")
+
+		assert.Equal(t, &secret.ErrSecretInUse{Name: "awskey", FunctionID: "func"}, err)
+	})
+
+	t.Run("secret not found", func(t *testing.T) {
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().List("default/", gomock.Any()).Return(nil, errors.New("Key not found in store"))
+		db := mock.NewMockStore(ctrl)
+		db.EXPECT().Delete("default/awskey").Return(errors.New("Key not found in store"))
+		service := &Service{SecretStore: db, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		err := service.DeleteSecret("default", "awskey")
+
+		assert.Equal(t, &secret.ErrSecretNotFound{Name: "awskey"}, err)
+	})
+}
+
+func TestResolveSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock.NewMockStore(ctrl)
+	db.EXPECT().
+		Get("default/awskey", &store.ReadOptions{Consistent: true}).
+		Return(&store.KVPair{Value: []byte(`{"space":"default","name":"awskey","value":"s3cr3t"}`)}, nil)
+	service := &Service{SecretStore: db, Log: zap.NewNop()}
+
+	value, err := service.ResolveSecret("default", "awskey")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", value)
+}
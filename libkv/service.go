@@ -3,6 +3,8 @@ package libkv
 import (
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/event-gateway/secret"
 	"github.com/serverless/event-gateway/subscription"
 	"github.com/serverless/event-gateway/subscription/cors"
 	"github.com/serverless/libkv/store"
@@ -15,6 +17,8 @@ type Service struct {
 	FunctionStore     store.Store
 	SubscriptionStore store.Store
 	CORSStore         store.Store
+	SchemaStore       store.Store
+	SecretStore       store.Store
 	Log               *zap.Logger
 }
 
@@ -22,3 +26,6 @@ var _ event.Service = (*Service)(nil)
 var _ function.Service = (*Service)(nil)
 var _ subscription.Service = (*Service)(nil)
 var _ cors.Service = (*Service)(nil)
+var _ schema.Service = (*Service)(nil)
+var _ secret.Service = (*Service)(nil)
+var _ function.SecretResolver = (*Service)(nil)
@@ -8,11 +8,13 @@ import (
 	"net/url"
 	"path"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/internal/pathtree"
+	intstore "github.com/serverless/event-gateway/internal/store"
 	istrings "github.com/serverless/event-gateway/internal/strings"
 	"github.com/serverless/event-gateway/metadata"
 	"github.com/serverless/event-gateway/subscription"
@@ -27,15 +29,22 @@ func (service Service) CreateSubscription(sub *subscription.Subscription) (*subs
 	if err != nil {
 		return nil, err
 	}
-	sub.ID = newSubscriptionID(sub)
-	_, err = service.SubscriptionStore.Get(subscriptionPath(sub.Space, sub.ID), &store.ReadOptions{Consistent: true})
-	if err == nil {
-		return nil, &subscription.ErrSubscriptionAlreadyExists{
-			ID: sub.ID,
+
+	if sub.Type == subscription.TypeAsync {
+		sub.ID = service.unusedSubscriptionID(sub)
+		err = service.checkForDuplicate(sub.Space, []*subscription.Subscription{sub})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		sub.ID = newSubscriptionID(sub, 0)
+		_, err = service.SubscriptionStore.Get(subscriptionPath(sub.Space, sub.ID), &store.ReadOptions{Consistent: true})
+		if err == nil {
+			return nil, &subscription.ErrSubscriptionAlreadyExists{
+				ID: sub.ID,
+			}
 		}
-	}
 
-	if sub.Type == subscription.TypeSync {
 		err = service.checkForPathConflict(sub.Space, sub.Method, sub.Path, sub.EventType)
 		if err != nil {
 			return nil, err
@@ -47,9 +56,11 @@ func (service Service) CreateSubscription(sub *subscription.Subscription) (*subs
 		return nil, err
 	}
 
-	_, err = service.GetFunction(sub.Space, sub.FunctionID)
-	if err != nil {
-		return nil, err
+	for _, id := range sub.FunctionIDs() {
+		_, err = service.GetFunction(sub.Space, id)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	buf, err := json.Marshal(sub)
@@ -66,13 +77,13 @@ func (service Service) CreateSubscription(sub *subscription.Subscription) (*subs
 	return sub, nil
 }
 
-// UpdateSubscription updates subscription.
+// UpdateSubscription updates subscription. Subscription retargeted to a different function keeps its ID.
 func (service Service) UpdateSubscription(id subscription.ID, newSub *subscription.Subscription) (*subscription.Subscription, error) {
 	if err := validateSubscription(newSub); err != nil {
 		return nil, err
 	}
 
-	oldSub, err := service.GetSubscription(newSub.Space, id)
+	oldSub, kv, err := service.getSubscription(newSub.Space, id)
 	if err != nil {
 		return nil, err
 	}
@@ -82,9 +93,19 @@ func (service Service) UpdateSubscription(id subscription.ID, newSub *subscripti
 		return nil, err
 	}
 
-	_, err = service.GetFunction(newSub.Space, newSub.FunctionID)
-	if err != nil {
-		return nil, err
+	newSub.ID = id
+	if newSub.FunctionID != oldSub.FunctionID {
+		err = service.checkForDuplicate(newSub.Space, []*subscription.Subscription{newSub})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range newSub.FunctionIDs() {
+		_, err = service.GetFunction(newSub.Space, id)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	buf, err := json.Marshal(newSub)
@@ -92,7 +113,10 @@ func (service Service) UpdateSubscription(id subscription.ID, newSub *subscripti
 		return nil, &subscription.ErrSubscriptionValidation{Message: err.Error()}
 	}
 
-	err = service.SubscriptionStore.Put(subscriptionPath(newSub.Space, newSub.ID), buf, nil)
+	ok, _, err := service.SubscriptionStore.AtomicPut(subscriptionPath(newSub.Space, id), buf, kv, nil)
+	if err == store.ErrKeyModified || (err == nil && !ok) {
+		return nil, &subscription.ErrSubscriptionsModified{}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +125,126 @@ func (service Service) UpdateSubscription(id subscription.ID, newSub *subscripti
 	return newSub, nil
 }
 
+// SwapSubscriptions changes functions of subscriptions in a single transaction. Either all subscriptions are
+// retargeted or none of them. Subscriptions keep their IDs.
+func (service Service) SwapSubscriptions(space string, retargets []subscription.Retarget) (subscription.Subscriptions, error) {
+	err := validateRetargets(retargets)
+	if err != nil {
+		return nil, err
+	}
+
+	txn, ok := service.SubscriptionStore.(intstore.Transactional)
+	if !ok {
+		return nil, intstore.ErrTransactionsNotSupported
+	}
+
+	subs := subscription.Subscriptions{}
+	changed := []*subscription.Subscription{}
+	ops := []intstore.Op{}
+	for _, r := range retargets {
+		sub, kv, err := service.getSubscription(space, r.SubscriptionID)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+		if sub.FunctionID == r.FunctionID {
+			continue
+		}
+
+		sub.FunctionID = r.FunctionID
+		err = validateTargets(sub)
+		if err != nil {
+			return nil, err
+		}
+		_, err = service.GetFunction(space, sub.FunctionID)
+		if err != nil {
+			return nil, err
+		}
+
+		buf, err := json.Marshal(sub)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, intstore.Op{Key: subscriptionPath(space, r.SubscriptionID), Value: buf, Previous: kv})
+		changed = append(changed, sub)
+	}
+
+	err = service.checkForDuplicate(space, changed)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ops) > 0 {
+		ok, err = txn.AtomicPutAll(ops)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &subscription.ErrSubscriptionsModified{}
+		}
+	}
+
+	service.Log.Debug("Subscriptions swapped.", zap.String("space", space), zap.Int("count", len(ops)))
+	return subs, nil
+}
+
+// checkForDuplicate checks that async subscriptions don't duplicate other async subscriptions with the same event
+// type, function, path and method. ID of retargeted async subscription doesn't match its function so subscriptions
+// are compared by content. Given subscriptions are compared in their new state so functions can be exchanged.
+func (service Service) checkForDuplicate(space string, subs []*subscription.Subscription) error {
+	all := subscription.Subscriptions{}
+	changed := map[subscription.ID]bool{}
+	for _, sub := range subs {
+		if sub.Type == subscription.TypeAsync {
+			all = append(all, sub)
+			changed[sub.ID] = true
+		}
+	}
+	if len(all) == 0 {
+		return nil
+	}
+
+	existing, err := service.ListSubscriptions(space)
+	if err != nil {
+		return err
+	}
+	for _, sub := range existing {
+		if !changed[sub.ID] {
+			all = append(all, sub)
+		}
+	}
+
+	seen := map[subscription.ID]subscription.ID{}
+	for _, sub := range all {
+		if sub.Type != subscription.TypeAsync {
+			continue
+		}
+
+		content := newSubscriptionID(sub, 0)
+		if id, ok := seen[content]; ok {
+			if changed[id] {
+				id = sub.ID
+			}
+			return &subscription.ErrSubscriptionAlreadyExists{ID: id}
+		}
+		seen[content] = sub.ID
+	}
+
+	return nil
+}
+
+// unusedSubscriptionID returns ID of new async subscription. ID derived from the subscription can already be used by
+// a subscription retargeted to a different function, in which case a counter is added to it.
+func (service Service) unusedSubscriptionID(sub *subscription.Subscription) subscription.ID {
+	for n := 0; ; n++ {
+		id := newSubscriptionID(sub, n)
+		_, err := service.SubscriptionStore.Get(subscriptionPath(sub.Space, id), &store.ReadOptions{Consistent: true})
+		if err != nil {
+			return id
+		}
+	}
+}
+
 // DeleteSubscription deletes subscription.
 func (service Service) DeleteSubscription(space string, id subscription.ID) error {
 	sub, err := service.GetSubscription(space, id)
@@ -145,22 +289,28 @@ func (service Service) ListSubscriptions(space string, filters ...metadata.Filte
 
 // GetSubscription return single subscription.
 func (service Service) GetSubscription(space string, id subscription.ID) (*subscription.Subscription, error) {
+	sub, _, err := service.getSubscription(space, id)
+	return sub, err
+}
+
+// getSubscription returns subscription with the KV pair it was read from.
+func (service Service) getSubscription(space string, id subscription.ID) (*subscription.Subscription, *store.KVPair, error) {
 	rawsub, err := service.SubscriptionStore.Get(subscriptionPath(space, id), &store.ReadOptions{Consistent: true})
 	if err != nil {
 		if err.Error() == errKeyNotFound {
-			return nil, &subscription.ErrSubscriptionNotFound{ID: id}
+			return nil, nil, &subscription.ErrSubscriptionNotFound{ID: id}
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	sub := &subscription.Subscription{}
 	dec := json.NewDecoder(bytes.NewReader(rawsub.Value))
 	err = dec.Decode(sub)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return sub, err
+	return sub, rawsub, nil
 }
 
 func (service Service) checkForPathConflict(space, method, path string, eventType event.TypeName) error {
@@ -210,6 +360,52 @@ func validateSubscription(sub *subscription.Subscription) error {
 		return &subscription.ErrSubscriptionValidation{Message: err.Error()}
 	}
 
+	return validateTargets(sub)
+}
+
+// validateTargets checks that targets don't repeat functions and their weights don't exceed 100%.
+func validateTargets(sub *subscription.Subscription) error {
+	ids := map[function.ID]bool{}
+	var weights uint
+	for _, id := range sub.FunctionIDs() {
+		if ids[id] {
+			return &subscription.ErrSubscriptionValidation{Message: "function " + string(id) + " is targeted more than once"}
+		}
+		ids[id] = true
+	}
+	for _, target := range sub.Targets {
+		weights += target.Weight
+	}
+	if weights > 100 {
+		return &subscription.ErrSubscriptionValidation{Message: "sum of target weights cannot exceed 100"}
+	}
+
+	if sub.Sticky != nil && (sub.Sticky.Header == "") == (sub.Sticky.Attribute == "") {
+		return &subscription.ErrSubscriptionValidation{Message: "sticky routing requires either header or attribute"}
+	}
+
+	return nil
+}
+
+// validateRetargets checks that swap retargets at least one subscription and every subscription only once.
+func validateRetargets(retargets []subscription.Retarget) error {
+	if len(retargets) == 0 {
+		return &subscription.ErrSubscriptionValidation{Message: "at least one subscription is required"}
+	}
+
+	validate := validator.New()
+	ids := map[subscription.ID]bool{}
+	for _, retarget := range retargets {
+		err := validate.Struct(retarget)
+		if err != nil {
+			return &subscription.ErrSubscriptionValidation{Message: err.Error()}
+		}
+		if ids[retarget.SubscriptionID] {
+			return &subscription.ErrSubscriptionValidation{Message: "subscription " + string(retarget.SubscriptionID) + " is swapped more than once"}
+		}
+		ids[retarget.SubscriptionID] = true
+	}
+
 	return nil
 }
 
@@ -264,13 +460,17 @@ func isPathInConflict(existing, new string) bool {
 	return true
 }
 
-func newSubscriptionID(sub *subscription.Subscription) subscription.ID {
+// newSubscriptionID returns ID derived from the subscription. Non-zero n is added to the ID to make it unique.
+func newSubscriptionID(sub *subscription.Subscription, n int) subscription.ID {
 	var raw string
 	if sub.Type == subscription.TypeAsync {
 		raw = string(sub.Type) + "," + string(sub.EventType) + "," + string(sub.FunctionID) + "," + url.PathEscape(sub.Path) + "," + sub.Method
 	} else {
 		raw = string(sub.Type) + "," + string(sub.EventType) + "," + url.PathEscape(sub.Path) + "," + sub.Method
 	}
+	if n > 0 {
+		raw += "," + strconv.Itoa(n)
+	}
 
 	return subscription.ID(base64.RawURLEncoding.EncodeToString([]byte(raw)))
 }
@@ -296,9 +496,6 @@ func validateSubscriptionUpdate(newSub *subscription.Subscription, oldSub *subsc
 	if newSub.EventType != oldSub.EventType {
 		return &subscription.ErrInvalidSubscriptionUpdate{Field: "EventType"}
 	}
-	if newSub.FunctionID != oldSub.FunctionID {
-		return &subscription.ErrInvalidSubscriptionUpdate{Field: "FunctionID"}
-	}
 	if newSub.Path != oldSub.Path {
 		return &subscription.ErrInvalidSubscriptionUpdate{Field: "Path"}
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
+	intstore "github.com/serverless/event-gateway/internal/store"
 	"github.com/serverless/event-gateway/metadata"
 	"github.com/serverless/event-gateway/mock"
 	"github.com/serverless/event-gateway/subscription"
@@ -43,6 +44,7 @@ func TestCreateSubscription(t *testing.T) {
 		eventTypesDB.EXPECT().Get("default/user.created", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: asyncEventPayload}, nil)
 		subscriptionsDB := mock.NewMockStore(ctrl)
 		subscriptionsDB.EXPECT().Get(asyncKey, &store.ReadOptions{Consistent: true}).Return(nil, errors.New("KV sub not found"))
+		subscriptionsDB.EXPECT().List("default/", &store.ReadOptions{Consistent: true}).Return([]*store.KVPair{}, nil)
 		subscriptionsDB.EXPECT().AtomicPut(asyncKey, asyncValue, nil, nil).Return(true, nil, nil)
 		functionsDB := mock.NewMockStore(ctrl)
 		functionsDB.EXPECT().Get("default/func", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: funcValue}, nil)
@@ -88,14 +90,90 @@ func TestCreateSubscription(t *testing.T) {
 				"\nKey: 'Subscription.FunctionID' Error:Field validation for 'FunctionID' failed on the 'required' tag"})
 	})
 
-	t.Run("subscription already exists", func(t *testing.T) {
+	t.Run("targets validation error", func(t *testing.T) {
+		subs := &Service{Log: zap.NewNop()}
+		for _, testCase := range []struct {
+			targets []subscription.Target
+			sticky  *subscription.Sticky
+			message string
+		}{
+			{
+				[]subscription.Target{{FunctionID: "canary", Weight: 60}, {FunctionID: "beta", Weight: 50}},
+				nil,
+				"sum of target weights cannot exceed 100",
+			},
+			{
+				[]subscription.Target{{FunctionID: "func", Weight: 10}},
+				nil,
+				"function func is targeted more than once",
+			},
+			{
+				[]subscription.Target{{FunctionID: "canary", Weight: 10}},
+				&subscription.Sticky{},
+				"sticky routing requires either header or attribute",
+			},
+		} {
+			sub := *asyncSub
+			sub.Targets = testCase.targets
+			sub.Sticky = testCase.sticky
+
+			_, err := subs.CreateSubscription(&sub)
+
+			assert.Equal(t, &subscription.ErrSubscriptionValidation{Message: testCase.message}, err)
+		}
+	})
+
+	t.Run("async subscription created with unique ID if derived ID is used by retargeted subscription", func(t *testing.T) {
+		retargetedValue := []byte(
+			`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVA",` +
+				`"type":"async","eventType":"user.created","functionId":"other","path":"/","method":"GET"}`)
+		eventTypesDB := mock.NewMockStore(ctrl)
+		eventTypesDB.EXPECT().Get("default/user.created", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: asyncEventPayload}, nil)
 		subscriptionsDB := mock.NewMockStore(ctrl)
-		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: []byte(`{"subscriptionId":""}`)}, nil)
+		subscriptionsDB.EXPECT().Get(asyncKey, &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: retargetedValue}, nil)
+		subscriptionsDB.EXPECT().Get("default/YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVCwx", &store.ReadOptions{Consistent: true}).Return(nil, errors.New("KV sub not found"))
+		subscriptionsDB.EXPECT().List("default/", &store.ReadOptions{Consistent: true}).Return([]*store.KVPair{{Value: retargetedValue}}, nil)
+		subscriptionsDB.EXPECT().AtomicPut(
+			"default/YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVCwx",
+			[]byte(`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVCwx",`+
+				`"type":"async","eventType":"user.created","functionId":"func","path":"/","method":"GET"}`),
+			nil,
+			nil).Return(true, nil, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/func", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{
+			EventTypeStore:    eventTypesDB,
+			SubscriptionStore: subscriptionsDB,
+			FunctionStore:     functionsDB,
+			Log:               zap.NewNop()}
+
+		sub, err := subs.CreateSubscription(asyncSub)
+
+		assert.Nil(t, err)
+		assert.Equal(t, subscription.ID("YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVCwx"), sub.ID)
+	})
+
+	t.Run("async subscription already exists", func(t *testing.T) {
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(asyncKey, gomock.Any()).Return(nil, errors.New("KV sub not found"))
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{{Value: []byte(
+			`{"space":"default","subscriptionId":"retargeted",` +
+				`"type":"async","eventType":"user.created","functionId":"func","path":"/","method":"GET"}`)}}, nil)
 		subs := &Service{SubscriptionStore: subscriptionsDB, Log: zap.NewNop()}
 
 		_, err := subs.CreateSubscription(asyncSub)
 
-		assert.Equal(t, err, &subscription.ErrSubscriptionAlreadyExists{ID: "YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVA"})
+		assert.Equal(t, err, &subscription.ErrSubscriptionAlreadyExists{ID: "retargeted"})
+	})
+
+	t.Run("sync subscription already exists", func(t *testing.T) {
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: []byte(`{"subscriptionId":""}`)}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, Log: zap.NewNop()}
+
+		_, err := subs.CreateSubscription(syncSub)
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionAlreadyExists{ID: "c3luYyxodHRwLnJlcXVlc3QsJTJGLFBPU1Q"})
 	})
 
 	t.Run("subscription path conflict", func(t *testing.T) {
@@ -124,6 +202,7 @@ func TestCreateSubscription(t *testing.T) {
 		eventTypesDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("Key not found in store"))
 		subscriptionsDB := mock.NewMockStore(ctrl)
 		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("KV sub not found"))
+		subscriptionsDB.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*store.KVPair{}, nil)
 		subs := &Service{SubscriptionStore: subscriptionsDB, EventTypeStore: eventTypesDB, Log: zap.NewNop()}
 
 		_, err := subs.CreateSubscription(asyncSub)
@@ -136,6 +215,7 @@ func TestCreateSubscription(t *testing.T) {
 		eventTypesDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: asyncEventPayload}, nil)
 		subscriptionsDB := mock.NewMockStore(ctrl)
 		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("KV sub not found"))
+		subscriptionsDB.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*store.KVPair{}, nil)
 		functionsDB := mock.NewMockStore(ctrl)
 		functionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("Key not found in store"))
 		subs := &Service{
@@ -149,6 +229,28 @@ func TestCreateSubscription(t *testing.T) {
 		assert.Equal(t, err, &function.ErrFunctionNotFound{ID: "func"})
 	})
 
+	t.Run("target function not found error", func(t *testing.T) {
+		eventTypesDB := mock.NewMockStore(ctrl)
+		eventTypesDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: asyncEventPayload}, nil)
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, errors.New("KV sub not found"))
+		subscriptionsDB.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*store.KVPair{}, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/func", gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
+		functionsDB.EXPECT().Get("default/canary", gomock.Any()).Return(nil, errors.New("Key not found in store"))
+		subs := &Service{
+			EventTypeStore:    eventTypesDB,
+			SubscriptionStore: subscriptionsDB,
+			FunctionStore:     functionsDB,
+			Log:               zap.NewNop()}
+		sub := *asyncSub
+		sub.Targets = []subscription.Target{{FunctionID: "canary", Weight: 10}}
+
+		_, err := subs.CreateSubscription(&sub)
+
+		assert.Equal(t, err, &function.ErrFunctionNotFound{ID: "canary"})
+	})
+
 	t.Run("KV Put error", func(t *testing.T) {
 		eventTypesDB := mock.NewMockStore(ctrl)
 		eventTypesDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: asyncEventPayload}, nil)
@@ -183,14 +285,16 @@ func TestUpdateSubscription(t *testing.T) {
 	funcValue := []byte(`{"functionId":"func","type":"http","provider":{"url": "http://test.com"}}}`)
 
 	t.Run("subscription updated", func(t *testing.T) {
+		kv := &store.KVPair{Value: syncValue, LastIndex: 10}
 		subscriptionsDB := mock.NewMockStore(ctrl)
-		subscriptionsDB.EXPECT().Get(syncKey, &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: syncValue}, nil)
-		subscriptionsDB.EXPECT().Put(
+		subscriptionsDB.EXPECT().Get(syncKey, &store.ReadOptions{Consistent: true}).Return(kv, nil)
+		subscriptionsDB.EXPECT().AtomicPut(
 			syncKey,
 			[]byte(
 				`{"space":"default","subscriptionId":"c3luYyxodHRwLnJlcXVlc3QsZnVuYywlMkYsUE9TVA","type":"sync",`+
 					`"eventType":"http.request","functionId":"func","path":"/","method":"POST"}`),
-			nil).Return(nil)
+			kv,
+			nil).Return(true, nil, nil)
 		functionsDB := mock.NewMockStore(ctrl)
 		functionsDB.EXPECT().Get("default/func", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: funcValue}, nil)
 		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
@@ -224,6 +328,34 @@ func TestUpdateSubscription(t *testing.T) {
 		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: syncValue}, nil)
 		subs := &Service{SubscriptionStore: subscriptionsDB, Log: zap.NewNop()}
 		_, err := subs.UpdateSubscription(
+			syncID,
+			&subscription.Subscription{
+				ID:         syncID,
+				Type:       subscription.TypeSync,
+				EventType:  "http.request",
+				FunctionID: "func",
+				Path:       "/foo",
+				Method:     "POST"})
+
+		assert.Equal(t, err, &subscription.ErrInvalidSubscriptionUpdate{Field: "Path"})
+	})
+
+	t.Run("function retargeted", func(t *testing.T) {
+		kv := &store.KVPair{Value: syncValue, LastIndex: 10}
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(syncKey, &store.ReadOptions{Consistent: true}).Return(kv, nil)
+		subscriptionsDB.EXPECT().AtomicPut(
+			syncKey,
+			[]byte(
+				`{"space":"default","subscriptionId":"c3luYyxodHRwLnJlcXVlc3QsZnVuYywlMkYsUE9TVA","type":"sync",`+
+					`"eventType":"http.request","functionId":"func2","path":"/","method":"POST"}`),
+			kv,
+			nil).Return(true, nil, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/func2", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		sub, err := subs.UpdateSubscription(
 			syncID,
 			&subscription.Subscription{
 				ID:         syncID,
@@ -233,7 +365,96 @@ func TestUpdateSubscription(t *testing.T) {
 				Path:       "/",
 				Method:     "POST"})
 
-		assert.Equal(t, err, &subscription.ErrInvalidSubscriptionUpdate{Field: "FunctionID"})
+		assert.Nil(t, err)
+		assert.Equal(t, syncID, sub.ID)
+	})
+
+	asyncID := subscription.ID("YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVA")
+	asyncKey := "default/YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVA"
+	asyncValue := []byte(
+		`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVA",` +
+			`"type":"async","eventType":"user.created","functionId":"func","path":"/","method":"GET"}`)
+	retargetedValue := []byte(
+		`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMsJTJGLEdFVA",` +
+			`"type":"async","eventType":"user.created","functionId":"func2","path":"/","method":"GET"}`)
+	retargetedSub := &subscription.Subscription{
+		ID:         asyncID,
+		Type:       subscription.TypeAsync,
+		EventType:  "user.created",
+		FunctionID: "func2",
+		Path:       "/",
+		Method:     "GET",
+	}
+
+	t.Run("async function retargeted", func(t *testing.T) {
+		kv := &store.KVPair{Value: asyncValue, LastIndex: 10}
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(asyncKey, gomock.Any()).Return(kv, nil)
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{kv}, nil)
+		subscriptionsDB.EXPECT().AtomicPut(asyncKey, retargetedValue, kv, nil).Return(true, nil, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/func2", gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+		newSub := *retargetedSub
+		newSub.ID = ""
+
+		sub, err := subs.UpdateSubscription(asyncID, &newSub)
+
+		assert.Nil(t, err)
+		assert.Equal(t, asyncID, sub.ID)
+	})
+
+	t.Run("async function retargeted to already subscribed function", func(t *testing.T) {
+		kv := &store.KVPair{Value: asyncValue}
+		subscribedKV := &store.KVPair{Value: []byte(
+			`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMyLCUyRixHRVQ",` +
+				`"type":"async","eventType":"user.created","functionId":"func2","path":"/","method":"GET"}`)}
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(asyncKey, gomock.Any()).Return(kv, nil)
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{kv, subscribedKV}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, Log: zap.NewNop()}
+		newSub := *retargetedSub
+
+		_, err := subs.UpdateSubscription(asyncID, &newSub)
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionAlreadyExists{ID: "YXN5bmMsdXNlci5jcmVhdGVkLGZ1bmMyLCUyRixHRVQ"})
+	})
+
+	t.Run("async function retargeted concurrently", func(t *testing.T) {
+		kv := &store.KVPair{Value: asyncValue}
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(asyncKey, gomock.Any()).Return(kv, nil)
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{kv}, nil)
+		subscriptionsDB.EXPECT().AtomicPut(asyncKey, retargetedValue, kv, nil).Return(false, nil, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/func2", gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+		newSub := *retargetedSub
+
+		_, err := subs.UpdateSubscription(asyncID, &newSub)
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionsModified{})
+	})
+
+	t.Run("subscription modified concurrently", func(t *testing.T) {
+		subscriptionsDB := mock.NewMockStore(ctrl)
+		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: syncValue}, nil)
+		subscriptionsDB.EXPECT().AtomicPut(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(false, nil, store.ErrKeyModified)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		_, err := subs.UpdateSubscription(
+			syncID,
+			&subscription.Subscription{
+				ID:         syncID,
+				Type:       subscription.TypeSync,
+				EventType:  "http.request",
+				FunctionID: "func",
+				Path:       "/",
+				Method:     "POST"})
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionsModified{})
 	})
 
 	t.Run("subscription not found", func(t *testing.T) {
@@ -277,7 +498,7 @@ func TestUpdateSubscription(t *testing.T) {
 	t.Run("KV Put error", func(t *testing.T) {
 		subscriptionsDB := mock.NewMockStore(ctrl)
 		subscriptionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: syncValue}, nil)
-		subscriptionsDB.EXPECT().Put(gomock.Any(), gomock.Any(), nil).Return(errors.New("KV Put err"))
+		subscriptionsDB.EXPECT().AtomicPut(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(false, nil, errors.New("KV Put err"))
 		functionsDB := mock.NewMockStore(ctrl)
 		functionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
 		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
@@ -296,6 +517,218 @@ func TestUpdateSubscription(t *testing.T) {
 	})
 }
 
+func TestSwapSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	value1 := []byte(`{"space":"default","subscriptionId":"sub1","type":"sync","eventType":"http.request",` +
+		`"functionId":"blue1","path":"/a","method":"POST"}`)
+	value2 := []byte(`{"space":"default","subscriptionId":"sub2","type":"sync","eventType":"http.request",` +
+		`"functionId":"blue2","path":"/b","method":"POST"}`)
+	funcValue := []byte(`{"functionId":"func","type":"http","provider":{"url": "http://test.com"}}}`)
+	retargets := []subscription.Retarget{
+		{SubscriptionID: "sub1", FunctionID: "green1"},
+		{SubscriptionID: "sub2", FunctionID: "green2"},
+	}
+
+	t.Run("subscriptions swapped", func(t *testing.T) {
+		kv1 := &store.KVPair{Key: "default/sub1", Value: value1, LastIndex: 10}
+		kv2 := &store.KVPair{Key: "default/sub2", Value: value2, LastIndex: 12}
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: true}
+		subscriptionsDB.EXPECT().Get("default/sub1", &store.ReadOptions{Consistent: true}).Return(kv1, nil)
+		subscriptionsDB.EXPECT().Get("default/sub2", &store.ReadOptions{Consistent: true}).Return(kv2, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/green1", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: funcValue}, nil)
+		functionsDB.EXPECT().Get("default/green2", &store.ReadOptions{Consistent: true}).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		swapped, err := subs.SwapSubscriptions("default", retargets)
+
+		assert.Nil(t, err)
+		assert.Equal(t, function.ID("green1"), swapped[0].FunctionID)
+		assert.Equal(t, function.ID("green2"), swapped[1].FunctionID)
+		assert.Equal(t, []intstore.Op{
+			{
+				Key: "default/sub1",
+				Value: []byte(`{"space":"default","subscriptionId":"sub1","type":"sync","eventType":"http.request",` +
+					`"functionId":"green1","path":"/a","method":"POST"}`),
+				Previous: kv1,
+			},
+			{
+				Key: "default/sub2",
+				Value: []byte(`{"space":"default","subscriptionId":"sub2","type":"sync","eventType":"http.request",` +
+					`"functionId":"green2","path":"/b","method":"POST"}`),
+				Previous: kv2,
+			},
+		}, subscriptionsDB.ops)
+	})
+
+	blueID := subscription.ID("YXN5bmMsdXNlci5jcmVhdGVkLGJsdWUsJTJGLFBPU1Q")
+	blueValue := []byte(`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGJsdWUsJTJGLFBPU1Q",` +
+		`"type":"async","eventType":"user.created","functionId":"blue","path":"/","method":"POST"}`)
+	greenID := subscription.ID("YXN5bmMsdXNlci5jcmVhdGVkLGdyZWVuLCUyRixQT1NU")
+	greenValue := []byte(`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGdyZWVuLCUyRixQT1NU",` +
+		`"type":"async","eventType":"user.created","functionId":"green","path":"/","method":"POST"}`)
+
+	t.Run("async subscription keeps ID", func(t *testing.T) {
+		kv := &store.KVPair{Value: blueValue, LastIndex: 10}
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: true}
+		subscriptionsDB.EXPECT().Get("default/"+string(blueID), gomock.Any()).Return(kv, nil)
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{kv}, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/red", gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		swapped, err := subs.SwapSubscriptions("default", []subscription.Retarget{{SubscriptionID: blueID, FunctionID: "red"}})
+
+		assert.Nil(t, err)
+		assert.Equal(t, blueID, swapped[0].ID)
+		assert.Equal(t, []intstore.Op{
+			{
+				Key: "default/" + string(blueID),
+				Value: []byte(`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGJsdWUsJTJGLFBPU1Q",` +
+					`"type":"async","eventType":"user.created","functionId":"red","path":"/","method":"POST"}`),
+				Previous: kv,
+			},
+		}, subscriptionsDB.ops)
+	})
+
+	t.Run("async subscriptions exchanged functions", func(t *testing.T) {
+		blueKV := &store.KVPair{Value: blueValue, LastIndex: 10}
+		greenKV := &store.KVPair{Value: greenValue, LastIndex: 12}
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: true}
+		subscriptionsDB.EXPECT().Get("default/"+string(blueID), gomock.Any()).Return(blueKV, nil)
+		subscriptionsDB.EXPECT().Get("default/"+string(greenID), gomock.Any()).Return(greenKV, nil)
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{blueKV, greenKV}, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil).Times(2)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", []subscription.Retarget{
+			{SubscriptionID: blueID, FunctionID: "green"},
+			{SubscriptionID: greenID, FunctionID: "blue"},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []intstore.Op{
+			{
+				Key: "default/" + string(blueID),
+				Value: []byte(`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGJsdWUsJTJGLFBPU1Q",` +
+					`"type":"async","eventType":"user.created","functionId":"green","path":"/","method":"POST"}`),
+				Previous: blueKV,
+			},
+			{
+				Key: "default/" + string(greenID),
+				Value: []byte(`{"space":"default","subscriptionId":"YXN5bmMsdXNlci5jcmVhdGVkLGdyZWVuLCUyRixQT1NU",` +
+					`"type":"async","eventType":"user.created","functionId":"blue","path":"/","method":"POST"}`),
+				Previous: greenKV,
+			},
+		}, subscriptionsDB.ops)
+	})
+
+	t.Run("async subscription retargeted to already subscribed function", func(t *testing.T) {
+		blueKV := &store.KVPair{Value: blueValue}
+		greenKV := &store.KVPair{Value: greenValue}
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: true}
+		subscriptionsDB.EXPECT().Get("default/"+string(blueID), gomock.Any()).Return(blueKV, nil)
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{blueKV, greenKV}, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/green", gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", []subscription.Retarget{{SubscriptionID: blueID, FunctionID: "green"}})
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionAlreadyExists{ID: greenID})
+		assert.Nil(t, subscriptionsDB.ops)
+	})
+
+	t.Run("async subscriptions retargeted to the same function", func(t *testing.T) {
+		blueKV := &store.KVPair{Value: blueValue}
+		greenKV := &store.KVPair{Value: greenValue}
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: true}
+		subscriptionsDB.EXPECT().Get("default/"+string(blueID), gomock.Any()).Return(blueKV, nil)
+		subscriptionsDB.EXPECT().Get("default/"+string(greenID), gomock.Any()).Return(greenKV, nil)
+		subscriptionsDB.EXPECT().List("default/", gomock.Any()).Return([]*store.KVPair{blueKV, greenKV}, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/red", gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil).Times(2)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", []subscription.Retarget{
+			{SubscriptionID: blueID, FunctionID: "red"},
+			{SubscriptionID: greenID, FunctionID: "red"},
+		})
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionAlreadyExists{ID: greenID})
+		assert.Nil(t, subscriptionsDB.ops)
+	})
+
+	t.Run("subscriptions modified concurrently", func(t *testing.T) {
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl), ok: false}
+		subscriptionsDB.EXPECT().Get("default/sub1", gomock.Any()).Return(&store.KVPair{Value: value1}, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/green1", gomock.Any()).Return(&store.KVPair{Value: funcValue}, nil)
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", retargets[:1])
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionsModified{})
+	})
+
+	t.Run("subscription not found", func(t *testing.T) {
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl)}
+		subscriptionsDB.EXPECT().Get("default/sub1", gomock.Any()).Return(nil, errors.New("Key not found in store"))
+		subs := &Service{SubscriptionStore: subscriptionsDB, Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", retargets)
+
+		assert.Equal(t, err, &subscription.ErrSubscriptionNotFound{ID: "sub1"})
+		assert.Nil(t, subscriptionsDB.ops)
+	})
+
+	t.Run("function not found", func(t *testing.T) {
+		subscriptionsDB := &txnStore{MockStore: mock.NewMockStore(ctrl)}
+		subscriptionsDB.EXPECT().Get("default/sub1", gomock.Any()).Return(&store.KVPair{Value: value1}, nil)
+		functionsDB := mock.NewMockStore(ctrl)
+		functionsDB.EXPECT().Get("default/green1", gomock.Any()).Return(nil, errors.New("Key not found in store"))
+		subs := &Service{SubscriptionStore: subscriptionsDB, FunctionStore: functionsDB, Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", retargets)
+
+		assert.Equal(t, err, &function.ErrFunctionNotFound{ID: "green1"})
+		assert.Nil(t, subscriptionsDB.ops)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		subs := &Service{SubscriptionStore: &txnStore{}, Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", []subscription.Retarget{})
+		assert.Equal(t, err, &subscription.ErrSubscriptionValidation{Message: "at least one subscription is required"})
+
+		_, err = subs.SwapSubscriptions("default", []subscription.Retarget{retargets[0], retargets[0]})
+		assert.Equal(t, err, &subscription.ErrSubscriptionValidation{Message: "subscription sub1 is swapped more than once"})
+	})
+
+	t.Run("store without transactions", func(t *testing.T) {
+		subs := &Service{SubscriptionStore: mock.NewMockStore(ctrl), Log: zap.NewNop()}
+
+		_, err := subs.SwapSubscriptions("default", retargets)
+
+		assert.Equal(t, err, intstore.ErrTransactionsNotSupported)
+	})
+}
+
+// txnStore is a transactional store recording written operations.
+type txnStore struct {
+	*mock.MockStore
+	ok  bool
+	ops []intstore.Op
+}
+
+func (s *txnStore) AtomicPutAll(ops []intstore.Op) (bool, error) {
+	s.ops = ops
+	return s.ok, nil
+}
+
 func TestDeleteSubscription(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -3,5 +3,7 @@
 //go:generate mockgen -package mock -destination ./function.go -mock_names "Service=MockFunctionService" github.com/serverless/event-gateway/function Service
 //go:generate mockgen -package mock -destination ./subscription.go -mock_names "Service=MockSubscriptionService" github.com/serverless/event-gateway/subscription Service
 //go:generate mockgen -package mock -destination ./cors.go -mock_names "Service=MockCORSService" github.com/serverless/event-gateway/subscription/cors Service
+//go:generate mockgen -package mock -destination ./schema.go -mock_names "Service=MockSchemaService" github.com/serverless/event-gateway/schema Service
+//go:generate mockgen -package mock -destination ./secret.go -mock_names "Service=MockSecretService" github.com/serverless/event-gateway/secret Service
 
 package mock
@@ -90,6 +90,19 @@ func (mr *MockSubscriptionServiceMockRecorder) ListSubscriptions(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockSubscriptionService)(nil).ListSubscriptions), varargs...)
 }
 
+// SwapSubscriptions mocks base method
+func (m *MockSubscriptionService) SwapSubscriptions(arg0 string, arg1 []subscription.Retarget) (subscription.Subscriptions, error) {
+	ret := m.ctrl.Call(m, "SwapSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].(subscription.Subscriptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapSubscriptions indicates an expected call of SwapSubscriptions
+func (mr *MockSubscriptionServiceMockRecorder) SwapSubscriptions(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapSubscriptions", reflect.TypeOf((*MockSubscriptionService)(nil).SwapSubscriptions), arg0, arg1)
+}
+
 // UpdateSubscription mocks base method
 func (m *MockSubscriptionService) UpdateSubscription(arg0 subscription.ID, arg1 *subscription.Subscription) (*subscription.Subscription, error) {
 	ret := m.ctrl.Call(m, "UpdateSubscription", arg0, arg1)
@@ -0,0 +1,187 @@
+package amqp
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	connectTimeout = 5 * time.Second
+	confirmTimeout = 5 * time.Second
+	heartbeat      = 10 * time.Second
+)
+
+var (
+	errNack           = errors.New("message rejected by AMQP broker")
+	errConfirmTimeout = errors.New("waiting for AMQP broker confirmation timed out")
+	errChannelClosed  = errors.New("AMQP channel closed before the message was confirmed")
+)
+
+// channel is an AMQP channel in confirm mode shared by all functions publishing to the same broker. It connects on
+// first publish and reconnects on next publish after the connection is lost.
+type channel struct {
+	sync.Mutex
+	url     string
+	session *session
+}
+
+// session is a single broker connection with a channel in confirm mode.
+type session struct {
+	conn        *amqp.Connection
+	channel     *amqp.Channel
+	deliveryTag uint64
+
+	confirmations chan amqp.Confirmation
+	// pending maps delivery tags of published messages to channels receiving broker confirmations.
+	pendingMutex sync.Mutex
+	pending      map[uint64]chan bool
+}
+
+// Publish publishes message and waits until the broker acks or nacks it.
+func (c *channel) Publish(exchange, routingKey string, msg amqp.Publishing) error {
+	s, deliveryTag, confirmed, err := c.publish(exchange, routingKey, msg)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case ack, ok := <-confirmed:
+		if !ok {
+			return errChannelClosed
+		}
+		if !ack {
+			return errNack
+		}
+		return nil
+	case <-time.After(confirmTimeout):
+		s.pendingMutex.Lock()
+		delete(s.pending, deliveryTag)
+		s.pendingMutex.Unlock()
+		return errConfirmTimeout
+	}
+}
+
+func (c *channel) publish(exchange, routingKey string, msg amqp.Publishing) (*session, uint64, chan bool, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.session == nil {
+		s, err := open(c.url)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		c.session = s
+		go c.confirm(s)
+	}
+	s := c.session
+
+	// delivery tags are assigned by the broker in publish order starting from 1
+	deliveryTag := s.deliveryTag + 1
+	confirmed := make(chan bool, 1)
+	s.pendingMutex.Lock()
+	s.pending[deliveryTag] = confirmed
+	s.pendingMutex.Unlock()
+
+	err := s.channel.Publish(exchange, routingKey, false, false, msg)
+	if err != nil {
+		s.conn.Close()
+		c.session = nil
+		return nil, 0, nil, err
+	}
+
+	s.deliveryTag = deliveryTag
+	return s, deliveryTag, confirmed, nil
+}
+
+// confirm passes broker confirmations to publishers. Once the channel is closed publishers waiting for confirmation
+// are notified and the session is reopened on next publish.
+func (c *channel) confirm(s *session) {
+	for confirmation := range s.confirmations {
+		s.pendingMutex.Lock()
+		if confirmed, ok := s.pending[confirmation.DeliveryTag]; ok {
+			confirmed <- confirmation.Ack
+			delete(s.pending, confirmation.DeliveryTag)
+		}
+		s.pendingMutex.Unlock()
+	}
+
+	s.pendingMutex.Lock()
+	for deliveryTag, confirmed := range s.pending {
+		close(confirmed)
+		delete(s.pending, deliveryTag)
+	}
+	s.pendingMutex.Unlock()
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.session == s {
+		s.conn.Close()
+		c.session = nil
+	}
+}
+
+func open(url string) (*session, error) {
+	conn, err := amqp.DialConfig(url, amqp.Config{
+		Heartbeat: heartbeat,
+		Dial: func(network, addr string) (net.Conn, error) {
+			conn, err := net.DialTimeout(network, addr, connectTimeout)
+			if err != nil {
+				return nil, err
+			}
+			// deadline for TLS and AMQP handshake, it's cleared once the connection is open
+			err = conn.SetDeadline(time.Now().Add(connectTimeout))
+			if err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return conn, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	err = ch.Confirm(false)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &session{
+		conn:          conn,
+		channel:       ch,
+		confirmations: ch.NotifyPublish(make(chan amqp.Confirmation, 64)),
+		pending:       map[uint64]chan bool{},
+	}, nil
+}
+
+type channelPool struct {
+	sync.Mutex
+	channels map[string]*channel
+}
+
+// channels is a pool of channels reused across function config updates.
+var channels = &channelPool{channels: map[string]*channel{}}
+
+func (p *channelPool) get(url string) *channel {
+	p.Lock()
+	defer p.Unlock()
+
+	if ch, ok := p.channels[url]; ok {
+		return ch
+	}
+
+	ch := &channel{url: url}
+	p.channels[url] = ch
+	return ch
+}
@@ -0,0 +1,168 @@
+package awseventbridge
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("awseventbridge")
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// AWSEventBridge function implementation
+type AWSEventBridge struct {
+	Service eventbridgeiface.EventBridgeAPI `json:"-" validate:"-"`
+
+	// EventBusName is a name or ARN of the event bus. Default event bus is used if empty.
+	EventBusName string `json:"eventBusName,omitempty"`
+	Region       string `json:"region" validate:"required"`
+	// Source overrides event source sent to EventBridge. By default CloudEvents source attribute is used.
+	Source             string `json:"source,omitempty"`
+	AWSAccessKeyID     string `json:"awsAccessKeyId,omitempty"`
+	AWSSecretAccessKey string `json:"awsSecretAccessKey,omitempty"`
+	AWSSessionToken    string `json:"awsSessionToken,omitempty"`
+}
+
+// event contains CloudEvents attributes mapped to EventBridge entry.
+type event struct {
+	EventType string `json:"eventType"`
+	Source    string `json:"source"`
+	EventTime string `json:"eventTime"`
+}
+
+var (
+	errMissingEventType = errors.New("event has no eventType used as detail type")
+	errMissingSource    = errors.New("event has no source")
+	errUnexpectedResult = errors.New("unexpected number of result entries")
+)
+
+// Call puts event to AWS EventBridge event bus. The whole event is sent as entry detail. Detail type is taken from
+// eventType attribute.
+func (a AWSEventBridge) Call(payload []byte) ([]byte, error) {
+	cloudEvent := &event{}
+	err := json.Unmarshal(payload, cloudEvent)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if cloudEvent.EventType == "" {
+		return nil, &function.ErrFunctionCallFailed{Original: errMissingEventType}
+	}
+
+	source := a.Source
+	if source == "" {
+		source = cloudEvent.Source
+	}
+	if source == "" {
+		return nil, &function.ErrFunctionCallFailed{Original: errMissingSource}
+	}
+
+	entry := &eventbridge.PutEventsRequestEntry{
+		Source:     aws.String(source),
+		DetailType: aws.String(cloudEvent.EventType),
+		Detail:     aws.String(string(payload)),
+	}
+	if a.EventBusName != "" {
+		entry.EventBusName = aws.String(a.EventBusName)
+	}
+	if eventTime, err := time.Parse(time.RFC3339, cloudEvent.EventTime); err == nil {
+		entry.Time = aws.Time(eventTime)
+	}
+
+	putEventsOutput, err := a.Service.PutEvents(&eventbridge.PutEventsInput{
+		Entries: []*eventbridge.PutEventsRequestEntry{entry},
+	})
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if len(putEventsOutput.Entries) != 1 {
+		return nil, &function.ErrFunctionCallFailed{Original: errUnexpectedResult}
+	}
+
+	result := putEventsOutput.Entries[0]
+	if aws.Int64Value(putEventsOutput.FailedEntryCount) > 0 || result.ErrorCode != nil {
+		return nil, &function.ErrFunctionCallFailed{
+			Original: errors.New(aws.StringValue(result.ErrorCode) + ": " + aws.StringValue(result.ErrorMessage)),
+		}
+	}
+
+	return []byte(aws.StringValue(result.EventId)), nil
+}
+
+// validate provider config.
+func (a AWSEventBridge) validate() error {
+	validate := validator.New()
+	err := validate.Struct(a)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// CredentialFields is a part of function.CredentialsProvider interface.
+func (a AWSEventBridge) CredentialFields() []string {
+	return []string{"awsSecretAccessKey", "awsSessionToken"}
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (a AWSEventBridge) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if a.EventBusName != "" {
+		enc.AddString("eventBusName", a.EventBusName)
+	}
+	enc.AddString("region", a.Region)
+	if a.Source != "" {
+		enc.AddString("source", a.Source)
+	}
+	if a.AWSAccessKeyID != "" {
+		enc.AddString("awsAccessKeyId", "*****")
+	}
+	if a.AWSSecretAccessKey != "" {
+		enc.AddString("awsSecretAccessKey", "*****")
+	}
+	if a.AWSSessionToken != "" {
+		enc.AddString("awsSessionToken", "*****")
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &AWSEventBridge{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for AWS EventBridge function")
+	}
+
+	config := aws.NewConfig().WithRegion(provider.Region)
+	if provider.AWSAccessKeyID != "" && provider.AWSSecretAccessKey != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(provider.AWSAccessKeyID, provider.AWSSecretAccessKey, provider.AWSSessionToken))
+	}
+
+	awsSession, err := session.NewSession(config)
+	if err != nil {
+		return nil, errors.New("unable to create AWS Session: " + err.Error())
+	}
+
+	provider.Service = eventbridge.New(awsSession)
+	return provider, nil
+}
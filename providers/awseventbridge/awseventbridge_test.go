@@ -0,0 +1,198 @@
+package awseventbridge_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/golang/mock/gomock"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/awseventbridge"
+	"github.com/serverless/event-gateway/providers/awseventbridge/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := awseventbridge.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	for _, testCase := range callTests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		serviceMock := mock.NewMockEventBridgeAPI(mockCtrl)
+		serviceMock.EXPECT().PutEvents(gomock.Any()).Return(testCase.putEventsResult, testCase.putEventsError)
+
+		provider := awseventbridge.AWSEventBridge{
+			Service: serviceMock,
+			Region:  "us-east-1",
+		}
+
+		output, err := provider.Call([]byte(event))
+
+		assert.Equal(t, testCase.expectedResult, output)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCallEntry(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock.NewMockEventBridgeAPI(mockCtrl)
+	serviceMock.EXPECT().PutEvents(&eventbridge.PutEventsInput{
+		Entries: []*eventbridge.PutEventsRequestEntry{{
+			EventBusName: aws.String("orders"),
+			Source:       aws.String("/users"),
+			DetailType:   aws.String("user.created"),
+			Detail:       aws.String(event),
+			Time:         aws.Time(time.Date(2018, 4, 12, 23, 20, 50, 0, time.UTC)),
+		}},
+	}).Return(&eventbridge.PutEventsOutput{
+		FailedEntryCount: aws.Int64(0),
+		Entries:          []*eventbridge.PutEventsResultEntry{{EventId: aws.String("testid")}},
+	}, nil)
+	serviceMock.EXPECT().PutEvents(&eventbridge.PutEventsInput{
+		Entries: []*eventbridge.PutEventsRequestEntry{{
+			EventBusName: aws.String("orders"),
+			Source:       aws.String("com.example.users"),
+			DetailType:   aws.String("user.created"),
+			Detail:       aws.String(event),
+			Time:         aws.Time(time.Date(2018, 4, 12, 23, 20, 50, 0, time.UTC)),
+		}},
+	}).Return(&eventbridge.PutEventsOutput{
+		FailedEntryCount: aws.Int64(0),
+		Entries:          []*eventbridge.PutEventsResultEntry{{EventId: aws.String("testid")}},
+	}, nil)
+
+	provider := awseventbridge.AWSEventBridge{
+		Service:      serviceMock,
+		EventBusName: "orders",
+		Region:       "us-east-1",
+	}
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+
+	provider.Source = "com.example.users"
+
+	_, err = provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+}
+
+func TestCallInvalidEvent(t *testing.T) {
+	provider := awseventbridge.AWSEventBridge{Region: "us-east-1"}
+
+	_, err := provider.Call([]byte(`{"source":"/users"}`))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("event has no eventType used as detail type")}, err)
+
+	_, err = provider.Call([]byte(`{"eventType":"user.created"}`))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("event has no source")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+const event = `{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+	`"eventTime":"2018-04-12T23:20:50Z","data":{"userId":7}}`
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"eventBusName": "", "region": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"eventBusName": "orders", "region": ""}`,
+		errors.New("missing required fields for AWS EventBridge function"),
+	},
+}
+
+var callTests = []struct {
+	putEventsResult *eventbridge.PutEventsOutput
+	putEventsError  error
+	expectedResult  []byte
+	expectedError   error
+}{
+	{
+		&eventbridge.PutEventsOutput{
+			FailedEntryCount: aws.Int64(0),
+			Entries:          []*eventbridge.PutEventsResultEntry{{EventId: aws.String("testid")}},
+		},
+		nil,
+		[]byte("testid"),
+		nil,
+	},
+	{
+		&eventbridge.PutEventsOutput{
+			FailedEntryCount: aws.Int64(1),
+			Entries: []*eventbridge.PutEventsResultEntry{{
+				ErrorCode:    aws.String("InternalFailure"),
+				ErrorMessage: aws.String("internal failure"),
+			}},
+		},
+		nil,
+		[]byte(nil),
+		&function.ErrFunctionCallFailed{Original: errors.New("InternalFailure: internal failure")},
+	},
+	{
+		nil,
+		awserr.New("", "", nil),
+		[]byte(nil),
+		&function.ErrFunctionCallFailed{Original: awserr.New("", "", nil)},
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		awseventbridge.AWSEventBridge{
+			Region: "us-east-1",
+		},
+		map[string]interface{}{
+			"region": "us-east-1",
+		},
+	},
+	{
+		awseventbridge.AWSEventBridge{
+			EventBusName:       "orders",
+			Source:             "com.example.users",
+			AWSAccessKeyID:     "id",
+			AWSSecretAccessKey: "key",
+			AWSSessionToken:    "token",
+		},
+		map[string]interface{}{
+			"eventBusName":       "orders",
+			"region":             "",
+			"source":             "com.example.users",
+			"awsAccessKeyId":     "*****",
+			"awsSecretAccessKey": "*****",
+			"awsSessionToken":    "*****",
+		},
+	},
+}
@@ -0,0 +1,3 @@
+//go:generate mockgen -package mock -destination eventbridgeiface.go github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface EventBridgeAPI
+
+package mock
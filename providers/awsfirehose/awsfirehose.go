@@ -58,6 +58,11 @@ func (a AWSFirehose) validate() error {
 	return nil
 }
 
+// CredentialFields is a part of function.CredentialsProvider interface.
+func (a AWSFirehose) CredentialFields() []string {
+	return []string{"awsSecretAccessKey", "awsSessionToken"}
+}
+
 // MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
 func (a AWSFirehose) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("deliveryStreamName", a.DeliveryStreamName)
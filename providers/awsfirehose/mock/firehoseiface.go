@@ -5,9 +5,9 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
-	aws "github.com/aws/aws-sdk-go/aws"
 	request "github.com/aws/aws-sdk-go/aws/request"
 	firehose "github.com/aws/aws-sdk-go/service/firehose"
 	gomock "github.com/golang/mock/gomock"
@@ -63,7 +63,7 @@ func (mr *MockFirehoseAPIMockRecorder) CreateDeliveryStreamRequest(arg0 interfac
 }
 
 // CreateDeliveryStreamWithContext mocks base method
-func (m *MockFirehoseAPI) CreateDeliveryStreamWithContext(arg0 aws.Context, arg1 *firehose.CreateDeliveryStreamInput, arg2 ...request.Option) (*firehose.CreateDeliveryStreamOutput, error) {
+func (m *MockFirehoseAPI) CreateDeliveryStreamWithContext(arg0 context.Context, arg1 *firehose.CreateDeliveryStreamInput, arg2 ...request.Option) (*firehose.CreateDeliveryStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -107,7 +107,7 @@ func (mr *MockFirehoseAPIMockRecorder) DeleteDeliveryStreamRequest(arg0 interfac
 }
 
 // DeleteDeliveryStreamWithContext mocks base method
-func (m *MockFirehoseAPI) DeleteDeliveryStreamWithContext(arg0 aws.Context, arg1 *firehose.DeleteDeliveryStreamInput, arg2 ...request.Option) (*firehose.DeleteDeliveryStreamOutput, error) {
+func (m *MockFirehoseAPI) DeleteDeliveryStreamWithContext(arg0 context.Context, arg1 *firehose.DeleteDeliveryStreamInput, arg2 ...request.Option) (*firehose.DeleteDeliveryStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -151,7 +151,7 @@ func (mr *MockFirehoseAPIMockRecorder) DescribeDeliveryStreamRequest(arg0 interf
 }
 
 // DescribeDeliveryStreamWithContext mocks base method
-func (m *MockFirehoseAPI) DescribeDeliveryStreamWithContext(arg0 aws.Context, arg1 *firehose.DescribeDeliveryStreamInput, arg2 ...request.Option) (*firehose.DescribeDeliveryStreamOutput, error) {
+func (m *MockFirehoseAPI) DescribeDeliveryStreamWithContext(arg0 context.Context, arg1 *firehose.DescribeDeliveryStreamInput, arg2 ...request.Option) (*firehose.DescribeDeliveryStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -195,7 +195,7 @@ func (mr *MockFirehoseAPIMockRecorder) ListDeliveryStreamsRequest(arg0 interface
 }
 
 // ListDeliveryStreamsWithContext mocks base method
-func (m *MockFirehoseAPI) ListDeliveryStreamsWithContext(arg0 aws.Context, arg1 *firehose.ListDeliveryStreamsInput, arg2 ...request.Option) (*firehose.ListDeliveryStreamsOutput, error) {
+func (m *MockFirehoseAPI) ListDeliveryStreamsWithContext(arg0 context.Context, arg1 *firehose.ListDeliveryStreamsInput, arg2 ...request.Option) (*firehose.ListDeliveryStreamsOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -212,6 +212,50 @@ func (mr *MockFirehoseAPIMockRecorder) ListDeliveryStreamsWithContext(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveryStreamsWithContext", reflect.TypeOf((*MockFirehoseAPI)(nil).ListDeliveryStreamsWithContext), varargs...)
 }
 
+// ListTagsForDeliveryStream mocks base method
+func (m *MockFirehoseAPI) ListTagsForDeliveryStream(arg0 *firehose.ListTagsForDeliveryStreamInput) (*firehose.ListTagsForDeliveryStreamOutput, error) {
+	ret := m.ctrl.Call(m, "ListTagsForDeliveryStream", arg0)
+	ret0, _ := ret[0].(*firehose.ListTagsForDeliveryStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForDeliveryStream indicates an expected call of ListTagsForDeliveryStream
+func (mr *MockFirehoseAPIMockRecorder) ListTagsForDeliveryStream(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForDeliveryStream", reflect.TypeOf((*MockFirehoseAPI)(nil).ListTagsForDeliveryStream), arg0)
+}
+
+// ListTagsForDeliveryStreamRequest mocks base method
+func (m *MockFirehoseAPI) ListTagsForDeliveryStreamRequest(arg0 *firehose.ListTagsForDeliveryStreamInput) (*request.Request, *firehose.ListTagsForDeliveryStreamOutput) {
+	ret := m.ctrl.Call(m, "ListTagsForDeliveryStreamRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*firehose.ListTagsForDeliveryStreamOutput)
+	return ret0, ret1
+}
+
+// ListTagsForDeliveryStreamRequest indicates an expected call of ListTagsForDeliveryStreamRequest
+func (mr *MockFirehoseAPIMockRecorder) ListTagsForDeliveryStreamRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForDeliveryStreamRequest", reflect.TypeOf((*MockFirehoseAPI)(nil).ListTagsForDeliveryStreamRequest), arg0)
+}
+
+// ListTagsForDeliveryStreamWithContext mocks base method
+func (m *MockFirehoseAPI) ListTagsForDeliveryStreamWithContext(arg0 context.Context, arg1 *firehose.ListTagsForDeliveryStreamInput, arg2 ...request.Option) (*firehose.ListTagsForDeliveryStreamOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsForDeliveryStreamWithContext", varargs...)
+	ret0, _ := ret[0].(*firehose.ListTagsForDeliveryStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForDeliveryStreamWithContext indicates an expected call of ListTagsForDeliveryStreamWithContext
+func (mr *MockFirehoseAPIMockRecorder) ListTagsForDeliveryStreamWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForDeliveryStreamWithContext", reflect.TypeOf((*MockFirehoseAPI)(nil).ListTagsForDeliveryStreamWithContext), varargs...)
+}
+
 // PutRecord mocks base method
 func (m *MockFirehoseAPI) PutRecord(arg0 *firehose.PutRecordInput) (*firehose.PutRecordOutput, error) {
 	ret := m.ctrl.Call(m, "PutRecord", arg0)
@@ -252,7 +296,7 @@ func (mr *MockFirehoseAPIMockRecorder) PutRecordBatchRequest(arg0 interface{}) *
 }
 
 // PutRecordBatchWithContext mocks base method
-func (m *MockFirehoseAPI) PutRecordBatchWithContext(arg0 aws.Context, arg1 *firehose.PutRecordBatchInput, arg2 ...request.Option) (*firehose.PutRecordBatchOutput, error) {
+func (m *MockFirehoseAPI) PutRecordBatchWithContext(arg0 context.Context, arg1 *firehose.PutRecordBatchInput, arg2 ...request.Option) (*firehose.PutRecordBatchOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -283,7 +327,7 @@ func (mr *MockFirehoseAPIMockRecorder) PutRecordRequest(arg0 interface{}) *gomoc
 }
 
 // PutRecordWithContext mocks base method
-func (m *MockFirehoseAPI) PutRecordWithContext(arg0 aws.Context, arg1 *firehose.PutRecordInput, arg2 ...request.Option) (*firehose.PutRecordOutput, error) {
+func (m *MockFirehoseAPI) PutRecordWithContext(arg0 context.Context, arg1 *firehose.PutRecordInput, arg2 ...request.Option) (*firehose.PutRecordOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -300,6 +344,182 @@ func (mr *MockFirehoseAPIMockRecorder) PutRecordWithContext(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRecordWithContext", reflect.TypeOf((*MockFirehoseAPI)(nil).PutRecordWithContext), varargs...)
 }
 
+// StartDeliveryStreamEncryption mocks base method
+func (m *MockFirehoseAPI) StartDeliveryStreamEncryption(arg0 *firehose.StartDeliveryStreamEncryptionInput) (*firehose.StartDeliveryStreamEncryptionOutput, error) {
+	ret := m.ctrl.Call(m, "StartDeliveryStreamEncryption", arg0)
+	ret0, _ := ret[0].(*firehose.StartDeliveryStreamEncryptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDeliveryStreamEncryption indicates an expected call of StartDeliveryStreamEncryption
+func (mr *MockFirehoseAPIMockRecorder) StartDeliveryStreamEncryption(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeliveryStreamEncryption", reflect.TypeOf((*MockFirehoseAPI)(nil).StartDeliveryStreamEncryption), arg0)
+}
+
+// StartDeliveryStreamEncryptionRequest mocks base method
+func (m *MockFirehoseAPI) StartDeliveryStreamEncryptionRequest(arg0 *firehose.StartDeliveryStreamEncryptionInput) (*request.Request, *firehose.StartDeliveryStreamEncryptionOutput) {
+	ret := m.ctrl.Call(m, "StartDeliveryStreamEncryptionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*firehose.StartDeliveryStreamEncryptionOutput)
+	return ret0, ret1
+}
+
+// StartDeliveryStreamEncryptionRequest indicates an expected call of StartDeliveryStreamEncryptionRequest
+func (mr *MockFirehoseAPIMockRecorder) StartDeliveryStreamEncryptionRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeliveryStreamEncryptionRequest", reflect.TypeOf((*MockFirehoseAPI)(nil).StartDeliveryStreamEncryptionRequest), arg0)
+}
+
+// StartDeliveryStreamEncryptionWithContext mocks base method
+func (m *MockFirehoseAPI) StartDeliveryStreamEncryptionWithContext(arg0 context.Context, arg1 *firehose.StartDeliveryStreamEncryptionInput, arg2 ...request.Option) (*firehose.StartDeliveryStreamEncryptionOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartDeliveryStreamEncryptionWithContext", varargs...)
+	ret0, _ := ret[0].(*firehose.StartDeliveryStreamEncryptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartDeliveryStreamEncryptionWithContext indicates an expected call of StartDeliveryStreamEncryptionWithContext
+func (mr *MockFirehoseAPIMockRecorder) StartDeliveryStreamEncryptionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartDeliveryStreamEncryptionWithContext", reflect.TypeOf((*MockFirehoseAPI)(nil).StartDeliveryStreamEncryptionWithContext), varargs...)
+}
+
+// StopDeliveryStreamEncryption mocks base method
+func (m *MockFirehoseAPI) StopDeliveryStreamEncryption(arg0 *firehose.StopDeliveryStreamEncryptionInput) (*firehose.StopDeliveryStreamEncryptionOutput, error) {
+	ret := m.ctrl.Call(m, "StopDeliveryStreamEncryption", arg0)
+	ret0, _ := ret[0].(*firehose.StopDeliveryStreamEncryptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopDeliveryStreamEncryption indicates an expected call of StopDeliveryStreamEncryption
+func (mr *MockFirehoseAPIMockRecorder) StopDeliveryStreamEncryption(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDeliveryStreamEncryption", reflect.TypeOf((*MockFirehoseAPI)(nil).StopDeliveryStreamEncryption), arg0)
+}
+
+// StopDeliveryStreamEncryptionRequest mocks base method
+func (m *MockFirehoseAPI) StopDeliveryStreamEncryptionRequest(arg0 *firehose.StopDeliveryStreamEncryptionInput) (*request.Request, *firehose.StopDeliveryStreamEncryptionOutput) {
+	ret := m.ctrl.Call(m, "StopDeliveryStreamEncryptionRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*firehose.StopDeliveryStreamEncryptionOutput)
+	return ret0, ret1
+}
+
+// StopDeliveryStreamEncryptionRequest indicates an expected call of StopDeliveryStreamEncryptionRequest
+func (mr *MockFirehoseAPIMockRecorder) StopDeliveryStreamEncryptionRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDeliveryStreamEncryptionRequest", reflect.TypeOf((*MockFirehoseAPI)(nil).StopDeliveryStreamEncryptionRequest), arg0)
+}
+
+// StopDeliveryStreamEncryptionWithContext mocks base method
+func (m *MockFirehoseAPI) StopDeliveryStreamEncryptionWithContext(arg0 context.Context, arg1 *firehose.StopDeliveryStreamEncryptionInput, arg2 ...request.Option) (*firehose.StopDeliveryStreamEncryptionOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopDeliveryStreamEncryptionWithContext", varargs...)
+	ret0, _ := ret[0].(*firehose.StopDeliveryStreamEncryptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopDeliveryStreamEncryptionWithContext indicates an expected call of StopDeliveryStreamEncryptionWithContext
+func (mr *MockFirehoseAPIMockRecorder) StopDeliveryStreamEncryptionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDeliveryStreamEncryptionWithContext", reflect.TypeOf((*MockFirehoseAPI)(nil).StopDeliveryStreamEncryptionWithContext), varargs...)
+}
+
+// TagDeliveryStream mocks base method
+func (m *MockFirehoseAPI) TagDeliveryStream(arg0 *firehose.TagDeliveryStreamInput) (*firehose.TagDeliveryStreamOutput, error) {
+	ret := m.ctrl.Call(m, "TagDeliveryStream", arg0)
+	ret0, _ := ret[0].(*firehose.TagDeliveryStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagDeliveryStream indicates an expected call of TagDeliveryStream
+func (mr *MockFirehoseAPIMockRecorder) TagDeliveryStream(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDeliveryStream", reflect.TypeOf((*MockFirehoseAPI)(nil).TagDeliveryStream), arg0)
+}
+
+// TagDeliveryStreamRequest mocks base method
+func (m *MockFirehoseAPI) TagDeliveryStreamRequest(arg0 *firehose.TagDeliveryStreamInput) (*request.Request, *firehose.TagDeliveryStreamOutput) {
+	ret := m.ctrl.Call(m, "TagDeliveryStreamRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*firehose.TagDeliveryStreamOutput)
+	return ret0, ret1
+}
+
+// TagDeliveryStreamRequest indicates an expected call of TagDeliveryStreamRequest
+func (mr *MockFirehoseAPIMockRecorder) TagDeliveryStreamRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDeliveryStreamRequest", reflect.TypeOf((*MockFirehoseAPI)(nil).TagDeliveryStreamRequest), arg0)
+}
+
+// TagDeliveryStreamWithContext mocks base method
+func (m *MockFirehoseAPI) TagDeliveryStreamWithContext(arg0 context.Context, arg1 *firehose.TagDeliveryStreamInput, arg2 ...request.Option) (*firehose.TagDeliveryStreamOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagDeliveryStreamWithContext", varargs...)
+	ret0, _ := ret[0].(*firehose.TagDeliveryStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagDeliveryStreamWithContext indicates an expected call of TagDeliveryStreamWithContext
+func (mr *MockFirehoseAPIMockRecorder) TagDeliveryStreamWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagDeliveryStreamWithContext", reflect.TypeOf((*MockFirehoseAPI)(nil).TagDeliveryStreamWithContext), varargs...)
+}
+
+// UntagDeliveryStream mocks base method
+func (m *MockFirehoseAPI) UntagDeliveryStream(arg0 *firehose.UntagDeliveryStreamInput) (*firehose.UntagDeliveryStreamOutput, error) {
+	ret := m.ctrl.Call(m, "UntagDeliveryStream", arg0)
+	ret0, _ := ret[0].(*firehose.UntagDeliveryStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagDeliveryStream indicates an expected call of UntagDeliveryStream
+func (mr *MockFirehoseAPIMockRecorder) UntagDeliveryStream(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagDeliveryStream", reflect.TypeOf((*MockFirehoseAPI)(nil).UntagDeliveryStream), arg0)
+}
+
+// UntagDeliveryStreamRequest mocks base method
+func (m *MockFirehoseAPI) UntagDeliveryStreamRequest(arg0 *firehose.UntagDeliveryStreamInput) (*request.Request, *firehose.UntagDeliveryStreamOutput) {
+	ret := m.ctrl.Call(m, "UntagDeliveryStreamRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*firehose.UntagDeliveryStreamOutput)
+	return ret0, ret1
+}
+
+// UntagDeliveryStreamRequest indicates an expected call of UntagDeliveryStreamRequest
+func (mr *MockFirehoseAPIMockRecorder) UntagDeliveryStreamRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagDeliveryStreamRequest", reflect.TypeOf((*MockFirehoseAPI)(nil).UntagDeliveryStreamRequest), arg0)
+}
+
+// UntagDeliveryStreamWithContext mocks base method
+func (m *MockFirehoseAPI) UntagDeliveryStreamWithContext(arg0 context.Context, arg1 *firehose.UntagDeliveryStreamInput, arg2 ...request.Option) (*firehose.UntagDeliveryStreamOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UntagDeliveryStreamWithContext", varargs...)
+	ret0, _ := ret[0].(*firehose.UntagDeliveryStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagDeliveryStreamWithContext indicates an expected call of UntagDeliveryStreamWithContext
+func (mr *MockFirehoseAPIMockRecorder) UntagDeliveryStreamWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagDeliveryStreamWithContext", reflect.TypeOf((*MockFirehoseAPI)(nil).UntagDeliveryStreamWithContext), varargs...)
+}
+
 // UpdateDestination mocks base method
 func (m *MockFirehoseAPI) UpdateDestination(arg0 *firehose.UpdateDestinationInput) (*firehose.UpdateDestinationOutput, error) {
 	ret := m.ctrl.Call(m, "UpdateDestination", arg0)
@@ -327,7 +547,7 @@ func (mr *MockFirehoseAPIMockRecorder) UpdateDestinationRequest(arg0 interface{}
 }
 
 // UpdateDestinationWithContext mocks base method
-func (m *MockFirehoseAPI) UpdateDestinationWithContext(arg0 aws.Context, arg1 *firehose.UpdateDestinationInput, arg2 ...request.Option) (*firehose.UpdateDestinationOutput, error) {
+func (m *MockFirehoseAPI) UpdateDestinationWithContext(arg0 context.Context, arg1 *firehose.UpdateDestinationInput, arg2 ...request.Option) (*firehose.UpdateDestinationOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
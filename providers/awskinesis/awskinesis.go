@@ -60,6 +60,11 @@ func (a AWSKinesis) validate() error {
 	return nil
 }
 
+// CredentialFields is a part of function.CredentialsProvider interface.
+func (a AWSKinesis) CredentialFields() []string {
+	return []string{"awsSecretAccessKey", "awsSessionToken"}
+}
+
 // MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
 func (a AWSKinesis) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("streamName", a.StreamName)
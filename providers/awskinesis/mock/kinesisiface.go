@@ -5,9 +5,9 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
-	aws "github.com/aws/aws-sdk-go/aws"
 	request "github.com/aws/aws-sdk-go/aws/request"
 	kinesis "github.com/aws/aws-sdk-go/service/kinesis"
 	gomock "github.com/golang/mock/gomock"
@@ -63,7 +63,7 @@ func (mr *MockKinesisAPIMockRecorder) AddTagsToStreamRequest(arg0 interface{}) *
 }
 
 // AddTagsToStreamWithContext mocks base method
-func (m *MockKinesisAPI) AddTagsToStreamWithContext(arg0 aws.Context, arg1 *kinesis.AddTagsToStreamInput, arg2 ...request.Option) (*kinesis.AddTagsToStreamOutput, error) {
+func (m *MockKinesisAPI) AddTagsToStreamWithContext(arg0 context.Context, arg1 *kinesis.AddTagsToStreamInput, arg2 ...request.Option) (*kinesis.AddTagsToStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -107,7 +107,7 @@ func (mr *MockKinesisAPIMockRecorder) CreateStreamRequest(arg0 interface{}) *gom
 }
 
 // CreateStreamWithContext mocks base method
-func (m *MockKinesisAPI) CreateStreamWithContext(arg0 aws.Context, arg1 *kinesis.CreateStreamInput, arg2 ...request.Option) (*kinesis.CreateStreamOutput, error) {
+func (m *MockKinesisAPI) CreateStreamWithContext(arg0 context.Context, arg1 *kinesis.CreateStreamInput, arg2 ...request.Option) (*kinesis.CreateStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -151,7 +151,7 @@ func (mr *MockKinesisAPIMockRecorder) DecreaseStreamRetentionPeriodRequest(arg0
 }
 
 // DecreaseStreamRetentionPeriodWithContext mocks base method
-func (m *MockKinesisAPI) DecreaseStreamRetentionPeriodWithContext(arg0 aws.Context, arg1 *kinesis.DecreaseStreamRetentionPeriodInput, arg2 ...request.Option) (*kinesis.DecreaseStreamRetentionPeriodOutput, error) {
+func (m *MockKinesisAPI) DecreaseStreamRetentionPeriodWithContext(arg0 context.Context, arg1 *kinesis.DecreaseStreamRetentionPeriodInput, arg2 ...request.Option) (*kinesis.DecreaseStreamRetentionPeriodOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -195,7 +195,7 @@ func (mr *MockKinesisAPIMockRecorder) DeleteStreamRequest(arg0 interface{}) *gom
 }
 
 // DeleteStreamWithContext mocks base method
-func (m *MockKinesisAPI) DeleteStreamWithContext(arg0 aws.Context, arg1 *kinesis.DeleteStreamInput, arg2 ...request.Option) (*kinesis.DeleteStreamOutput, error) {
+func (m *MockKinesisAPI) DeleteStreamWithContext(arg0 context.Context, arg1 *kinesis.DeleteStreamInput, arg2 ...request.Option) (*kinesis.DeleteStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -212,6 +212,50 @@ func (mr *MockKinesisAPIMockRecorder) DeleteStreamWithContext(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStreamWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).DeleteStreamWithContext), varargs...)
 }
 
+// DeregisterStreamConsumer mocks base method
+func (m *MockKinesisAPI) DeregisterStreamConsumer(arg0 *kinesis.DeregisterStreamConsumerInput) (*kinesis.DeregisterStreamConsumerOutput, error) {
+	ret := m.ctrl.Call(m, "DeregisterStreamConsumer", arg0)
+	ret0, _ := ret[0].(*kinesis.DeregisterStreamConsumerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterStreamConsumer indicates an expected call of DeregisterStreamConsumer
+func (mr *MockKinesisAPIMockRecorder) DeregisterStreamConsumer(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterStreamConsumer", reflect.TypeOf((*MockKinesisAPI)(nil).DeregisterStreamConsumer), arg0)
+}
+
+// DeregisterStreamConsumerRequest mocks base method
+func (m *MockKinesisAPI) DeregisterStreamConsumerRequest(arg0 *kinesis.DeregisterStreamConsumerInput) (*request.Request, *kinesis.DeregisterStreamConsumerOutput) {
+	ret := m.ctrl.Call(m, "DeregisterStreamConsumerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.DeregisterStreamConsumerOutput)
+	return ret0, ret1
+}
+
+// DeregisterStreamConsumerRequest indicates an expected call of DeregisterStreamConsumerRequest
+func (mr *MockKinesisAPIMockRecorder) DeregisterStreamConsumerRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterStreamConsumerRequest", reflect.TypeOf((*MockKinesisAPI)(nil).DeregisterStreamConsumerRequest), arg0)
+}
+
+// DeregisterStreamConsumerWithContext mocks base method
+func (m *MockKinesisAPI) DeregisterStreamConsumerWithContext(arg0 context.Context, arg1 *kinesis.DeregisterStreamConsumerInput, arg2 ...request.Option) (*kinesis.DeregisterStreamConsumerOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeregisterStreamConsumerWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.DeregisterStreamConsumerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterStreamConsumerWithContext indicates an expected call of DeregisterStreamConsumerWithContext
+func (mr *MockKinesisAPIMockRecorder) DeregisterStreamConsumerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterStreamConsumerWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).DeregisterStreamConsumerWithContext), varargs...)
+}
+
 // DescribeLimits mocks base method
 func (m *MockKinesisAPI) DescribeLimits(arg0 *kinesis.DescribeLimitsInput) (*kinesis.DescribeLimitsOutput, error) {
 	ret := m.ctrl.Call(m, "DescribeLimits", arg0)
@@ -239,7 +283,7 @@ func (mr *MockKinesisAPIMockRecorder) DescribeLimitsRequest(arg0 interface{}) *g
 }
 
 // DescribeLimitsWithContext mocks base method
-func (m *MockKinesisAPI) DescribeLimitsWithContext(arg0 aws.Context, arg1 *kinesis.DescribeLimitsInput, arg2 ...request.Option) (*kinesis.DescribeLimitsOutput, error) {
+func (m *MockKinesisAPI) DescribeLimitsWithContext(arg0 context.Context, arg1 *kinesis.DescribeLimitsInput, arg2 ...request.Option) (*kinesis.DescribeLimitsOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -269,6 +313,50 @@ func (mr *MockKinesisAPIMockRecorder) DescribeStream(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStream", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStream), arg0)
 }
 
+// DescribeStreamConsumer mocks base method
+func (m *MockKinesisAPI) DescribeStreamConsumer(arg0 *kinesis.DescribeStreamConsumerInput) (*kinesis.DescribeStreamConsumerOutput, error) {
+	ret := m.ctrl.Call(m, "DescribeStreamConsumer", arg0)
+	ret0, _ := ret[0].(*kinesis.DescribeStreamConsumerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStreamConsumer indicates an expected call of DescribeStreamConsumer
+func (mr *MockKinesisAPIMockRecorder) DescribeStreamConsumer(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamConsumer", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStreamConsumer), arg0)
+}
+
+// DescribeStreamConsumerRequest mocks base method
+func (m *MockKinesisAPI) DescribeStreamConsumerRequest(arg0 *kinesis.DescribeStreamConsumerInput) (*request.Request, *kinesis.DescribeStreamConsumerOutput) {
+	ret := m.ctrl.Call(m, "DescribeStreamConsumerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.DescribeStreamConsumerOutput)
+	return ret0, ret1
+}
+
+// DescribeStreamConsumerRequest indicates an expected call of DescribeStreamConsumerRequest
+func (mr *MockKinesisAPIMockRecorder) DescribeStreamConsumerRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamConsumerRequest", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStreamConsumerRequest), arg0)
+}
+
+// DescribeStreamConsumerWithContext mocks base method
+func (m *MockKinesisAPI) DescribeStreamConsumerWithContext(arg0 context.Context, arg1 *kinesis.DescribeStreamConsumerInput, arg2 ...request.Option) (*kinesis.DescribeStreamConsumerOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeStreamConsumerWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.DescribeStreamConsumerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStreamConsumerWithContext indicates an expected call of DescribeStreamConsumerWithContext
+func (mr *MockKinesisAPIMockRecorder) DescribeStreamConsumerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamConsumerWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStreamConsumerWithContext), varargs...)
+}
+
 // DescribeStreamPages mocks base method
 func (m *MockKinesisAPI) DescribeStreamPages(arg0 *kinesis.DescribeStreamInput, arg1 func(*kinesis.DescribeStreamOutput, bool) bool) error {
 	ret := m.ctrl.Call(m, "DescribeStreamPages", arg0, arg1)
@@ -282,7 +370,7 @@ func (mr *MockKinesisAPIMockRecorder) DescribeStreamPages(arg0, arg1 interface{}
 }
 
 // DescribeStreamPagesWithContext mocks base method
-func (m *MockKinesisAPI) DescribeStreamPagesWithContext(arg0 aws.Context, arg1 *kinesis.DescribeStreamInput, arg2 func(*kinesis.DescribeStreamOutput, bool) bool, arg3 ...request.Option) error {
+func (m *MockKinesisAPI) DescribeStreamPagesWithContext(arg0 context.Context, arg1 *kinesis.DescribeStreamInput, arg2 func(*kinesis.DescribeStreamOutput, bool) bool, arg3 ...request.Option) error {
 	varargs := []interface{}{arg0, arg1, arg2}
 	for _, a := range arg3 {
 		varargs = append(varargs, a)
@@ -311,8 +399,52 @@ func (mr *MockKinesisAPIMockRecorder) DescribeStreamRequest(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamRequest", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStreamRequest), arg0)
 }
 
+// DescribeStreamSummary mocks base method
+func (m *MockKinesisAPI) DescribeStreamSummary(arg0 *kinesis.DescribeStreamSummaryInput) (*kinesis.DescribeStreamSummaryOutput, error) {
+	ret := m.ctrl.Call(m, "DescribeStreamSummary", arg0)
+	ret0, _ := ret[0].(*kinesis.DescribeStreamSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStreamSummary indicates an expected call of DescribeStreamSummary
+func (mr *MockKinesisAPIMockRecorder) DescribeStreamSummary(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamSummary", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStreamSummary), arg0)
+}
+
+// DescribeStreamSummaryRequest mocks base method
+func (m *MockKinesisAPI) DescribeStreamSummaryRequest(arg0 *kinesis.DescribeStreamSummaryInput) (*request.Request, *kinesis.DescribeStreamSummaryOutput) {
+	ret := m.ctrl.Call(m, "DescribeStreamSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.DescribeStreamSummaryOutput)
+	return ret0, ret1
+}
+
+// DescribeStreamSummaryRequest indicates an expected call of DescribeStreamSummaryRequest
+func (mr *MockKinesisAPIMockRecorder) DescribeStreamSummaryRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamSummaryRequest", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStreamSummaryRequest), arg0)
+}
+
+// DescribeStreamSummaryWithContext mocks base method
+func (m *MockKinesisAPI) DescribeStreamSummaryWithContext(arg0 context.Context, arg1 *kinesis.DescribeStreamSummaryInput, arg2 ...request.Option) (*kinesis.DescribeStreamSummaryOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeStreamSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.DescribeStreamSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStreamSummaryWithContext indicates an expected call of DescribeStreamSummaryWithContext
+func (mr *MockKinesisAPIMockRecorder) DescribeStreamSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamSummaryWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).DescribeStreamSummaryWithContext), varargs...)
+}
+
 // DescribeStreamWithContext mocks base method
-func (m *MockKinesisAPI) DescribeStreamWithContext(arg0 aws.Context, arg1 *kinesis.DescribeStreamInput, arg2 ...request.Option) (*kinesis.DescribeStreamOutput, error) {
+func (m *MockKinesisAPI) DescribeStreamWithContext(arg0 context.Context, arg1 *kinesis.DescribeStreamInput, arg2 ...request.Option) (*kinesis.DescribeStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -356,7 +488,7 @@ func (mr *MockKinesisAPIMockRecorder) DisableEnhancedMonitoringRequest(arg0 inte
 }
 
 // DisableEnhancedMonitoringWithContext mocks base method
-func (m *MockKinesisAPI) DisableEnhancedMonitoringWithContext(arg0 aws.Context, arg1 *kinesis.DisableEnhancedMonitoringInput, arg2 ...request.Option) (*kinesis.EnhancedMonitoringOutput, error) {
+func (m *MockKinesisAPI) DisableEnhancedMonitoringWithContext(arg0 context.Context, arg1 *kinesis.DisableEnhancedMonitoringInput, arg2 ...request.Option) (*kinesis.EnhancedMonitoringOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -400,7 +532,7 @@ func (mr *MockKinesisAPIMockRecorder) EnableEnhancedMonitoringRequest(arg0 inter
 }
 
 // EnableEnhancedMonitoringWithContext mocks base method
-func (m *MockKinesisAPI) EnableEnhancedMonitoringWithContext(arg0 aws.Context, arg1 *kinesis.EnableEnhancedMonitoringInput, arg2 ...request.Option) (*kinesis.EnhancedMonitoringOutput, error) {
+func (m *MockKinesisAPI) EnableEnhancedMonitoringWithContext(arg0 context.Context, arg1 *kinesis.EnableEnhancedMonitoringInput, arg2 ...request.Option) (*kinesis.EnhancedMonitoringOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -444,7 +576,7 @@ func (mr *MockKinesisAPIMockRecorder) GetRecordsRequest(arg0 interface{}) *gomoc
 }
 
 // GetRecordsWithContext mocks base method
-func (m *MockKinesisAPI) GetRecordsWithContext(arg0 aws.Context, arg1 *kinesis.GetRecordsInput, arg2 ...request.Option) (*kinesis.GetRecordsOutput, error) {
+func (m *MockKinesisAPI) GetRecordsWithContext(arg0 context.Context, arg1 *kinesis.GetRecordsInput, arg2 ...request.Option) (*kinesis.GetRecordsOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -488,7 +620,7 @@ func (mr *MockKinesisAPIMockRecorder) GetShardIteratorRequest(arg0 interface{})
 }
 
 // GetShardIteratorWithContext mocks base method
-func (m *MockKinesisAPI) GetShardIteratorWithContext(arg0 aws.Context, arg1 *kinesis.GetShardIteratorInput, arg2 ...request.Option) (*kinesis.GetShardIteratorOutput, error) {
+func (m *MockKinesisAPI) GetShardIteratorWithContext(arg0 context.Context, arg1 *kinesis.GetShardIteratorInput, arg2 ...request.Option) (*kinesis.GetShardIteratorOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -532,7 +664,7 @@ func (mr *MockKinesisAPIMockRecorder) IncreaseStreamRetentionPeriodRequest(arg0
 }
 
 // IncreaseStreamRetentionPeriodWithContext mocks base method
-func (m *MockKinesisAPI) IncreaseStreamRetentionPeriodWithContext(arg0 aws.Context, arg1 *kinesis.IncreaseStreamRetentionPeriodInput, arg2 ...request.Option) (*kinesis.IncreaseStreamRetentionPeriodOutput, error) {
+func (m *MockKinesisAPI) IncreaseStreamRetentionPeriodWithContext(arg0 context.Context, arg1 *kinesis.IncreaseStreamRetentionPeriodInput, arg2 ...request.Option) (*kinesis.IncreaseStreamRetentionPeriodOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -549,6 +681,123 @@ func (mr *MockKinesisAPIMockRecorder) IncreaseStreamRetentionPeriodWithContext(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseStreamRetentionPeriodWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).IncreaseStreamRetentionPeriodWithContext), varargs...)
 }
 
+// ListShards mocks base method
+func (m *MockKinesisAPI) ListShards(arg0 *kinesis.ListShardsInput) (*kinesis.ListShardsOutput, error) {
+	ret := m.ctrl.Call(m, "ListShards", arg0)
+	ret0, _ := ret[0].(*kinesis.ListShardsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShards indicates an expected call of ListShards
+func (mr *MockKinesisAPIMockRecorder) ListShards(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShards", reflect.TypeOf((*MockKinesisAPI)(nil).ListShards), arg0)
+}
+
+// ListShardsRequest mocks base method
+func (m *MockKinesisAPI) ListShardsRequest(arg0 *kinesis.ListShardsInput) (*request.Request, *kinesis.ListShardsOutput) {
+	ret := m.ctrl.Call(m, "ListShardsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.ListShardsOutput)
+	return ret0, ret1
+}
+
+// ListShardsRequest indicates an expected call of ListShardsRequest
+func (mr *MockKinesisAPIMockRecorder) ListShardsRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShardsRequest", reflect.TypeOf((*MockKinesisAPI)(nil).ListShardsRequest), arg0)
+}
+
+// ListShardsWithContext mocks base method
+func (m *MockKinesisAPI) ListShardsWithContext(arg0 context.Context, arg1 *kinesis.ListShardsInput, arg2 ...request.Option) (*kinesis.ListShardsOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListShardsWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.ListShardsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShardsWithContext indicates an expected call of ListShardsWithContext
+func (mr *MockKinesisAPIMockRecorder) ListShardsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShardsWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).ListShardsWithContext), varargs...)
+}
+
+// ListStreamConsumers mocks base method
+func (m *MockKinesisAPI) ListStreamConsumers(arg0 *kinesis.ListStreamConsumersInput) (*kinesis.ListStreamConsumersOutput, error) {
+	ret := m.ctrl.Call(m, "ListStreamConsumers", arg0)
+	ret0, _ := ret[0].(*kinesis.ListStreamConsumersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStreamConsumers indicates an expected call of ListStreamConsumers
+func (mr *MockKinesisAPIMockRecorder) ListStreamConsumers(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamConsumers", reflect.TypeOf((*MockKinesisAPI)(nil).ListStreamConsumers), arg0)
+}
+
+// ListStreamConsumersPages mocks base method
+func (m *MockKinesisAPI) ListStreamConsumersPages(arg0 *kinesis.ListStreamConsumersInput, arg1 func(*kinesis.ListStreamConsumersOutput, bool) bool) error {
+	ret := m.ctrl.Call(m, "ListStreamConsumersPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStreamConsumersPages indicates an expected call of ListStreamConsumersPages
+func (mr *MockKinesisAPIMockRecorder) ListStreamConsumersPages(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamConsumersPages", reflect.TypeOf((*MockKinesisAPI)(nil).ListStreamConsumersPages), arg0, arg1)
+}
+
+// ListStreamConsumersPagesWithContext mocks base method
+func (m *MockKinesisAPI) ListStreamConsumersPagesWithContext(arg0 context.Context, arg1 *kinesis.ListStreamConsumersInput, arg2 func(*kinesis.ListStreamConsumersOutput, bool) bool, arg3 ...request.Option) error {
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStreamConsumersPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStreamConsumersPagesWithContext indicates an expected call of ListStreamConsumersPagesWithContext
+func (mr *MockKinesisAPIMockRecorder) ListStreamConsumersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamConsumersPagesWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).ListStreamConsumersPagesWithContext), varargs...)
+}
+
+// ListStreamConsumersRequest mocks base method
+func (m *MockKinesisAPI) ListStreamConsumersRequest(arg0 *kinesis.ListStreamConsumersInput) (*request.Request, *kinesis.ListStreamConsumersOutput) {
+	ret := m.ctrl.Call(m, "ListStreamConsumersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.ListStreamConsumersOutput)
+	return ret0, ret1
+}
+
+// ListStreamConsumersRequest indicates an expected call of ListStreamConsumersRequest
+func (mr *MockKinesisAPIMockRecorder) ListStreamConsumersRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamConsumersRequest", reflect.TypeOf((*MockKinesisAPI)(nil).ListStreamConsumersRequest), arg0)
+}
+
+// ListStreamConsumersWithContext mocks base method
+func (m *MockKinesisAPI) ListStreamConsumersWithContext(arg0 context.Context, arg1 *kinesis.ListStreamConsumersInput, arg2 ...request.Option) (*kinesis.ListStreamConsumersOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStreamConsumersWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.ListStreamConsumersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStreamConsumersWithContext indicates an expected call of ListStreamConsumersWithContext
+func (mr *MockKinesisAPIMockRecorder) ListStreamConsumersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamConsumersWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).ListStreamConsumersWithContext), varargs...)
+}
+
 // ListStreams mocks base method
 func (m *MockKinesisAPI) ListStreams(arg0 *kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error) {
 	ret := m.ctrl.Call(m, "ListStreams", arg0)
@@ -575,7 +824,7 @@ func (mr *MockKinesisAPIMockRecorder) ListStreamsPages(arg0, arg1 interface{}) *
 }
 
 // ListStreamsPagesWithContext mocks base method
-func (m *MockKinesisAPI) ListStreamsPagesWithContext(arg0 aws.Context, arg1 *kinesis.ListStreamsInput, arg2 func(*kinesis.ListStreamsOutput, bool) bool, arg3 ...request.Option) error {
+func (m *MockKinesisAPI) ListStreamsPagesWithContext(arg0 context.Context, arg1 *kinesis.ListStreamsInput, arg2 func(*kinesis.ListStreamsOutput, bool) bool, arg3 ...request.Option) error {
 	varargs := []interface{}{arg0, arg1, arg2}
 	for _, a := range arg3 {
 		varargs = append(varargs, a)
@@ -605,7 +854,7 @@ func (mr *MockKinesisAPIMockRecorder) ListStreamsRequest(arg0 interface{}) *gomo
 }
 
 // ListStreamsWithContext mocks base method
-func (m *MockKinesisAPI) ListStreamsWithContext(arg0 aws.Context, arg1 *kinesis.ListStreamsInput, arg2 ...request.Option) (*kinesis.ListStreamsOutput, error) {
+func (m *MockKinesisAPI) ListStreamsWithContext(arg0 context.Context, arg1 *kinesis.ListStreamsInput, arg2 ...request.Option) (*kinesis.ListStreamsOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -649,7 +898,7 @@ func (mr *MockKinesisAPIMockRecorder) ListTagsForStreamRequest(arg0 interface{})
 }
 
 // ListTagsForStreamWithContext mocks base method
-func (m *MockKinesisAPI) ListTagsForStreamWithContext(arg0 aws.Context, arg1 *kinesis.ListTagsForStreamInput, arg2 ...request.Option) (*kinesis.ListTagsForStreamOutput, error) {
+func (m *MockKinesisAPI) ListTagsForStreamWithContext(arg0 context.Context, arg1 *kinesis.ListTagsForStreamInput, arg2 ...request.Option) (*kinesis.ListTagsForStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -693,7 +942,7 @@ func (mr *MockKinesisAPIMockRecorder) MergeShardsRequest(arg0 interface{}) *gomo
 }
 
 // MergeShardsWithContext mocks base method
-func (m *MockKinesisAPI) MergeShardsWithContext(arg0 aws.Context, arg1 *kinesis.MergeShardsInput, arg2 ...request.Option) (*kinesis.MergeShardsOutput, error) {
+func (m *MockKinesisAPI) MergeShardsWithContext(arg0 context.Context, arg1 *kinesis.MergeShardsInput, arg2 ...request.Option) (*kinesis.MergeShardsOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -737,7 +986,7 @@ func (mr *MockKinesisAPIMockRecorder) PutRecordRequest(arg0 interface{}) *gomock
 }
 
 // PutRecordWithContext mocks base method
-func (m *MockKinesisAPI) PutRecordWithContext(arg0 aws.Context, arg1 *kinesis.PutRecordInput, arg2 ...request.Option) (*kinesis.PutRecordOutput, error) {
+func (m *MockKinesisAPI) PutRecordWithContext(arg0 context.Context, arg1 *kinesis.PutRecordInput, arg2 ...request.Option) (*kinesis.PutRecordOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -781,7 +1030,7 @@ func (mr *MockKinesisAPIMockRecorder) PutRecordsRequest(arg0 interface{}) *gomoc
 }
 
 // PutRecordsWithContext mocks base method
-func (m *MockKinesisAPI) PutRecordsWithContext(arg0 aws.Context, arg1 *kinesis.PutRecordsInput, arg2 ...request.Option) (*kinesis.PutRecordsOutput, error) {
+func (m *MockKinesisAPI) PutRecordsWithContext(arg0 context.Context, arg1 *kinesis.PutRecordsInput, arg2 ...request.Option) (*kinesis.PutRecordsOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -798,6 +1047,50 @@ func (mr *MockKinesisAPIMockRecorder) PutRecordsWithContext(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutRecordsWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).PutRecordsWithContext), varargs...)
 }
 
+// RegisterStreamConsumer mocks base method
+func (m *MockKinesisAPI) RegisterStreamConsumer(arg0 *kinesis.RegisterStreamConsumerInput) (*kinesis.RegisterStreamConsumerOutput, error) {
+	ret := m.ctrl.Call(m, "RegisterStreamConsumer", arg0)
+	ret0, _ := ret[0].(*kinesis.RegisterStreamConsumerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterStreamConsumer indicates an expected call of RegisterStreamConsumer
+func (mr *MockKinesisAPIMockRecorder) RegisterStreamConsumer(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStreamConsumer", reflect.TypeOf((*MockKinesisAPI)(nil).RegisterStreamConsumer), arg0)
+}
+
+// RegisterStreamConsumerRequest mocks base method
+func (m *MockKinesisAPI) RegisterStreamConsumerRequest(arg0 *kinesis.RegisterStreamConsumerInput) (*request.Request, *kinesis.RegisterStreamConsumerOutput) {
+	ret := m.ctrl.Call(m, "RegisterStreamConsumerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.RegisterStreamConsumerOutput)
+	return ret0, ret1
+}
+
+// RegisterStreamConsumerRequest indicates an expected call of RegisterStreamConsumerRequest
+func (mr *MockKinesisAPIMockRecorder) RegisterStreamConsumerRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStreamConsumerRequest", reflect.TypeOf((*MockKinesisAPI)(nil).RegisterStreamConsumerRequest), arg0)
+}
+
+// RegisterStreamConsumerWithContext mocks base method
+func (m *MockKinesisAPI) RegisterStreamConsumerWithContext(arg0 context.Context, arg1 *kinesis.RegisterStreamConsumerInput, arg2 ...request.Option) (*kinesis.RegisterStreamConsumerOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterStreamConsumerWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.RegisterStreamConsumerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterStreamConsumerWithContext indicates an expected call of RegisterStreamConsumerWithContext
+func (mr *MockKinesisAPIMockRecorder) RegisterStreamConsumerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterStreamConsumerWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).RegisterStreamConsumerWithContext), varargs...)
+}
+
 // RemoveTagsFromStream mocks base method
 func (m *MockKinesisAPI) RemoveTagsFromStream(arg0 *kinesis.RemoveTagsFromStreamInput) (*kinesis.RemoveTagsFromStreamOutput, error) {
 	ret := m.ctrl.Call(m, "RemoveTagsFromStream", arg0)
@@ -825,7 +1118,7 @@ func (mr *MockKinesisAPIMockRecorder) RemoveTagsFromStreamRequest(arg0 interface
 }
 
 // RemoveTagsFromStreamWithContext mocks base method
-func (m *MockKinesisAPI) RemoveTagsFromStreamWithContext(arg0 aws.Context, arg1 *kinesis.RemoveTagsFromStreamInput, arg2 ...request.Option) (*kinesis.RemoveTagsFromStreamOutput, error) {
+func (m *MockKinesisAPI) RemoveTagsFromStreamWithContext(arg0 context.Context, arg1 *kinesis.RemoveTagsFromStreamInput, arg2 ...request.Option) (*kinesis.RemoveTagsFromStreamOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -869,7 +1162,7 @@ func (mr *MockKinesisAPIMockRecorder) SplitShardRequest(arg0 interface{}) *gomoc
 }
 
 // SplitShardWithContext mocks base method
-func (m *MockKinesisAPI) SplitShardWithContext(arg0 aws.Context, arg1 *kinesis.SplitShardInput, arg2 ...request.Option) (*kinesis.SplitShardOutput, error) {
+func (m *MockKinesisAPI) SplitShardWithContext(arg0 context.Context, arg1 *kinesis.SplitShardInput, arg2 ...request.Option) (*kinesis.SplitShardOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -913,7 +1206,7 @@ func (mr *MockKinesisAPIMockRecorder) StartStreamEncryptionRequest(arg0 interfac
 }
 
 // StartStreamEncryptionWithContext mocks base method
-func (m *MockKinesisAPI) StartStreamEncryptionWithContext(arg0 aws.Context, arg1 *kinesis.StartStreamEncryptionInput, arg2 ...request.Option) (*kinesis.StartStreamEncryptionOutput, error) {
+func (m *MockKinesisAPI) StartStreamEncryptionWithContext(arg0 context.Context, arg1 *kinesis.StartStreamEncryptionInput, arg2 ...request.Option) (*kinesis.StartStreamEncryptionOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -957,7 +1250,7 @@ func (mr *MockKinesisAPIMockRecorder) StopStreamEncryptionRequest(arg0 interface
 }
 
 // StopStreamEncryptionWithContext mocks base method
-func (m *MockKinesisAPI) StopStreamEncryptionWithContext(arg0 aws.Context, arg1 *kinesis.StopStreamEncryptionInput, arg2 ...request.Option) (*kinesis.StopStreamEncryptionOutput, error) {
+func (m *MockKinesisAPI) StopStreamEncryptionWithContext(arg0 context.Context, arg1 *kinesis.StopStreamEncryptionInput, arg2 ...request.Option) (*kinesis.StopStreamEncryptionOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -974,6 +1267,50 @@ func (mr *MockKinesisAPIMockRecorder) StopStreamEncryptionWithContext(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopStreamEncryptionWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).StopStreamEncryptionWithContext), varargs...)
 }
 
+// SubscribeToShard mocks base method
+func (m *MockKinesisAPI) SubscribeToShard(arg0 *kinesis.SubscribeToShardInput) (*kinesis.SubscribeToShardOutput, error) {
+	ret := m.ctrl.Call(m, "SubscribeToShard", arg0)
+	ret0, _ := ret[0].(*kinesis.SubscribeToShardOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToShard indicates an expected call of SubscribeToShard
+func (mr *MockKinesisAPIMockRecorder) SubscribeToShard(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToShard", reflect.TypeOf((*MockKinesisAPI)(nil).SubscribeToShard), arg0)
+}
+
+// SubscribeToShardRequest mocks base method
+func (m *MockKinesisAPI) SubscribeToShardRequest(arg0 *kinesis.SubscribeToShardInput) (*request.Request, *kinesis.SubscribeToShardOutput) {
+	ret := m.ctrl.Call(m, "SubscribeToShardRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.SubscribeToShardOutput)
+	return ret0, ret1
+}
+
+// SubscribeToShardRequest indicates an expected call of SubscribeToShardRequest
+func (mr *MockKinesisAPIMockRecorder) SubscribeToShardRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToShardRequest", reflect.TypeOf((*MockKinesisAPI)(nil).SubscribeToShardRequest), arg0)
+}
+
+// SubscribeToShardWithContext mocks base method
+func (m *MockKinesisAPI) SubscribeToShardWithContext(arg0 context.Context, arg1 *kinesis.SubscribeToShardInput, arg2 ...request.Option) (*kinesis.SubscribeToShardOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeToShardWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.SubscribeToShardOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToShardWithContext indicates an expected call of SubscribeToShardWithContext
+func (mr *MockKinesisAPIMockRecorder) SubscribeToShardWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToShardWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).SubscribeToShardWithContext), varargs...)
+}
+
 // UpdateShardCount mocks base method
 func (m *MockKinesisAPI) UpdateShardCount(arg0 *kinesis.UpdateShardCountInput) (*kinesis.UpdateShardCountOutput, error) {
 	ret := m.ctrl.Call(m, "UpdateShardCount", arg0)
@@ -1001,7 +1338,7 @@ func (mr *MockKinesisAPIMockRecorder) UpdateShardCountRequest(arg0 interface{})
 }
 
 // UpdateShardCountWithContext mocks base method
-func (m *MockKinesisAPI) UpdateShardCountWithContext(arg0 aws.Context, arg1 *kinesis.UpdateShardCountInput, arg2 ...request.Option) (*kinesis.UpdateShardCountOutput, error) {
+func (m *MockKinesisAPI) UpdateShardCountWithContext(arg0 context.Context, arg1 *kinesis.UpdateShardCountInput, arg2 ...request.Option) (*kinesis.UpdateShardCountOutput, error) {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -1018,6 +1355,50 @@ func (mr *MockKinesisAPIMockRecorder) UpdateShardCountWithContext(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardCountWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).UpdateShardCountWithContext), varargs...)
 }
 
+// UpdateStreamMode mocks base method
+func (m *MockKinesisAPI) UpdateStreamMode(arg0 *kinesis.UpdateStreamModeInput) (*kinesis.UpdateStreamModeOutput, error) {
+	ret := m.ctrl.Call(m, "UpdateStreamMode", arg0)
+	ret0, _ := ret[0].(*kinesis.UpdateStreamModeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStreamMode indicates an expected call of UpdateStreamMode
+func (mr *MockKinesisAPIMockRecorder) UpdateStreamMode(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStreamMode", reflect.TypeOf((*MockKinesisAPI)(nil).UpdateStreamMode), arg0)
+}
+
+// UpdateStreamModeRequest mocks base method
+func (m *MockKinesisAPI) UpdateStreamModeRequest(arg0 *kinesis.UpdateStreamModeInput) (*request.Request, *kinesis.UpdateStreamModeOutput) {
+	ret := m.ctrl.Call(m, "UpdateStreamModeRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*kinesis.UpdateStreamModeOutput)
+	return ret0, ret1
+}
+
+// UpdateStreamModeRequest indicates an expected call of UpdateStreamModeRequest
+func (mr *MockKinesisAPIMockRecorder) UpdateStreamModeRequest(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStreamModeRequest", reflect.TypeOf((*MockKinesisAPI)(nil).UpdateStreamModeRequest), arg0)
+}
+
+// UpdateStreamModeWithContext mocks base method
+func (m *MockKinesisAPI) UpdateStreamModeWithContext(arg0 context.Context, arg1 *kinesis.UpdateStreamModeInput, arg2 ...request.Option) (*kinesis.UpdateStreamModeOutput, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStreamModeWithContext", varargs...)
+	ret0, _ := ret[0].(*kinesis.UpdateStreamModeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStreamModeWithContext indicates an expected call of UpdateStreamModeWithContext
+func (mr *MockKinesisAPIMockRecorder) UpdateStreamModeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStreamModeWithContext", reflect.TypeOf((*MockKinesisAPI)(nil).UpdateStreamModeWithContext), varargs...)
+}
+
 // WaitUntilStreamExists mocks base method
 func (m *MockKinesisAPI) WaitUntilStreamExists(arg0 *kinesis.DescribeStreamInput) error {
 	ret := m.ctrl.Call(m, "WaitUntilStreamExists", arg0)
@@ -1031,7 +1412,7 @@ func (mr *MockKinesisAPIMockRecorder) WaitUntilStreamExists(arg0 interface{}) *g
 }
 
 // WaitUntilStreamExistsWithContext mocks base method
-func (m *MockKinesisAPI) WaitUntilStreamExistsWithContext(arg0 aws.Context, arg1 *kinesis.DescribeStreamInput, arg2 ...request.WaiterOption) error {
+func (m *MockKinesisAPI) WaitUntilStreamExistsWithContext(arg0 context.Context, arg1 *kinesis.DescribeStreamInput, arg2 ...request.WaiterOption) error {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -1060,7 +1441,7 @@ func (mr *MockKinesisAPIMockRecorder) WaitUntilStreamNotExists(arg0 interface{})
 }
 
 // WaitUntilStreamNotExistsWithContext mocks base method
-func (m *MockKinesisAPI) WaitUntilStreamNotExistsWithContext(arg0 aws.Context, arg1 *kinesis.DescribeStreamInput, arg2 ...request.WaiterOption) error {
+func (m *MockKinesisAPI) WaitUntilStreamNotExistsWithContext(arg0 context.Context, arg1 *kinesis.DescribeStreamInput, arg2 ...request.WaiterOption) error {
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
@@ -1,8 +1,10 @@
 package awslambda
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -31,14 +33,65 @@ type AWSLambda struct {
 	AWSAccessKeyID     string `json:"awsAccessKeyId,omitempty"`
 	AWSSecretAccessKey string `json:"awsSecretAccessKey,omitempty"`
 	AWSSessionToken    string `json:"awsSessionToken,omitempty"`
+	// InvocationType is one of "RequestResponse" or "Event". By default sync subscriptions use "RequestResponse" and
+	// async subscriptions use "Event".
+	InvocationType string `json:"invocationType,omitempty" validate:"omitempty,eq=RequestResponse|eq=Event"`
+	// Qualifier is a version or alias of the function.
+	Qualifier string `json:"qualifier,omitempty"`
+	// ClientContext is passed to the function in the context object. Only RequestResponse invocations receive it.
+	ClientContext map[string]interface{} `json:"clientContext,omitempty"`
+	// LogTail enables capturing the last 4 KB of the function logs. Logs are included in function errors.
+	LogTail bool `json:"logTail,omitempty"`
 }
 
+// maxClientContextSize is a maximum size of base64 encoded client context accepted by AWS Lambda.
+const maxClientContextSize = 3583
+
+var errClientContextTooLarge = errors.New("client context cannot be larger than 3583 bytes when base64 encoded")
+
 // Call AWS Lambda function.
 func (a AWSLambda) Call(payload []byte) ([]byte, error) {
-	invokeOutput, err := a.Service.Invoke(&lambda.InvokeInput{
+	invocationType := a.InvocationType
+	if invocationType == "" {
+		invocationType = lambda.InvocationTypeRequestResponse
+	}
+	return a.invoke(payload, invocationType)
+}
+
+// CallAsync invokes AWS Lambda function with "Event" invocation type, unless other type is configured. Failed "Event"
+// invocations are retried by AWS Lambda and sent to function's dead letter queue.
+func (a AWSLambda) CallAsync(payload []byte) error {
+	invocationType := a.InvocationType
+	if invocationType == "" {
+		invocationType = lambda.InvocationTypeEvent
+	}
+	_, err := a.invoke(payload, invocationType)
+	return err
+}
+
+func (a AWSLambda) invoke(payload []byte, invocationType string) ([]byte, error) {
+	input := &lambda.InvokeInput{
 		FunctionName: &a.ARN,
 		Payload:      payload,
-	})
+	}
+	// RequestResponse is the default invocation type so it's set only if configured
+	if a.InvocationType != "" || invocationType != lambda.InvocationTypeRequestResponse {
+		input.InvocationType = aws.String(invocationType)
+	}
+	if a.Qualifier != "" {
+		input.Qualifier = aws.String(a.Qualifier)
+	}
+	if invocationType == lambda.InvocationTypeRequestResponse {
+		if len(a.ClientContext) > 0 {
+			clientContext, _ := a.clientContext()
+			input.ClientContext = aws.String(clientContext)
+		}
+		if a.LogTail {
+			input.LogType = aws.String(lambda.LogTypeTail)
+		}
+	}
+
+	invokeOutput, err := a.Service.Invoke(input)
 	if err != nil {
 		if awserr, ok := err.(awserr.Error); ok {
 			switch awserr.Code() {
@@ -55,7 +108,14 @@ func (a AWSLambda) Call(payload []byte) ([]byte, error) {
 	}
 
 	if invokeOutput.FunctionError != nil {
-		return nil, &function.ErrFunctionError{Original: errors.New(*invokeOutput.FunctionError)}
+		message := *invokeOutput.FunctionError
+		if invokeOutput.LogResult != nil {
+			logs, err := base64.StdEncoding.DecodeString(*invokeOutput.LogResult)
+			if err == nil {
+				message += "\n" + strings.TrimSpace(string(logs))
+			}
+		}
+		return nil, &function.ErrFunctionError{Original: errors.New(message)}
 	}
 
 	return invokeOutput.Payload, err
@@ -68,9 +128,33 @@ func (a AWSLambda) validate() error {
 	if err != nil {
 		return err
 	}
+	clientContext, err := a.clientContext()
+	if err != nil {
+		return err
+	}
+	if len(clientContext) > maxClientContextSize {
+		return errClientContextTooLarge
+	}
 	return nil
 }
 
+// clientContext returns base64 encoded JSON of the client context.
+func (a AWSLambda) clientContext() (string, error) {
+	if len(a.ClientContext) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(a.ClientContext)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// CredentialFields is a part of function.CredentialsProvider interface.
+func (a AWSLambda) CredentialFields() []string {
+	return []string{"awsSecretAccessKey", "awsSessionToken"}
+}
+
 // MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
 func (a AWSLambda) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("arn", a.ARN)
@@ -84,6 +168,15 @@ func (a AWSLambda) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if a.AWSSessionToken != "" {
 		enc.AddString("awsSessionToken", "*****")
 	}
+	if a.InvocationType != "" {
+		enc.AddString("invocationType", a.InvocationType)
+	}
+	if a.Qualifier != "" {
+		enc.AddString("qualifier", a.Qualifier)
+	}
+	if a.LogTail {
+		enc.AddBool("logTail", a.LogTail)
+	}
 	return nil
 }
 
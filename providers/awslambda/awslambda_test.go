@@ -2,6 +2,7 @@ package awslambda_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -51,6 +52,85 @@ func TestCall(t *testing.T) {
 	}
 }
 
+func TestCall_InvocationConfig(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock.NewMockLambdaAPI(mockCtrl)
+	serviceMock.EXPECT().Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String("testarn"),
+		Payload:        []byte("testpayload"),
+		InvocationType: aws.String("RequestResponse"),
+		Qualifier:      aws.String("live"),
+		ClientContext:  aws.String("eyJjdXN0b20iOnsic291cmNlIjoiZXZlbnQtZ2F0ZXdheSJ9fQ=="),
+		LogType:        aws.String("Tail"),
+	}).Return(&lambda.InvokeOutput{
+		FunctionError: aws.String("Unhandled"),
+		LogResult:     aws.String("U1RBUlQgUmVxdWVzdElkOiAxCmJvb20K"),
+	}, nil)
+
+	provider := awslambda.AWSLambda{
+		Service: serviceMock,
+
+		ARN:            "testarn",
+		Region:         "us-east-1",
+		InvocationType: "RequestResponse",
+		Qualifier:      "live",
+		ClientContext:  map[string]interface{}{"custom": map[string]interface{}{"source": "event-gateway"}},
+		LogTail:        true,
+	}
+
+	_, err := provider.Call([]byte("testpayload"))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("Unhandled\nSTART RequestId: 1\nboom")}, err)
+}
+
+func TestCallAsync(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock.NewMockLambdaAPI(mockCtrl)
+	serviceMock.EXPECT().Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String("testarn"),
+		Payload:        []byte("testpayload"),
+		InvocationType: aws.String("Event"),
+	}).Return(&lambda.InvokeOutput{StatusCode: aws.Int64(202)}, nil)
+
+	provider := awslambda.AWSLambda{
+		Service: serviceMock,
+
+		ARN:           "testarn",
+		Region:        "us-east-1",
+		ClientContext: map[string]interface{}{"custom": "ignored"},
+		LogTail:       true,
+	}
+
+	err := provider.CallAsync([]byte("testpayload"))
+
+	assert.Nil(t, err)
+}
+
+func TestCallAsync_RequestResponse(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock.NewMockLambdaAPI(mockCtrl)
+	serviceMock.EXPECT().Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String("testarn"),
+		Payload:        []byte("testpayload"),
+		InvocationType: aws.String("RequestResponse"),
+	}).Return(&lambda.InvokeOutput{FunctionError: aws.String("Handled")}, nil)
+
+	provider := awslambda.AWSLambda{
+		Service: serviceMock,
+
+		ARN:            "testarn",
+		Region:         "us-east-1",
+		InvocationType: "RequestResponse",
+	}
+
+	err := provider.CallAsync([]byte("testpayload"))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("Handled")}, err)
+}
+
 func TestMarshalLogObject(t *testing.T) {
 	for _, testCase := range logTests {
 		enc := zapcore.NewMapObjectEncoder()
@@ -77,6 +157,19 @@ var loadTests = []struct {
 		`{"arn": "test", "region": ""}`,
 		errors.New("missing required fields for AWS Lambda function"),
 	},
+	{
+		`{"arn": "test", "region": "us-east-1", "invocationType": "DryRun"}`,
+		errors.New("missing required fields for AWS Lambda function"),
+	},
+	{
+		`{"arn": "test", "region": "us-east-1", "clientContext": {"custom": "` + strings.Repeat("a", 3000) + `"}}`,
+		errors.New("missing required fields for AWS Lambda function"),
+	},
+	{
+		`{"arn": "test", "region": "us-east-1", "invocationType": "Event", "qualifier": "live",
+			"clientContext": {"custom": {"source": "event-gateway"}}, "logTail": true}`,
+		nil,
+	},
 }
 
 var callTests = []struct {
@@ -145,4 +238,20 @@ var logTests = []struct {
 			"awsSessionToken":    "*****",
 		},
 	},
+	{
+		awslambda.AWSLambda{
+			ARN:            "test",
+			Region:         "us-east-1",
+			InvocationType: "Event",
+			Qualifier:      "live",
+			LogTail:        true,
+		},
+		map[string]interface{}{
+			"arn":            "test",
+			"region":         "us-east-1",
+			"invocationType": "Event",
+			"qualifier":      "live",
+			"logTail":        true,
+		},
+	},
 }
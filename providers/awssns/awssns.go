@@ -0,0 +1,201 @@
+package awssns
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("awssns")
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// AWSSNS function implementation
+type AWSSNS struct {
+	Service snsiface.SNSAPI `json:"-" validate:"-"`
+
+	TopicARN string `json:"topicArn" validate:"required"`
+	Region   string `json:"region" validate:"required"`
+	// MessageGroupID is a path to the event attribute used as a message group ID e.g. "source" or
+	// "extensions.tenant". It's required for FIFO topics and not allowed for standard topics.
+	MessageGroupID     string `json:"messageGroupId,omitempty"`
+	AWSAccessKeyID     string `json:"awsAccessKeyId,omitempty"`
+	AWSSecretAccessKey string `json:"awsSecretAccessKey,omitempty"`
+	AWSSessionToken    string `json:"awsSessionToken,omitempty"`
+}
+
+var (
+	errInvalidGroupID = errors.New("message group ID is required for FIFO topics only")
+	errMissingGroupID = errors.New("event has no attribute used as message group ID")
+	errMissingEventID = errors.New("event has no eventID used as message deduplication ID")
+)
+
+// Call publishes message to AWS SNS topic. CloudEvents extensions are sent as message attributes.
+func (a AWSSNS) Call(payload []byte) ([]byte, error) {
+	var event map[string]interface{}
+	// payload that is not a CloudEvent is published without message attributes
+	json.Unmarshal(payload, &event)
+
+	input := &sns.PublishInput{
+		TopicArn:          &a.TopicARN,
+		Message:           aws.String(string(payload)),
+		MessageAttributes: messageAttributes(event),
+	}
+
+	if a.fifo() {
+		groupID, ok := attribute(event, a.MessageGroupID)
+		if !ok {
+			return nil, &function.ErrFunctionCallFailed{Original: errMissingGroupID}
+		}
+		eventID, ok := attribute(event, "eventID")
+		if !ok {
+			return nil, &function.ErrFunctionCallFailed{Original: errMissingEventID}
+		}
+		input.MessageGroupId = &groupID
+		input.MessageDeduplicationId = &eventID
+	}
+
+	publishOutput, err := a.Service.Publish(input)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	return []byte(aws.StringValue(publishOutput.MessageId)), nil
+}
+
+// fifo returns true if the topic is a FIFO topic.
+func (a AWSSNS) fifo() bool {
+	return strings.HasSuffix(a.TopicARN, ".fifo")
+}
+
+// messageAttributes maps CloudEvents extensions to message attributes. Numbers are sent as Number attributes, strings
+// as String attributes and other values are JSON encoded.
+func messageAttributes(event map[string]interface{}) map[string]*sns.MessageAttributeValue {
+	extensions, ok := event["extensions"].(map[string]interface{})
+	if !ok || len(extensions) == 0 {
+		return nil
+	}
+
+	attributes := map[string]*sns.MessageAttributeValue{}
+	for name, value := range extensions {
+		switch v := value.(type) {
+		case nil:
+			continue
+		case string:
+			attributes[name] = &sns.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+		case float64:
+			attributes[name] = &sns.MessageAttributeValue{
+				DataType:    aws.String("Number"),
+				StringValue: aws.String(strconv.FormatFloat(v, 'f', -1, 64)),
+			}
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			attributes[name] = &sns.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(string(encoded))}
+		}
+	}
+	return attributes
+}
+
+// attribute returns value of the event attribute pointed by the dot separated path. Values other than strings are
+// JSON encoded.
+func attribute(event map[string]interface{}, path string) (string, bool) {
+	value, ok := eventpkg.Lookup(event, path)
+	if !ok {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	}
+}
+
+// validate provider config.
+func (a AWSSNS) validate() error {
+	validate := validator.New()
+	err := validate.Struct(a)
+	if err != nil {
+		return err
+	}
+	if a.fifo() != (a.MessageGroupID != "") {
+		return errInvalidGroupID
+	}
+	return nil
+}
+
+// CredentialFields is a part of function.CredentialsProvider interface.
+func (a AWSSNS) CredentialFields() []string {
+	return []string{"awsSecretAccessKey", "awsSessionToken"}
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (a AWSSNS) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("topicArn", a.TopicARN)
+	enc.AddString("region", a.Region)
+	if a.MessageGroupID != "" {
+		enc.AddString("messageGroupId", a.MessageGroupID)
+	}
+	if a.AWSAccessKeyID != "" {
+		enc.AddString("awsAccessKeyId", "*****")
+	}
+	if a.AWSSecretAccessKey != "" {
+		enc.AddString("awsSecretAccessKey", "*****")
+	}
+	if a.AWSSessionToken != "" {
+		enc.AddString("awsSessionToken", "*****")
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &AWSSNS{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for AWS SNS function")
+	}
+
+	config := aws.NewConfig().WithRegion(provider.Region)
+	if provider.AWSAccessKeyID != "" && provider.AWSSecretAccessKey != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(provider.AWSAccessKeyID, provider.AWSSecretAccessKey, provider.AWSSessionToken))
+	}
+
+	awsSession, err := session.NewSession(config)
+	if err != nil {
+		return nil, errors.New("unable to create AWS Session: " + err.Error())
+	}
+
+	provider.Service = sns.New(awsSession)
+	return provider, nil
+}
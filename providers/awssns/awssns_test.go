@@ -0,0 +1,193 @@
+package awssns_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/golang/mock/gomock"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/awssns"
+	"github.com/serverless/event-gateway/providers/awssns/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := awssns.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	for _, testCase := range callTests {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		serviceMock := mock.NewMockSNSAPI(mockCtrl)
+		serviceMock.EXPECT().Publish(gomock.Any()).Return(testCase.publishResult, testCase.publishError)
+
+		provider := awssns.AWSSNS{
+			Service:  serviceMock,
+			TopicARN: "arn:aws:sns:us-east-1:123456789012:MyTopic",
+			Region:   "us-east-1",
+		}
+
+		output, err := provider.Call([]byte("testpayload"))
+
+		assert.Equal(t, testCase.expectedResult, output)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCallMessageAttributes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock.NewMockSNSAPI(mockCtrl)
+	serviceMock.EXPECT().Publish(&sns.PublishInput{
+		TopicArn: aws.String("arn:aws:sns:us-east-1:123456789012:MyTopic"),
+		Message:  aws.String(event),
+		MessageAttributes: map[string]*sns.MessageAttributeValue{
+			"tenant":   {DataType: aws.String("String"), StringValue: aws.String("acme")},
+			"priority": {DataType: aws.String("Number"), StringValue: aws.String("1.5")},
+			"tags":     {DataType: aws.String("String"), StringValue: aws.String(`["a","b"]`)},
+		},
+	}).Return(&sns.PublishOutput{MessageId: aws.String("testid")}, nil)
+
+	provider := awssns.AWSSNS{
+		Service:  serviceMock,
+		TopicARN: "arn:aws:sns:us-east-1:123456789012:MyTopic",
+		Region:   "us-east-1",
+	}
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("testid"), output)
+}
+
+func TestCallFIFO(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock.NewMockSNSAPI(mockCtrl)
+	serviceMock.EXPECT().Publish(gomock.Any()).Do(func(input *sns.PublishInput) {
+		assert.Equal(t, "acme", *input.MessageGroupId)
+		assert.Equal(t, "1", *input.MessageDeduplicationId)
+	}).Return(&sns.PublishOutput{MessageId: aws.String("testid")}, nil)
+
+	provider := awssns.AWSSNS{
+		Service:        serviceMock,
+		TopicARN:       "arn:aws:sns:us-east-1:123456789012:MyTopic.fifo",
+		Region:         "us-east-1",
+		MessageGroupID: "extensions.tenant",
+	}
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("testid"), output)
+
+	provider.MessageGroupID = "extensions.missing"
+
+	_, err = provider.Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("event has no attribute used as message group ID")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+const event = `{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+	`"extensions":{"tenant":"acme","priority":1.5,"tags":["a","b"],"empty":null},"data":{"userId":7}}`
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"topicArn": "", "region": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"topicArn": "", "region": "us-east-1"}`,
+		errors.New("missing required fields for AWS SNS function"),
+	},
+	{
+		`{"topicArn": "arn:aws:sns:us-east-1:123456789012:MyTopic", "region": ""}`,
+		errors.New("missing required fields for AWS SNS function"),
+	},
+	{
+		`{"topicArn": "arn:aws:sns:us-east-1:123456789012:MyTopic.fifo", "region": "us-east-1"}`,
+		errors.New("missing required fields for AWS SNS function"),
+	},
+	{
+		`{"topicArn": "arn:aws:sns:us-east-1:123456789012:MyTopic", "region": "us-east-1", "messageGroupId": "source"}`,
+		errors.New("missing required fields for AWS SNS function"),
+	},
+}
+
+var callTests = []struct {
+	publishResult  *sns.PublishOutput
+	publishError   error
+	expectedResult []byte
+	expectedError  error
+}{
+	{
+		&sns.PublishOutput{MessageId: aws.String("testid")},
+		nil,
+		[]byte("testid"),
+		nil,
+	},
+	{
+		nil,
+		awserr.New("", "", nil),
+		[]byte(nil),
+		&function.ErrFunctionCallFailed{Original: awserr.New("", "", nil)},
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		awssns.AWSSNS{
+			TopicARN: "arn:aws:sns:us-east-1:123456789012:MyTopic",
+			Region:   "us-east-1",
+		},
+		map[string]interface{}{
+			"topicArn": "arn:aws:sns:us-east-1:123456789012:MyTopic",
+			"region":   "us-east-1",
+		},
+	},
+	{
+		awssns.AWSSNS{
+			TopicARN:           "arn:aws:sns:us-east-1:123456789012:MyTopic.fifo",
+			MessageGroupID:     "extensions.tenant",
+			AWSAccessKeyID:     "id",
+			AWSSecretAccessKey: "key",
+			AWSSessionToken:    "token",
+		},
+		map[string]interface{}{
+			"topicArn":           "arn:aws:sns:us-east-1:123456789012:MyTopic.fifo",
+			"region":             "",
+			"messageGroupId":     "extensions.tenant",
+			"awsAccessKeyId":     "*****",
+			"awsSecretAccessKey": "*****",
+			"awsSessionToken":    "*****",
+		},
+	},
+}
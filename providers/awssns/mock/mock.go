@@ -0,0 +1,3 @@
+//go:generate mockgen -package mock -destination snsiface.go github.com/aws/aws-sdk-go/service/sns/snsiface SNSAPI
+
+package mock
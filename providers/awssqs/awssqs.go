@@ -59,6 +59,11 @@ func (a AWSSQS) validate() error {
 	return nil
 }
 
+// CredentialFields is a part of function.CredentialsProvider interface.
+func (a AWSSQS) CredentialFields() []string {
+	return []string{"awsSecretAccessKey", "awsSessionToken"}
+}
+
 // MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
 func (a AWSSQS) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("queueUrl", a.QueueURL)
@@ -0,0 +1,190 @@
+package azurefunctions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("azurefunctions")
+
+const (
+	defaultAuthorityHost = "https://login.microsoftonline.com"
+	defaultTimeout       = 5 * time.Second
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// AzureFunctions function implementation. HTTP-triggered function is invoked with function key or Azure AD access
+// token.
+type AzureFunctions struct {
+	TokenSource oauth2.TokenSource `json:"-" validate:"-"`
+
+	// URL is a URL of the function e.g. "https://app.azurewebsites.net/api/function".
+	URL string `json:"url" validate:"required,url"`
+	// FunctionKey is sent in x-functions-key header.
+	FunctionKey string `json:"functionKey,omitempty"`
+	AAD         *AAD   `json:"aad,omitempty"`
+	// Timeout is a time (in milliseconds) to wait for the function response.
+	Timeout int `json:"timeout,omitempty" validate:"min=0"`
+}
+
+// AAD contains Azure AD application credentials used to get access token with client credentials flow.
+type AAD struct {
+	TenantID     string `json:"tenantId" validate:"required"`
+	ClientID     string `json:"clientId" validate:"required"`
+	ClientSecret string `json:"clientSecret" validate:"required"`
+	// Scope is a scope of the access token e.g. "api://app/.default".
+	Scope string `json:"scope" validate:"required"`
+	// AuthorityHost is a Azure AD authority e.g. "https://login.microsoftonline.us" for national clouds.
+	AuthorityHost string `json:"authorityHost,omitempty" validate:"omitempty,url"`
+}
+
+var errMultipleAuth = errors.New("function key and AAD cannot be used together")
+
+// Call invokes Azure Function.
+func (a AzureFunctions) Call(payload []byte) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, a.URL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	if a.FunctionKey != "" {
+		req.Header.Set("x-functions-key", a.FunctionKey)
+	}
+	if a.TokenSource != nil {
+		token, err := a.TokenSource.Token()
+		if err != nil {
+			return nil, &function.ErrFunctionCallFailed{Original: err}
+		}
+		token.SetAuthHeader(req)
+	}
+
+	client := http.Client{Timeout: a.timeout()}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if err := function.ErrFromStatusCode(resp.StatusCode); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (a AzureFunctions) timeout() time.Duration {
+	if a.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(a.Timeout) * time.Millisecond
+}
+
+// validate provider config.
+func (a AzureFunctions) validate() error {
+	validate := validator.New()
+	err := validate.Struct(a)
+	if err != nil {
+		return err
+	}
+	if a.FunctionKey != "" && a.AAD != nil {
+		return errMultipleAuth
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (a AzureFunctions) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("url", a.URL)
+	if a.FunctionKey != "" {
+		enc.AddString("functionKey", "*****")
+	}
+	if a.AAD != nil {
+		enc.AddString("aadTenantId", a.AAD.TenantID)
+		enc.AddString("aadClientId", a.AAD.ClientID)
+		if a.AAD.ClientSecret != "" {
+			enc.AddString("aadClientSecret", "*****")
+		}
+		enc.AddString("aadScope", a.AAD.Scope)
+	}
+	if a.Timeout != 0 {
+		enc.AddInt("timeout", a.Timeout)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &AzureFunctions{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for Azure Functions function")
+	}
+
+	if provider.AAD != nil {
+		provider.TokenSource = tokenSources.get(*provider.AAD)
+	}
+	return provider, nil
+}
+
+type tokenSourcePool struct {
+	sync.Mutex
+	tokenSources map[AAD]oauth2.TokenSource
+}
+
+// tokenSources is a pool of access token sources reused across function config updates. Token source caches the
+// token until it expires.
+var tokenSources = &tokenSourcePool{tokenSources: map[AAD]oauth2.TokenSource{}}
+
+func (p *tokenSourcePool) get(aad AAD) oauth2.TokenSource {
+	p.Lock()
+	defer p.Unlock()
+
+	if tokenSource, ok := p.tokenSources[aad]; ok {
+		return tokenSource
+	}
+
+	authorityHost := aad.AuthorityHost
+	if authorityHost == "" {
+		authorityHost = defaultAuthorityHost
+	}
+	config := &clientcredentials.Config{
+		ClientID:     aad.ClientID,
+		ClientSecret: aad.ClientSecret,
+		TokenURL:     strings.TrimSuffix(authorityHost, "/") + "/" + aad.TenantID + "/oauth2/v2.0/token",
+		Scopes:       []string{aad.Scope},
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+
+	tokenSource := config.TokenSource(context.Background())
+	p.tokenSources[aad] = tokenSource
+	return tokenSource
+}
@@ -0,0 +1,178 @@
+package azurefunctions_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/internal/azuretest"
+	"github.com/serverless/event-gateway/providers/azurefunctions"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := azurefunctions.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCallFunctionKey(t *testing.T) {
+	server := azuretest.NewServer()
+	defer server.Close()
+	server.HandleFunc("/api/function", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-functions-key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+		w.Write(append([]byte("received "), body...))
+	})
+
+	output, err := load(t, `{"url": "`+server.URL+`/api/function", "functionKey": "key"}`).Call([]byte("testpayload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("received testpayload"), output)
+
+	_, err = load(t, `{"url": "`+server.URL+`/api/function", "functionKey": "wrong"}`).Call([]byte("testpayload"))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("HTTP status code: 401")}, err)
+}
+
+func TestCallAAD(t *testing.T) {
+	server := azuretest.NewServer()
+	defer server.Close()
+	server.AddApplication("tenant", "client", "secret")
+	server.HandleFunc("/api/function", func(w http.ResponseWriter, r *http.Request) {
+		scope, ok := server.Scope(r)
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(scope))
+	})
+	provider := load(t, `{"url": "`+server.URL+`/api/function", "aad": {"tenantId": "tenant", "clientId": "client",
+		"clientSecret": "secret", "scope": "api://app/.default", "authorityHost": "`+server.URL+`"}}`)
+
+	output, err := provider.Call([]byte("testpayload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("api://app/.default"), output)
+
+	output, err = provider.Call([]byte("testpayload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("api://app/.default"), output)
+	assert.Equal(t, 1, server.TokenRequests())
+
+	provider = load(t, `{"url": "`+server.URL+`/api/function", "aad": {"tenantId": "tenant", "clientId": "client",
+		"clientSecret": "wrong", "scope": "api://app/.default", "authorityHost": "`+server.URL+`"}}`)
+
+	_, err = provider.Call([]byte("testpayload"))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestCallFailed(t *testing.T) {
+	server := azuretest.NewServer()
+	defer server.Close()
+	server.HandleFunc("/api/function", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := load(t, `{"url": "`+server.URL+`/api/function"}`).Call([]byte("testpayload"))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 500")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := azurefunctions.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"url": "https://app.azurewebsites.net/api/function", "functionKey": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"url": ""}`,
+		errors.New("missing required fields for Azure Functions function"),
+	},
+	{
+		`{"url": "https://app.azurewebsites.net/api/function", "aad": {"tenantId": "tenant", "clientId": "client"}}`,
+		errors.New("missing required fields for Azure Functions function"),
+	},
+	{
+		`{"url": "https://app.azurewebsites.net/api/function", "functionKey": "key", "aad": {"tenantId": "tenant",
+			"clientId": "client", "clientSecret": "secret", "scope": "api://app/.default"}}`,
+		errors.New("missing required fields for Azure Functions function"),
+	},
+	{
+		`{"url": "https://app.azurewebsites.net/api/function", "timeout": -1}`,
+		errors.New("missing required fields for Azure Functions function"),
+	},
+	{
+		`{"url": "https://app.azurewebsites.net/api/function", "aad": {"tenantId": "tenant", "clientId": "client",
+			"clientSecret": "secret", "scope": "api://app/.default"}, "timeout": 10000}`,
+		nil,
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		azurefunctions.AzureFunctions{
+			URL:         "https://app.azurewebsites.net/api/function",
+			FunctionKey: "key",
+		},
+		map[string]interface{}{
+			"url":         "https://app.azurewebsites.net/api/function",
+			"functionKey": "*****",
+		},
+	},
+	{
+		azurefunctions.AzureFunctions{
+			URL: "https://app.azurewebsites.net/api/function",
+			AAD: &azurefunctions.AAD{
+				TenantID:     "tenant",
+				ClientID:     "client",
+				ClientSecret: "secret",
+				Scope:        "api://app/.default",
+			},
+			Timeout: 10000,
+		},
+		map[string]interface{}{
+			"url":             "https://app.azurewebsites.net/api/function",
+			"aadTenantId":     "tenant",
+			"aadClientId":     "client",
+			"aadClientSecret": "*****",
+			"aadScope":        "api://app/.default",
+			"timeout":         10000,
+		},
+	},
+}
@@ -0,0 +1,280 @@
+package azureservicebus
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("azureservicebus")
+
+const (
+	timeout  = 5 * time.Second
+	tokenTTL = time.Hour
+	// propertyPrefix is a prefix of properties that CloudEvents attributes are mapped to by default.
+	propertyPrefix = "cloudEvents_"
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// AzureServiceBus function implementation. Event is sent to Service Bus queue or topic with REST API.
+type AzureServiceBus struct {
+	// ConnectionString is a Service Bus connection string with shared access key e.g.
+	// "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=key".
+	ConnectionString string `json:"connectionString" validate:"required"`
+	Queue            string `json:"queue,omitempty"`
+	Topic            string `json:"topic,omitempty"`
+	// SessionID is a path to the event attribute used as a message session ID e.g. "source" or "extensions.tenant".
+	SessionID string `json:"sessionId,omitempty"`
+	// Properties maps message property names to event attribute paths e.g. {"tenant": "extensions.tenant"}. By
+	// default all attributes and extensions, except data, are mapped to properties prefixed with "cloudEvents_".
+	Properties map[string]string `json:"properties,omitempty"`
+
+	endpoint string
+	keyName  string
+	key      string
+}
+
+// brokerProperties are system properties of the message.
+type brokerProperties struct {
+	MessageID string `json:"MessageId,omitempty"`
+	Label     string `json:"Label,omitempty"`
+	SessionID string `json:"SessionId,omitempty"`
+}
+
+var (
+	errInvalidEntity           = errors.New("either queue or topic has to be provided")
+	errInvalidProperties       = errors.New("property name and attribute path cannot be empty")
+	errInvalidConnectionString = errors.New("endpoint, shared access key name and key are required")
+	errMissingSessionID        = errors.New("event has no attribute used as session ID")
+)
+
+// Call sends event to Service Bus queue or topic. Event ID is used as message ID and event type as message label. It
+// returns ID of the sent message.
+func (a AzureServiceBus) Call(payload []byte) ([]byte, error) {
+	var event map[string]interface{}
+	// payload that is not a CloudEvent is sent without properties
+	json.Unmarshal(payload, &event)
+
+	properties := brokerProperties{}
+	properties.MessageID, _ = attribute(event, "eventID")
+	properties.Label, _ = attribute(event, "eventType")
+	if a.SessionID != "" {
+		sessionID, ok := attribute(event, a.SessionID)
+		if !ok {
+			return nil, &function.ErrFunctionCallFailed{Original: errMissingSessionID}
+		}
+		properties.SessionID = sessionID
+	}
+	encodedProperties, err := json.Marshal(properties)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	resource := a.endpoint + "/" + a.entity()
+	req, err := http.NewRequest(http.MethodPost, resource+"/messages", bytes.NewReader(payload))
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	req.Header.Set("Authorization", a.sharedAccessSignature(resource))
+	req.Header.Set("BrokerProperties", string(encodedProperties))
+	for name, value := range a.properties(event) {
+		// property names are case sensitive so the header name is not canonicalized
+		req.Header[name] = []string{value}
+	}
+
+	client := http.Client{Timeout: timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return nil, &function.ErrFunctionCallFailed{Original: fmt.Errorf("HTTP status code: %d", resp.StatusCode)}
+	}
+
+	return []byte(properties.MessageID), nil
+}
+
+func (a AzureServiceBus) entity() string {
+	if a.Queue != "" {
+		return url.PathEscape(a.Queue)
+	}
+	return url.PathEscape(a.Topic)
+}
+
+// sharedAccessSignature returns SAS token for the resource.
+func (a AzureServiceBus) sharedAccessSignature(resource string) string {
+	encodedResource := url.QueryEscape(resource)
+	expiry := strconv.FormatInt(time.Now().Add(tokenTTL).Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(a.key))
+	mac.Write([]byte(encodedResource + "\n" + expiry))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return "SharedAccessSignature sr=" + encodedResource + "&sig=" + url.QueryEscape(signature) + "&se=" + expiry +
+		"&skn=" + url.QueryEscape(a.keyName)
+}
+
+// properties maps event attributes to message properties. String values are quoted as required by Service Bus REST
+// API, other values are sent as JSON.
+func (a AzureServiceBus) properties(event map[string]interface{}) map[string]string {
+	mapping := a.Properties
+	if len(mapping) == 0 {
+		mapping = map[string]string{}
+		for name, value := range event {
+			switch name {
+			case "data":
+				// data is sent as the message body
+			case "extensions":
+				if extensions, ok := value.(map[string]interface{}); ok {
+					for extension := range extensions {
+						mapping[propertyPrefix+extension] = "extensions." + extension
+					}
+				}
+			default:
+				mapping[propertyPrefix+name] = name
+			}
+		}
+	}
+
+	properties := map[string]string{}
+	for name, path := range mapping {
+		value, ok := eventpkg.Lookup(event, path)
+		if !ok {
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		properties[name] = string(encoded)
+	}
+	return properties
+}
+
+// attribute returns string value of the event attribute pointed by the dot separated path. Values other than strings
+// are JSON encoded.
+func attribute(event map[string]interface{}, path string) (string, bool) {
+	value, ok := eventpkg.Lookup(event, path)
+	if !ok {
+		return "", false
+	}
+	if str, ok := value.(string); ok {
+		return str, str != ""
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
+}
+
+// parseConnectionString sets endpoint and shared access key from the connection string. "sb" endpoint scheme is
+// replaced with "https".
+func (a *AzureServiceBus) parseConnectionString() error {
+	for _, part := range strings.Split(a.ConnectionString, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(kv[0])) {
+		case "endpoint":
+			a.endpoint = strings.TrimSuffix(kv[1], "/")
+		case "sharedaccesskeyname":
+			a.keyName = kv[1]
+		case "sharedaccesskey":
+			a.key = kv[1]
+		}
+	}
+
+	endpoint, err := url.Parse(a.endpoint)
+	if err != nil || endpoint.Host == "" || a.keyName == "" || a.key == "" {
+		return errInvalidConnectionString
+	}
+	if endpoint.Scheme == "sb" {
+		endpoint.Scheme = "https"
+		a.endpoint = endpoint.String()
+	}
+	return nil
+}
+
+// validate provider config.
+func (a AzureServiceBus) validate() error {
+	validate := validator.New()
+	err := validate.Struct(a)
+	if err != nil {
+		return err
+	}
+	if (a.Queue == "") == (a.Topic == "") {
+		return errInvalidEntity
+	}
+	for name, path := range a.Properties {
+		if name == "" || path == "" {
+			return errInvalidProperties
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (a AzureServiceBus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if a.ConnectionString != "" {
+		enc.AddString("connectionString", "*****")
+	}
+	if a.endpoint != "" {
+		enc.AddString("endpoint", a.endpoint)
+	}
+	if a.Queue != "" {
+		enc.AddString("queue", a.Queue)
+	}
+	if a.Topic != "" {
+		enc.AddString("topic", a.Topic)
+	}
+	if a.SessionID != "" {
+		enc.AddString("sessionId", a.SessionID)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &AzureServiceBus{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for Azure Service Bus function")
+	}
+
+	err = provider.parseConnectionString()
+	if err != nil {
+		return nil, errors.New("invalid connection string for Azure Service Bus function: " + err.Error())
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,189 @@
+package azureservicebus_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/internal/azuretest"
+	"github.com/serverless/event-gateway/providers/azureservicebus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := azureservicebus.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	server := azuretest.NewServer()
+	defer server.Close()
+	server.AddSharedAccessKey("send", "key")
+	server.CreateEntity("users")
+	provider := load(t, `{"connectionString": "`+connectionString(server, "key")+`", "queue": "users"}`)
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), output)
+	messages := server.Messages("users")
+	assert.Len(t, messages, 1)
+	assert.Equal(t, []byte(event), messages[0].Body)
+	assert.Equal(t, "application/cloudevents+json", messages[0].ContentType)
+	assert.Equal(t, map[string]interface{}{"MessageId": "1", "Label": "user.created"}, messages[0].BrokerProperties)
+	assert.Equal(t, map[string]string{
+		"Cloudevents_eventtype":          `"user.created"`,
+		"Cloudevents_cloudeventsversion": `"0.1"`,
+		"Cloudevents_source":             `"/users"`,
+		"Cloudevents_eventid":            `"1"`,
+		"Cloudevents_tenant":             `"acme"`,
+		"Cloudevents_priority":           `2`,
+	}, messages[0].Properties)
+}
+
+func TestCallPropertiesAndSession(t *testing.T) {
+	server := azuretest.NewServer()
+	defer server.Close()
+	server.AddSharedAccessKey("send", "key")
+	server.CreateEntity("events")
+	provider := load(t, `{"connectionString": "`+connectionString(server, "key")+`", "topic": "events",
+		"sessionId": "extensions.tenant", "properties": {"tenant": "extensions.tenant", "user": "data.userId",
+		"missing": "extensions.missing"}}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	message := server.Messages("events")[0]
+	assert.Equal(t, "acme", message.BrokerProperties["SessionId"])
+	assert.Equal(t, map[string]string{"Tenant": `"acme"`, "User": `7`}, message.Properties)
+
+	provider = load(t, `{"connectionString": "`+connectionString(server, "key")+`", "topic": "events",
+		"sessionId": "extensions.missing"}`)
+
+	_, err = provider.Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("event has no attribute used as session ID")}, err)
+}
+
+func TestCallFailed(t *testing.T) {
+	server := azuretest.NewServer()
+	defer server.Close()
+	server.AddSharedAccessKey("send", "key")
+	server.CreateEntity("users")
+
+	_, err := load(t, `{"connectionString": "`+connectionString(server, "wrong")+`", "queue": "users"}`).
+		Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 401")}, err)
+
+	_, err = load(t, `{"connectionString": "`+connectionString(server, "key")+`", "queue": "orders"}`).
+		Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 404")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+func TestMarshalLogObjectLoaded(t *testing.T) {
+	provider := load(t, `{"connectionString": "`+validConnectionString+`", "queue": "users"}`)
+	enc := zapcore.NewMapObjectEncoder()
+
+	provider.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{
+		"connectionString": "*****",
+		"endpoint":         "https://namespace.servicebus.windows.net",
+		"queue":            "users",
+	}, enc.Fields)
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := azureservicebus.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+func connectionString(server *azuretest.Server, key string) string {
+	return "Endpoint=" + server.URL + "/;SharedAccessKeyName=send;SharedAccessKey=" + key
+}
+
+const event = `{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+	`"extensions":{"tenant":"acme","priority":2},"data":{"userId":7}}`
+
+const validConnectionString = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=key"
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"connectionString": "", "queue": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"connectionString": "", "queue": "users"}`,
+		errors.New("missing required fields for Azure Service Bus function"),
+	},
+	{
+		`{"connectionString": "` + validConnectionString + `"}`,
+		errors.New("missing required fields for Azure Service Bus function"),
+	},
+	{
+		`{"connectionString": "` + validConnectionString + `", "queue": "users", "topic": "events"}`,
+		errors.New("missing required fields for Azure Service Bus function"),
+	},
+	{
+		`{"connectionString": "` + validConnectionString + `", "queue": "users", "properties": {"tenant": ""}}`,
+		errors.New("missing required fields for Azure Service Bus function"),
+	},
+	{
+		`{"connectionString": "Endpoint=sb://namespace.servicebus.windows.net/", "queue": "users"}`,
+		errors.New("invalid connection string for Azure Service Bus function: " +
+			"endpoint, shared access key name and key are required"),
+	},
+	{
+		`{"connectionString": "` + validConnectionString + `", "queue": "users"}`,
+		nil,
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		azureservicebus.AzureServiceBus{
+			Queue: "users",
+		},
+		map[string]interface{}{
+			"queue": "users",
+		},
+	},
+	{
+		azureservicebus.AzureServiceBus{
+			ConnectionString: validConnectionString,
+			Topic:            "events",
+			SessionID:        "extensions.tenant",
+		},
+		map[string]interface{}{
+			"connectionString": "*****",
+			"topic":            "events",
+			"sessionId":        "extensions.tenant",
+		},
+	},
+}
@@ -0,0 +1,287 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("exec")
+
+const defaultTimeout = 5 * time.Second
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// Exec function implementation. Function is a local binary or script. By default a new process is started for every
+// event. The event is written to the process stdin and the process stdout is returned as function response. If
+// Workers is set, events are sent to a pool of long-lived processes speaking newline-delimited JSON.
+type Exec struct {
+	// Command is a path or a name (looked up in PATH) of the executable.
+	Command string   `json:"command" validate:"required"`
+	Args    []string `json:"args,omitempty"`
+	// Env contains environment variables set in addition to Event Gateway environment.
+	Env map[string]string `json:"env,omitempty"`
+	// Dir is a working directory of the process. By default Event Gateway working directory is used.
+	Dir string `json:"dir,omitempty"`
+	// Timeout is a time (in milliseconds) to wait for the response.
+	Timeout int `json:"timeout,omitempty" validate:"min=0"`
+	// Workers is a number of long-lived worker processes. Worker reads events from stdin, one event per line, and
+	// writes one line response per event to stdout.
+	Workers int `json:"workers,omitempty" validate:"min=0"`
+
+	// path is an absolute path of the allowed executable resolved when the function is loaded.
+	path string
+	pool *workerPool
+}
+
+var (
+	errInvalidEnv = errors.New("environment variable name cannot be empty or contain \"=\"")
+	errTimeout    = errors.New("function timed out")
+	errDisabled   = errors.New("exec functions are disabled")
+)
+
+// Config configures which executables exec functions can run. Exec functions run arbitrary processes on the Event
+// Gateway host, so they are disabled unless enabled with Configure.
+type Config struct {
+	// Commands are executables, or directories containing executables, that functions can run. Allowing an
+	// interpreter (e.g. "sh" or "node") allows running any code passed in args.
+	Commands []string
+	// Dirs are directories (including subdirectories) that functions can use as working directory.
+	Dirs []string
+}
+
+var (
+	configMutex sync.RWMutex
+	// allowedCommands and allowedDirs contain paths with symlinks resolved. nil allowedCommands means exec functions
+	// are disabled.
+	allowedCommands []string
+	allowedDirs     []string
+)
+
+// Configure enables exec functions running the configured commands. Exec functions are disabled if no command is
+// allowed.
+func Configure(config Config) error {
+	commands, err := realPaths(config.Commands)
+	if err != nil {
+		return err
+	}
+	dirs, err := realPaths(config.Dirs)
+	if err != nil {
+		return err
+	}
+
+	configMutex.Lock()
+	defer configMutex.Unlock()
+	allowedCommands = commands
+	allowedDirs = dirs
+	return nil
+}
+
+// allow resolves the executable and checks that the command and the working directory are allowed.
+func (e *Exec) allow() error {
+	configMutex.RLock()
+	defer configMutex.RUnlock()
+
+	if len(allowedCommands) == 0 {
+		return errDisabled
+	}
+
+	path := e.Command
+	if !strings.Contains(path, string(filepath.Separator)) {
+		var err error
+		path, err = osexec.LookPath(path)
+		if err != nil {
+			return err
+		}
+	} else if !filepath.IsAbs(path) && e.Dir != "" {
+		path = filepath.Join(e.Dir, path)
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil || !within(resolved, allowedCommands) {
+		return fmt.Errorf("command %q is not allowed", e.Command)
+	}
+
+	if e.Dir != "" {
+		resolved, err := filepath.EvalSymlinks(e.Dir)
+		if err != nil || !within(resolved, allowedDirs) {
+			return fmt.Errorf("working directory %q is not allowed", e.Dir)
+		}
+	}
+
+	e.path = path
+	return nil
+}
+
+// within returns true if path is one of the roots or is inside one of them.
+func within(path string, roots []string) bool {
+	for _, root := range roots {
+		prefix := strings.TrimSuffix(root, string(filepath.Separator)) + string(filepath.Separator)
+		if path == root || strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func realPaths(paths []string) ([]string, error) {
+	resolved := []string{}
+	for _, path := range paths {
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		path, err = filepath.EvalSymlinks(path)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, path)
+	}
+	return resolved, nil
+}
+
+// Call runs the process or sends the event to one of the workers.
+func (e Exec) Call(payload []byte) ([]byte, error) {
+	if e.pool != nil {
+		return e.pool.call(payload, e.timeout())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout())
+	defer cancel()
+
+	cmd := e.command(ctx)
+	cmd.Stdin = bytes.NewReader(payload)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, &function.ErrFunctionError{Original: errTimeout}
+	}
+	if exitErr, ok := err.(*osexec.ExitError); ok {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			return nil, &function.ErrFunctionError{Original: exitErr}
+		}
+		return nil, &function.ErrFunctionError{Original: fmt.Errorf("%s: %s", exitErr, message)}
+	}
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// command returns command configured with args, environment and working directory.
+func (e Exec) command(ctx context.Context) *osexec.Cmd {
+	command := e.Command
+	if e.path != "" {
+		command = e.path
+	}
+	cmd := osexec.CommandContext(ctx, command, e.Args...)
+	cmd.Dir = e.Dir
+	if len(e.Env) > 0 {
+		cmd.Env = os.Environ()
+		for name, value := range e.Env {
+			cmd.Env = append(cmd.Env, name+"="+value)
+		}
+	}
+	return cmd
+}
+
+func (e Exec) timeout() time.Duration {
+	if e.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(e.Timeout) * time.Millisecond
+}
+
+// validate provider config.
+func (e Exec) validate() error {
+	validate := validator.New()
+	err := validate.Struct(e)
+	if err != nil {
+		return err
+	}
+	for name := range e.Env {
+		if name == "" || strings.Contains(name, "=") {
+			return errInvalidEnv
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (e Exec) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("command", e.Command)
+	if len(e.Args) > 0 {
+		enc.AddString("args", strings.Join(e.Args, " "))
+	}
+	if len(e.Env) > 0 {
+		names := []string{}
+		for name := range e.Env {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		// values are not logged as they may contain secrets
+		enc.AddString("env", strings.Join(names, ","))
+	}
+	if e.Dir != "" {
+		enc.AddString("dir", e.Dir)
+	}
+	if e.Timeout != 0 {
+		enc.AddInt("timeout", e.Timeout)
+	}
+	if e.Workers != 0 {
+		enc.AddInt("workers", e.Workers)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &Exec{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for exec function")
+	}
+
+	err = provider.allow()
+	if err != nil {
+		return nil, errors.New("exec function not allowed: " + err.Error())
+	}
+
+	if provider.Workers > 0 {
+		provider.pool = workerPools.get(*provider)
+	}
+	return provider, nil
+}
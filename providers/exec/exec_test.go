@@ -0,0 +1,208 @@
+package exec_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/exec"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+// testConfig allows commands used in tests.
+var testConfig = exec.Config{Dirs: []string{os.TempDir()}}
+
+func TestMain(m *testing.M) {
+	for _, name := range []string{"cat", "sh", "sleep"} {
+		path, err := osexec.LookPath(name)
+		if err != nil {
+			panic(err)
+		}
+		testConfig.Commands = append(testConfig.Commands, filepath.Dir(path))
+	}
+	err := exec.Configure(testConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := exec.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	output, err := load(t, `{"command": "cat"}`).Call([]byte(`{"eventType":"user.created"}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"eventType":"user.created"}`), output)
+}
+
+func TestCallEnvAndDir(t *testing.T) {
+	dir, err := filepath.EvalSymlinks(os.TempDir())
+	assert.Nil(t, err)
+
+	output, err := load(t, `{"command": "sh", "args": ["-c", "printf '%s %s' \"$GREETING\" \"$(pwd)\""],
+		"env": {"GREETING": "hello"}, "dir": "`+dir+`"}`).Call([]byte(`{}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello "+dir), output)
+}
+
+func TestCallFunctionError(t *testing.T) {
+	_, err := load(t, `{"command": "sh", "args": ["-c", "echo boom >&2; exit 3"]}`).Call([]byte(`{}`))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("exit status 3: boom")}, err)
+}
+
+func TestCallTimeout(t *testing.T) {
+	_, err := load(t, `{"command": "sleep", "args": ["5"], "timeout": 100}`).Call([]byte(`{}`))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("function timed out")}, err)
+}
+
+func TestLoadNotAllowed(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "exec")
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "handler.sh")
+	ioutil.WriteFile(script, []byte("#!/bin/sh\ncat\n"), 0700)
+	link := filepath.Join(dir, "cat")
+	os.Symlink(script, link)
+
+	for _, testCase := range []struct {
+		config        string
+		expectedError string
+	}{
+		{`{"command": "event-gateway-missing-command"}`, "exec function not allowed: exec: " +
+			`"event-gateway-missing-command": executable file not found in $PATH`},
+		{`{"command": "` + script + `"}`, `exec function not allowed: command "` + script + `" is not allowed`},
+		{`{"command": "./handler.sh", "dir": "` + dir + `"}`,
+			`exec function not allowed: command "./handler.sh" is not allowed`},
+		{`{"command": "` + link + `"}`, `exec function not allowed: command "` + link + `" is not allowed`},
+		{`{"command": "cat", "dir": "/"}`, `exec function not allowed: working directory "/" is not allowed`},
+	} {
+		_, err := exec.ProviderLoader{}.Load([]byte(testCase.config))
+
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+func TestLoadDisabled(t *testing.T) {
+	exec.Configure(exec.Config{})
+	defer exec.Configure(testConfig)
+
+	_, err := exec.ProviderLoader{}.Load([]byte(`{"command": "cat"}`))
+
+	assert.EqualError(t, err, "exec function not allowed: exec functions are disabled")
+}
+
+func TestCallWorkers(t *testing.T) {
+	provider := load(t, `{"command": "sh", "args": ["-c", "n=0; while read line; do n=$((n+1)); echo \"$$ $n $line\"; done"],
+		"workers": 1}`)
+
+	first, err := provider.Call([]byte("{\n\"eventType\": \"user.created\"\n}"))
+	assert.Nil(t, err)
+	second, err := provider.Call([]byte(`{"eventType":"user.deleted"}`))
+	assert.Nil(t, err)
+
+	firstFields := strings.SplitN(string(first), " ", 3)
+	secondFields := strings.SplitN(string(second), " ", 3)
+	assert.Equal(t, firstFields[0], secondFields[0])
+	assert.Equal(t, []string{"1", `{"eventType":"user.created"}`}, firstFields[1:])
+	assert.Equal(t, []string{"2", `{"eventType":"user.deleted"}`}, secondFields[1:])
+}
+
+func TestCallWorkersRestart(t *testing.T) {
+	provider := load(t, `{"command": "sh", "args": ["-c", "read line; echo once"], "workers": 1}`)
+
+	output, err := provider.Call([]byte(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("once"), output)
+
+	_, err = provider.Call([]byte(`{}`))
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("worker process exited")}, err)
+
+	output, err = provider.Call([]byte(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("once"), output)
+}
+
+func TestCallWorkersTimeout(t *testing.T) {
+	provider := load(t, `{"command": "sh", "args": ["-c", "read line; exec sleep 5"], "workers": 1, "timeout": 100}`)
+
+	_, err := provider.Call([]byte(`{}`))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("function timed out")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+
+	exec.Exec{
+		Command: "node",
+		Args:    []string{"handler.js", "--verbose"},
+		Env:     map[string]string{"TOKEN": "secret", "STAGE": "dev"},
+		Dir:     "/srv/functions",
+		Timeout: 1000,
+		Workers: 4,
+	}.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{
+		"command": "node",
+		"args":    "handler.js --verbose",
+		"env":     "STAGE,TOKEN",
+		"dir":     "/srv/functions",
+		"timeout": 1000,
+		"workers": 4,
+	}, enc.Fields)
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := exec.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"command": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"command": ""}`,
+		errors.New("missing required fields for exec function"),
+	},
+	{
+		`{"command": "cat", "timeout": -1}`,
+		errors.New("missing required fields for exec function"),
+	},
+	{
+		`{"command": "cat", "workers": -1}`,
+		errors.New("missing required fields for exec function"),
+	},
+	{
+		`{"command": "cat", "env": {"A=B": "C"}}`,
+		errors.New("missing required fields for exec function"),
+	},
+	{
+		`{"command": "cat", "args": ["-u"], "env": {"STAGE": "dev"}, "dir": "/tmp", "timeout": 1000, "workers": 2}`,
+		nil,
+	},
+}
@@ -0,0 +1,214 @@
+package exec
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	osexec "os/exec"
+	"sync"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+)
+
+const (
+	// workerIdleTimeout is a time after which idle worker is stopped. Pools of removed or updated functions are not
+	// used anymore so their workers are stopped once they are idle for this long.
+	workerIdleTimeout = 5 * time.Minute
+	reapInterval      = time.Minute
+)
+
+var (
+	errNoIdleWorker = errors.New("no idle worker available before timeout")
+	errWorkerExited = errors.New("worker process exited")
+	errInvalidEvent = errors.New("event is not a valid JSON")
+)
+
+// worker is a long-lived process reading events from stdin and writing responses to stdout, one per line.
+type worker struct {
+	cmd      *osexec.Cmd
+	stdin    io.WriteCloser
+	stdout   *bufio.Reader
+	lastUsed time.Time
+}
+
+// send writes the event line to the worker and reads the response line.
+func (w *worker) send(line []byte) ([]byte, error) {
+	_, err := w.stdin.Write(line)
+	if err != nil {
+		return nil, errWorkerExited
+	}
+	resp, err := w.stdout.ReadBytes('\n')
+	if err != nil {
+		return nil, errWorkerExited
+	}
+	return bytes.TrimRight(resp, "\r\n"), nil
+}
+
+// stop kills the worker process.
+func (w *worker) stop() {
+	w.stdin.Close()
+	w.cmd.Process.Kill()
+	go w.cmd.Wait()
+}
+
+type response struct {
+	output []byte
+	err    error
+}
+
+// workerPool is a fixed size pool of workers shared by all functions with the same command, args, environment and
+// working directory. Workers are started on first use and restarted on next use after they exit or time out.
+type workerPool struct {
+	config Exec
+	// idle contains idle workers. nil means a worker that is not running.
+	idle chan *worker
+}
+
+func newWorkerPool(config Exec) *workerPool {
+	p := &workerPool{config: config, idle: make(chan *worker, config.Workers)}
+	for i := 0; i < config.Workers; i++ {
+		p.idle <- nil
+	}
+	return p
+}
+
+// call sends the event to an idle worker and waits for the response. Worker that doesn't respond in time is killed
+// as it may still write the response to the event.
+func (p *workerPool) call(payload []byte, timeout time.Duration) ([]byte, error) {
+	line := &bytes.Buffer{}
+	err := json.Compact(line, payload)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: errInvalidEvent}
+	}
+	line.WriteByte('\n')
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var w *worker
+	select {
+	case w = <-p.idle:
+	case <-timer.C:
+		return nil, &function.ErrFunctionCallFailed{Original: errNoIdleWorker}
+	}
+
+	if w == nil {
+		w, err = p.start()
+		if err != nil {
+			p.idle <- nil
+			return nil, &function.ErrFunctionCallFailed{Original: err}
+		}
+	}
+
+	result := make(chan response, 1)
+	go func() {
+		output, err := w.send(line.Bytes())
+		result <- response{output: output, err: err}
+	}()
+
+	select {
+	case resp := <-result:
+		if resp.err != nil {
+			w.stop()
+			p.idle <- nil
+			return nil, &function.ErrFunctionError{Original: resp.err}
+		}
+		w.lastUsed = time.Now()
+		p.idle <- w
+		return resp.output, nil
+	case <-timer.C:
+		w.stop()
+		p.idle <- nil
+		return nil, &function.ErrFunctionError{Original: errTimeout}
+	}
+}
+
+// reap stops workers idle for longer than idleTimeout. Workers in use are not affected.
+func (p *workerPool) reap(idleTimeout time.Duration) {
+	for n := len(p.idle); n > 0; n-- {
+		var w *worker
+		select {
+		case w = <-p.idle:
+		default:
+			return
+		}
+
+		if w != nil && time.Since(w.lastUsed) > idleTimeout {
+			w.stop()
+			w = nil
+		}
+		p.idle <- w
+	}
+}
+
+// start starts a new worker process. Worker stderr is forwarded to Event Gateway stderr.
+func (p *workerPool) start() (*worker, error) {
+	cmd := p.config.command(context.Background())
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	return &worker{cmd: cmd, stdin: stdin, stdout: bufio.NewReader(stdout), lastUsed: time.Now()}, nil
+}
+
+type workerPoolCache struct {
+	sync.Mutex
+	pools   map[string]*workerPool
+	reaping sync.Once
+}
+
+// workerPools is a pool of worker pools reused across function config updates. Functions with the same command, args,
+// environment, working directory and number of workers share the pool. Idle workers are stopped after
+// workerIdleTimeout so pools that are not used anymore don't keep processes running.
+var workerPools = &workerPoolCache{pools: map[string]*workerPool{}}
+
+func (c *workerPoolCache) get(config Exec) *workerPool {
+	c.Lock()
+	defer c.Unlock()
+
+	// timeout applies to a single call so it doesn't affect the workers
+	config.Timeout = 0
+	config.pool = nil
+	key, _ := json.Marshal(config)
+
+	if pool, ok := c.pools[string(key)]; ok {
+		return pool
+	}
+
+	pool := newWorkerPool(config)
+	c.pools[string(key)] = pool
+	c.reaping.Do(func() {
+		go c.reap(reapInterval, workerIdleTimeout)
+	})
+	return pool
+}
+
+// reap stops idle workers of all pools every interval.
+func (c *workerPoolCache) reap(interval, idleTimeout time.Duration) {
+	for range time.Tick(interval) {
+		c.Lock()
+		pools := make([]*workerPool, 0, len(c.pools))
+		for _, pool := range c.pools {
+			pools = append(pools, pool)
+		}
+		c.Unlock()
+
+		for _, pool := range pools {
+			pool.reap(idleTimeout)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package exec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPoolReap(t *testing.T) {
+	pool := newWorkerPool(Exec{Command: "cat", Workers: 2})
+	_, err := pool.call([]byte(`{}`), time.Second)
+	assert.Nil(t, err)
+
+	pool.reap(time.Hour)
+
+	assert.Equal(t, 1, running(pool))
+
+	pool.reap(0)
+
+	assert.Equal(t, 0, running(pool))
+	output, err := pool.call([]byte(`{"restarted":true}`), time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"restarted":true}`), output)
+}
+
+// running returns number of idle workers with running process.
+func running(pool *workerPool) int {
+	workers := []*worker{}
+	for n := len(pool.idle); n > 0; n-- {
+		workers = append(workers, <-pool.idle)
+	}
+
+	count := 0
+	for _, w := range workers {
+		if w != nil {
+			count++
+		}
+		pool.idle <- w
+	}
+	return count
+}
@@ -0,0 +1,168 @@
+package gcpfunctions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("gcpfunctions")
+
+const defaultTimeout = 5 * time.Second
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// GCPFunctions function implementation. Function is invoked with HTTPS request authenticated with Google-signed ID
+// token of the service account.
+type GCPFunctions struct {
+	TokenSource oauth2.TokenSource `json:"-" validate:"-"`
+
+	// URL is a HTTPS trigger URL of the function e.g. "https://us-central1-project.cloudfunctions.net/function".
+	URL string `json:"url" validate:"required,url"`
+	// Audience of ID token. By default function URL is used.
+	Audience string `json:"audience,omitempty" validate:"omitempty,url"`
+	// Credentials is a JSON key of the service account that invokes the function.
+	Credentials string `json:"credentials" validate:"required"`
+	// Timeout is a time (in milliseconds) to wait for the function response.
+	Timeout int `json:"timeout,omitempty" validate:"min=0"`
+}
+
+// Call invokes Google Cloud Function.
+func (g GCPFunctions) Call(payload []byte) ([]byte, error) {
+	token, err := g.TokenSource.Token()
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, g.URL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	token.SetAuthHeader(req)
+
+	client := http.Client{Timeout: g.timeout()}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if err := function.ErrFromStatusCode(resp.StatusCode); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (g GCPFunctions) timeout() time.Duration {
+	if g.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(g.Timeout) * time.Millisecond
+}
+
+// validate provider config.
+func (g GCPFunctions) validate() error {
+	validate := validator.New()
+	err := validate.Struct(g)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (g GCPFunctions) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("url", g.URL)
+	if g.Audience != "" {
+		enc.AddString("audience", g.Audience)
+	}
+	if g.Credentials != "" {
+		enc.AddString("credentials", "*****")
+	}
+	if g.Timeout != 0 {
+		enc.AddInt("timeout", g.Timeout)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &GCPFunctions{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for Google Cloud Functions function")
+	}
+
+	audience := provider.Audience
+	if audience == "" {
+		audience = provider.URL
+	}
+	provider.TokenSource, err = tokenSources.get(tokenSourceKey{credentials: provider.Credentials, audience: audience})
+	if err != nil {
+		return nil, errors.New("invalid credentials for Google Cloud Functions function: " + err.Error())
+	}
+
+	return provider, nil
+}
+
+type tokenSourceKey struct {
+	credentials string
+	audience    string
+}
+
+type tokenSourcePool struct {
+	sync.Mutex
+	tokenSources map[tokenSourceKey]oauth2.TokenSource
+}
+
+// tokenSources is a pool of ID token sources reused across function config updates. Token source caches the token
+// until it expires.
+var tokenSources = &tokenSourcePool{tokenSources: map[tokenSourceKey]oauth2.TokenSource{}}
+
+func (p *tokenSourcePool) get(key tokenSourceKey) (oauth2.TokenSource, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if tokenSource, ok := p.tokenSources[key]; ok {
+		return tokenSource, nil
+	}
+
+	config, err := google.JWTConfigFromJSON([]byte(key.credentials))
+	if err != nil {
+		return nil, err
+	}
+	config.PrivateClaims = map[string]interface{}{"target_audience": key.audience}
+	config.UseIDToken = true
+
+	tokenSource := oauth2.ReuseTokenSource(nil, config.TokenSource(context.Background()))
+	p.tokenSources[key] = tokenSource
+	return tokenSource, nil
+}
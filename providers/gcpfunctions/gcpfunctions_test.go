@@ -0,0 +1,180 @@
+package gcpfunctions_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/internal/gcptest"
+	"github.com/serverless/event-gateway/providers/gcpfunctions"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := gcpfunctions.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	server := gcptest.NewServer()
+	defer server.Close()
+	server.HandleFunc("/function", func(w http.ResponseWriter, r *http.Request) {
+		audience, ok := server.Audience(r)
+		if !ok || audience != server.URL+"/function" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+		w.Write(append([]byte("received "), body...))
+	})
+	provider := load(t, server, server.URL+"/function", "")
+
+	output, err := provider.Call([]byte("testpayload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("received testpayload"), output)
+
+	output, err = provider.Call([]byte("testpayload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("received testpayload"), output)
+	assert.Equal(t, 1, server.TokenRequests())
+}
+
+func TestCallAudience(t *testing.T) {
+	server := gcptest.NewServer()
+	defer server.Close()
+	server.HandleFunc("/function", func(w http.ResponseWriter, r *http.Request) {
+		audience, _ := server.Audience(r)
+		w.Write([]byte(audience))
+	})
+	provider := load(t, server, server.URL+"/function", "https://function.example.com")
+
+	output, err := provider.Call([]byte("testpayload"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("https://function.example.com"), output)
+}
+
+func TestCallFailed(t *testing.T) {
+	server := gcptest.NewServer()
+	defer server.Close()
+	server.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	server.HandleFunc("/forbidden", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := load(t, server, server.URL+"/error", "").Call([]byte("testpayload"))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 500")}, err)
+
+	_, err = load(t, server, server.URL+"/forbidden", "").Call([]byte("testpayload"))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("HTTP status code: 403")}, err)
+}
+
+func TestCallTokenRejected(t *testing.T) {
+	server := gcptest.NewServer()
+	other := gcptest.NewServer()
+	defer server.Close()
+	defer other.Close()
+	credentials := map[string]string{}
+	json.Unmarshal([]byte(other.Credentials), &credentials)
+	credentials["token_uri"] = server.URL + "/token"
+	key, _ := json.Marshal(credentials)
+	config, _ := json.Marshal(map[string]string{"url": server.URL + "/function", "credentials": string(key)})
+	provider, err := gcpfunctions.ProviderLoader{}.Load(config)
+	assert.Nil(t, err)
+
+	_, err = provider.Call([]byte("testpayload"))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+func load(t *testing.T, server *gcptest.Server, url, audience string) function.Provider {
+	config := map[string]string{"url": url, "credentials": server.Credentials}
+	if audience != "" {
+		config["audience"] = audience
+	}
+	data, _ := json.Marshal(config)
+	provider, err := gcpfunctions.ProviderLoader{}.Load(data)
+	assert.Nil(t, err)
+	return provider
+}
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"url": "https://us-central1-project.cloudfunctions.net/function", "credentials": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"url": "", "credentials": "{}"}`,
+		errors.New("missing required fields for Google Cloud Functions function"),
+	},
+	{
+		`{"url": "https://us-central1-project.cloudfunctions.net/function", "credentials": ""}`,
+		errors.New("missing required fields for Google Cloud Functions function"),
+	},
+	{
+		`{"url": "https://us-central1-project.cloudfunctions.net/function", "credentials": "{}", "timeout": -1}`,
+		errors.New("missing required fields for Google Cloud Functions function"),
+	},
+	{
+		`{"url": "https://us-central1-project.cloudfunctions.net/function", "credentials": "{"}`,
+		errors.New("invalid credentials for Google Cloud Functions function: unexpected end of JSON input"),
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		gcpfunctions.GCPFunctions{
+			URL: "https://us-central1-project.cloudfunctions.net/function",
+		},
+		map[string]interface{}{
+			"url": "https://us-central1-project.cloudfunctions.net/function",
+		},
+	},
+	{
+		gcpfunctions.GCPFunctions{
+			URL:         "https://us-central1-project.cloudfunctions.net/function",
+			Audience:    "https://function.example.com",
+			Credentials: `{"type": "service_account"}`,
+			Timeout:     10000,
+		},
+		map[string]interface{}{
+			"url":         "https://us-central1-project.cloudfunctions.net/function",
+			"audience":    "https://function.example.com",
+			"credentials": "*****",
+			"timeout":     10000,
+		},
+	},
+}
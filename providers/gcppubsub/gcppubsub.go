@@ -0,0 +1,293 @@
+package gcppubsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("gcppubsub")
+
+const (
+	defaultEndpoint = "https://pubsub.googleapis.com"
+	scope           = "https://www.googleapis.com/auth/pubsub"
+	timeout         = 5 * time.Second
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// GCPPubSub function implementation. Event is published to Google Cloud Pub/Sub topic.
+type GCPPubSub struct {
+	TokenSource oauth2.TokenSource `json:"-" validate:"-"`
+
+	Project string `json:"project" validate:"required"`
+	Topic   string `json:"topic" validate:"required"`
+	// OrderingKey is a path to the event attribute used as a message ordering key e.g. "source" or
+	// "extensions.tenant".
+	OrderingKey string `json:"orderingKey,omitempty"`
+	// Credentials is a JSON key of the service account. By default Application Default Credentials are used.
+	Credentials string `json:"credentials,omitempty"`
+	// Endpoint is a Pub/Sub API endpoint e.g. "http://localhost:8085" for the emulator or regional endpoint
+	// "https://us-east1-pubsub.googleapis.com". Requests to custom endpoint are not authenticated if credentials are
+	// not provided.
+	Endpoint string `json:"endpoint,omitempty" validate:"omitempty,url"`
+}
+
+// message is a Pub/Sub message. Data is base64 encoded when marshalled.
+type message struct {
+	Data        []byte            `json:"data"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	OrderingKey string            `json:"orderingKey,omitempty"`
+}
+
+type publishRequest struct {
+	Messages []message `json:"messages"`
+}
+
+type publishResponse struct {
+	MessageIDs []string `json:"messageIds"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+var (
+	errMissingOrderingKey = errors.New("event has no attribute used as ordering key")
+	errNoMessageID        = errors.New("no message ID returned by Pub/Sub API")
+)
+
+// Call publishes event to Pub/Sub topic. CloudEvents attributes and extensions are sent as message attributes prefixed
+// with "ce-". It returns ID of the published message.
+func (g GCPPubSub) Call(payload []byte) ([]byte, error) {
+	var event map[string]interface{}
+	// payload that is not a CloudEvent is published without attributes
+	json.Unmarshal(payload, &event)
+
+	msg := message{Data: payload, Attributes: attributes(event)}
+	if g.OrderingKey != "" {
+		key, ok := attribute(event, g.OrderingKey)
+		if !ok {
+			return nil, &function.ErrFunctionCallFailed{Original: errMissingOrderingKey}
+		}
+		msg.OrderingKey = key
+	}
+
+	body, err := json.Marshal(publishRequest{Messages: []message{msg}})
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, g.publishURL(), bytes.NewReader(body))
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if g.TokenSource != nil {
+		token, err := g.TokenSource.Token()
+		if err != nil {
+			return nil, &function.ErrFunctionCallFailed{Original: err}
+		}
+		token.SetAuthHeader(req)
+	}
+
+	client := http.Client{Timeout: timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &errorResponse{}
+		if json.Unmarshal(respBody, apiErr) != nil || apiErr.Error.Message == "" {
+			apiErr.Error.Message = http.StatusText(resp.StatusCode)
+		}
+		return nil, &function.ErrFunctionCallFailed{Original: errors.New("unable to publish message: " + apiErr.Error.Message)}
+	}
+
+	published := &publishResponse{}
+	err = json.Unmarshal(respBody, published)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if len(published.MessageIDs) == 0 {
+		return nil, &function.ErrFunctionCallFailed{Original: errNoMessageID}
+	}
+
+	return []byte(published.MessageIDs[0]), nil
+}
+
+func (g GCPPubSub) publishURL() string {
+	endpoint := g.Endpoint
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	return strings.TrimSuffix(endpoint, "/") +
+		"/v1/projects/" + url.PathEscape(g.Project) + "/topics/" + url.PathEscape(g.Topic) + ":publish"
+}
+
+// attributes maps CloudEvents attributes and extensions, except data, to message attributes. Values other than
+// strings are JSON encoded.
+func attributes(event map[string]interface{}) map[string]string {
+	attrs := map[string]string{}
+	for name, value := range event {
+		switch name {
+		case "data":
+			continue
+		case "extensions":
+			extensions, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for extension, extensionValue := range extensions {
+				if encoded, ok := encode(extensionValue); ok {
+					attrs["ce-"+extension] = encoded
+				}
+			}
+		default:
+			if encoded, ok := encode(value); ok {
+				attrs["ce-"+name] = encoded
+			}
+		}
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+	return attrs
+}
+
+// attribute returns value of the event attribute pointed by the dot separated path.
+func attribute(event map[string]interface{}, path string) (string, bool) {
+	value, _ := eventpkg.Lookup(event, path)
+	encoded, ok := encode(value)
+	return encoded, ok && encoded != ""
+}
+
+func encode(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	}
+}
+
+// validate provider config.
+func (g GCPPubSub) validate() error {
+	validate := validator.New()
+	err := validate.Struct(g)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (g GCPPubSub) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("project", g.Project)
+	enc.AddString("topic", g.Topic)
+	if g.OrderingKey != "" {
+		enc.AddString("orderingKey", g.OrderingKey)
+	}
+	if g.Credentials != "" {
+		enc.AddString("credentials", "*****")
+	}
+	if g.Endpoint != "" {
+		enc.AddString("endpoint", g.Endpoint)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &GCPPubSub{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for Google Cloud Pub/Sub function")
+	}
+
+	if provider.Credentials == "" && provider.Endpoint != "" {
+		return provider, nil
+	}
+
+	provider.TokenSource, err = tokenSources.get(provider.Credentials)
+	if err != nil {
+		return nil, errors.New("invalid credentials for Google Cloud Pub/Sub function: " + err.Error())
+	}
+
+	return provider, nil
+}
+
+type tokenSourcePool struct {
+	sync.Mutex
+	tokenSources map[string]oauth2.TokenSource
+}
+
+// tokenSources is a pool of access token sources, keyed by credentials, reused across function config updates. Token
+// source caches the token until it expires.
+var tokenSources = &tokenSourcePool{tokenSources: map[string]oauth2.TokenSource{}}
+
+func (p *tokenSourcePool) get(credentials string) (oauth2.TokenSource, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if tokenSource, ok := p.tokenSources[credentials]; ok {
+		return tokenSource, nil
+	}
+
+	var tokenSource oauth2.TokenSource
+	if credentials == "" {
+		defaultTokenSource, err := google.DefaultTokenSource(context.Background(), scope)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource = defaultTokenSource
+	} else {
+		config, err := google.JWTConfigFromJSON([]byte(credentials), scope)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource = oauth2.ReuseTokenSource(nil, config.TokenSource(context.Background()))
+	}
+
+	p.tokenSources[credentials] = tokenSource
+	return tokenSource, nil
+}
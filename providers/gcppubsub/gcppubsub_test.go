@@ -0,0 +1,188 @@
+package gcppubsub_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/internal/gcptest"
+	"github.com/serverless/event-gateway/providers/gcppubsub"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := gcppubsub.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	server := gcptest.NewServer()
+	defer server.Close()
+	server.CreateTopic("project", "users")
+	provider := load(t, map[string]string{"project": "project", "topic": "users", "endpoint": server.URL})
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	messages := server.Messages("project", "users")
+	assert.Len(t, messages, 1)
+	assert.Equal(t, messages[0].ID, string(output))
+	assert.Equal(t, []byte(event), messages[0].Data)
+	assert.Equal(t, map[string]string{
+		"ce-eventType":          "user.created",
+		"ce-cloudEventsVersion": "0.1",
+		"ce-source":             "/users",
+		"ce-eventID":            "1",
+		"ce-tenant":             "acme",
+		"ce-priority":           "2",
+	}, messages[0].Attributes)
+	assert.Equal(t, "", messages[0].OrderingKey)
+	assert.Equal(t, 0, server.TokenRequests())
+}
+
+func TestCallOrderingKey(t *testing.T) {
+	server := gcptest.NewServer()
+	defer server.Close()
+	server.CreateTopic("project", "users")
+	provider := load(t, map[string]string{
+		"project": "project", "topic": "users", "endpoint": server.URL, "orderingKey": "extensions.tenant",
+	})
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "acme", server.Messages("project", "users")[0].OrderingKey)
+
+	provider = load(t, map[string]string{
+		"project": "project", "topic": "users", "endpoint": server.URL, "orderingKey": "extensions.missing",
+	})
+
+	_, err = provider.Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("event has no attribute used as ordering key")}, err)
+}
+
+func TestCallCredentials(t *testing.T) {
+	server := gcptest.NewServer()
+	defer server.Close()
+	server.CreateTopic("project", "users")
+	provider := load(t, map[string]string{
+		"project": "project", "topic": "users", "endpoint": server.URL, "credentials": server.Credentials,
+	})
+
+	_, err := provider.Call([]byte(event))
+	assert.Nil(t, err)
+	_, err = provider.Call([]byte(event))
+	assert.Nil(t, err)
+
+	assert.Len(t, server.Messages("project", "users"), 2)
+	assert.Equal(t, 1, server.TokenRequests())
+}
+
+func TestCallTopicNotFound(t *testing.T) {
+	server := gcptest.NewServer()
+	defer server.Close()
+	provider := load(t, map[string]string{"project": "project", "topic": "users", "endpoint": server.URL})
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("unable to publish message: Topic not found")}, err)
+}
+
+func TestCallServerUnavailable(t *testing.T) {
+	provider := load(t, map[string]string{"project": "project", "topic": "users", "endpoint": "http://127.0.0.1:1"})
+
+	_, err := provider.Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+func load(t *testing.T, config map[string]string) function.Provider {
+	data, _ := json.Marshal(config)
+	provider, err := gcppubsub.ProviderLoader{}.Load(data)
+	assert.Nil(t, err)
+	return provider
+}
+
+const event = `{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+	`"extensions":{"tenant":"acme","priority":2},"data":{"userId":7}}`
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"project": "project", "topic": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"project": "", "topic": "users"}`,
+		errors.New("missing required fields for Google Cloud Pub/Sub function"),
+	},
+	{
+		`{"project": "project", "topic": ""}`,
+		errors.New("missing required fields for Google Cloud Pub/Sub function"),
+	},
+	{
+		`{"project": "project", "topic": "users", "endpoint": "localhost"}`,
+		errors.New("missing required fields for Google Cloud Pub/Sub function"),
+	},
+	{
+		`{"project": "project", "topic": "users", "credentials": "{"}`,
+		errors.New("invalid credentials for Google Cloud Pub/Sub function: unexpected end of JSON input"),
+	},
+	{
+		`{"project": "project", "topic": "users", "endpoint": "http://localhost:8085"}`,
+		nil,
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		gcppubsub.GCPPubSub{
+			Project: "project",
+			Topic:   "users",
+		},
+		map[string]interface{}{
+			"project": "project",
+			"topic":   "users",
+		},
+	},
+	{
+		gcppubsub.GCPPubSub{
+			Project:     "project",
+			Topic:       "users",
+			OrderingKey: "extensions.tenant",
+			Credentials: `{"type": "service_account"}`,
+			Endpoint:    "https://us-east1-pubsub.googleapis.com",
+		},
+		map[string]interface{}{
+			"project":     "project",
+			"topic":       "users",
+			"orderingKey": "extensions.tenant",
+			"credentials": "*****",
+			"endpoint":    "https://us-east1-pubsub.googleapis.com",
+		},
+	},
+}
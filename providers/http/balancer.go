@@ -0,0 +1,356 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Balancing strategies.
+const (
+	RoundRobin       = "roundRobin"
+	LeastOutstanding = "leastOutstanding"
+	Weighted         = "weighted"
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultHealthCheckTimeout  = 2 * time.Second
+	defaultHealthyThreshold    = 2
+	defaultUnhealthyThreshold  = 2
+	// idleIntervals is a number of health check intervals without calls after which health checks stop. They are
+	// restarted on next call.
+	idleIntervals = 10
+)
+
+// target is a state of a single endpoint.
+type target struct {
+	url    string
+	weight int
+
+	outstanding   int
+	currentWeight int
+	healthy       bool
+	// successes and failures count consecutive health check results.
+	successes int
+	failures  int
+}
+
+// balancer distributes calls across endpoints and keeps track of their health.
+type balancer struct {
+	sync.Mutex
+	strategy    string
+	targets     []*target
+	next        int
+	healthCheck *HealthCheck
+	client      *http.Client
+	lastUsed    time.Time
+	checking    bool
+	// stopped is set when the balancer is removed from the pool. Background goroutines exit on the next tick.
+	stopped bool
+
+	discovery   *Discovery
+	discovered  sync.Once
+	discovering bool
+	// err is an error of the last discovery refresh.
+	err error
+}
+
+func newBalancer(h HTTP) *balancer {
+	b := &balancer{strategy: h.Balancing, healthCheck: h.HealthCheck, discovery: h.Discovery, lastUsed: time.Now()}
+	if b.strategy == "" {
+		b.strategy = RoundRobin
+	}
+	b.setTargets(h.Endpoints)
+	if b.healthCheck != nil {
+		b.client, _ = clients.get(clientKey{tls: h.tlsConfig(), timeout: b.healthCheck.timeout()})
+	}
+	return b
+}
+
+// setTargets replaces targets with endpoints. State of targets that are still present is kept.
+func (b *balancer) setTargets(endpoints []Endpoint) {
+	existing := map[string]*target{}
+	for _, t := range b.targets {
+		existing[t.url] = t
+	}
+
+	targets := []*target{}
+	for _, endpoint := range endpoints {
+		weight := endpoint.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		t, ok := existing[endpoint.URL]
+		if !ok {
+			t = &target{url: endpoint.URL, healthy: true}
+		}
+		t.weight = weight
+		targets = append(targets, t)
+	}
+	b.targets = targets
+}
+
+// order returns targets in order in which they should be tried. The target picked by the balancing strategy is
+// first, other healthy targets follow as failover. If no target is healthy, all targets are returned. Error is
+// returned if no target was discovered.
+func (b *balancer) order() ([]*target, error) {
+	if b.discovery != nil {
+		b.discovered.Do(b.refresh)
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.lastUsed = time.Now()
+	if b.healthCheck != nil && !b.checking && !b.stopped && b.client != nil {
+		b.checking = true
+		go b.background(b.healthCheck.interval(), b.checkTargets, &b.checking)
+	}
+	if b.discovery != nil && !b.discovering && !b.stopped {
+		b.discovering = true
+		go b.background(b.discovery.refreshInterval(), b.refresh, &b.discovering)
+	}
+
+	if len(b.targets) == 0 {
+		if b.err != nil {
+			return nil, b.err
+		}
+		return nil, errNoTargets
+	}
+
+	candidates := []*target{}
+	for _, t := range b.targets {
+		if t.healthy {
+			candidates = append(candidates, t)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = append(candidates, b.targets...)
+	}
+
+	// rotate candidates so that ties are spread across targets
+	offset := b.next % len(candidates)
+	b.next++
+	ordered := append(append([]*target{}, candidates[offset:]...), candidates[:offset]...)
+
+	switch b.strategy {
+	case LeastOutstanding:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].outstanding < ordered[j].outstanding
+		})
+	case Weighted:
+		// smooth weighted round robin picks the first target, others are tried in the list order
+		total := 0
+		var picked *target
+		for _, t := range candidates {
+			t.currentWeight += t.weight
+			total += t.weight
+			if picked == nil || t.currentWeight > picked.currentWeight {
+				picked = t
+			}
+		}
+		picked.currentWeight -= total
+		ordered = []*target{picked}
+		for _, t := range candidates {
+			if t != picked {
+				ordered = append(ordered, t)
+			}
+		}
+	}
+	return ordered, nil
+}
+
+// start marks beginning of a call to the target.
+func (b *balancer) start(t *target) {
+	b.Lock()
+	defer b.Unlock()
+	t.outstanding++
+}
+
+// done marks end of a call to the target. Failed target is ejected until health checks find it healthy again.
+func (b *balancer) done(t *target, failed bool) {
+	b.Lock()
+	defer b.Unlock()
+	t.outstanding--
+	if failed && b.healthCheck != nil {
+		t.healthy = false
+		t.successes = 0
+	}
+}
+
+// background runs fn every interval until the balancer is idle or stopped. Running flag is cleared when it stops.
+func (b *balancer) background(interval time.Duration, fn func(), running *bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		<-ticker.C
+		b.Lock()
+		if b.stopped || time.Since(b.lastUsed) > idleIntervals*interval {
+			*running = false
+			b.Unlock()
+			return
+		}
+		b.Unlock()
+
+		fn()
+	}
+}
+
+// refresh replaces targets with discovered endpoints. Previous targets are kept if discovery fails.
+func (b *balancer) refresh() {
+	endpoints, err := b.discovery.resolve()
+
+	b.Lock()
+	defer b.Unlock()
+	b.err = err
+	if err == nil {
+		b.setTargets(endpoints)
+	}
+}
+
+func (b *balancer) checkTargets() {
+	b.Lock()
+	targets := append([]*target{}, b.targets...)
+	b.Unlock()
+
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t *target) {
+			defer wg.Done()
+			healthy := b.probe(t.url)
+
+			b.Lock()
+			defer b.Unlock()
+			if healthy {
+				t.successes++
+				t.failures = 0
+				if t.successes >= b.healthCheck.healthyThreshold() {
+					t.healthy = true
+				}
+			} else {
+				t.failures++
+				t.successes = 0
+				if t.failures >= b.healthCheck.unhealthyThreshold() {
+					t.healthy = false
+				}
+			}
+		}(t)
+	}
+	wg.Wait()
+}
+
+// probe sends health check request to the target. Target is healthy if it responds with 2xx or 3xx status code.
+func (b *balancer) probe(endpoint string) bool {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	path, err := url.Parse(b.healthCheck.Path)
+	if err != nil {
+		return false
+	}
+
+	resp, err := b.client.Get(base.ResolveReference(path).String())
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode < http.StatusBadRequest
+}
+
+func (h HealthCheck) interval() time.Duration {
+	if h.Interval == 0 {
+		return defaultHealthCheckInterval
+	}
+	return time.Duration(h.Interval) * time.Millisecond
+}
+
+func (h HealthCheck) timeout() time.Duration {
+	if h.Timeout == 0 {
+		return defaultHealthCheckTimeout
+	}
+	return time.Duration(h.Timeout) * time.Millisecond
+}
+
+func (h HealthCheck) healthyThreshold() int {
+	if h.HealthyThreshold == 0 {
+		return defaultHealthyThreshold
+	}
+	return h.HealthyThreshold
+}
+
+func (h HealthCheck) unhealthyThreshold() int {
+	if h.UnhealthyThreshold == 0 {
+		return defaultUnhealthyThreshold
+	}
+	return h.UnhealthyThreshold
+}
+
+type balancerPool struct {
+	sync.Mutex
+	balancers map[string]*balancer
+	reaping   sync.Once
+}
+
+// balancers is a pool of balancers reused across calls and function config updates. Functions with the same endpoints,
+// discovery, balancing strategy, health check and TLS config share the balancer. Balancers idle for longer than
+// idleTimeout are stopped and removed from the pool.
+var balancers = &balancerPool{balancers: map[string]*balancer{}}
+
+func (p *balancerPool) get(h HTTP) *balancer {
+	key, _ := json.Marshal(struct {
+		Endpoints   []Endpoint
+		Discovery   *Discovery
+		Balancing   string
+		HealthCheck *HealthCheck
+		TLS         TLS
+	}{h.Endpoints, h.Discovery, h.Balancing, h.HealthCheck, h.tlsConfig()})
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.reaping.Do(func() {
+		go p.reap(reapInterval, idleTimeout)
+	})
+
+	if b, ok := p.balancers[string(key)]; ok {
+		// lastUsed is updated under the pool lock so the balancer is not removed before the call uses it
+		b.Lock()
+		b.lastUsed = time.Now()
+		b.Unlock()
+		return b
+	}
+
+	b := newBalancer(h)
+	p.balancers[string(key)] = b
+	return b
+}
+
+// reap stops idle balancers every interval.
+func (p *balancerPool) reap(interval, idleTimeout time.Duration) {
+	for range time.Tick(interval) {
+		p.removeIdle(idleTimeout)
+	}
+}
+
+// removeIdle stops and removes balancers not used for longer than idleTimeout. Their health checks and discovery
+// refreshes stop on the next tick.
+func (p *balancerPool) removeIdle(idleTimeout time.Duration) {
+	p.Lock()
+	defer p.Unlock()
+
+	for key, b := range p.balancers {
+		b.Lock()
+		if time.Since(b.lastUsed) > idleTimeout {
+			b.stopped = true
+			delete(p.balancers, key)
+		}
+		b.Unlock()
+	}
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalancerPool(t *testing.T) {
+	t.Run("balancer shared by the same config", func(t *testing.T) {
+		pool := &balancerPool{balancers: map[string]*balancer{}}
+		h := HTTP{Endpoints: []Endpoint{{URL: "http://a.example.com"}}}
+
+		assert.True(t, pool.get(h) == pool.get(h))
+	})
+
+	t.Run("idle balancer stopped and removed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+		pool := &balancerPool{balancers: map[string]*balancer{}}
+		idle := pool.get(HTTP{Endpoints: []Endpoint{{URL: server.URL}}, HealthCheck: &HealthCheck{Path: "/", Interval: 10}})
+		used := pool.get(HTTP{Endpoints: []Endpoint{{URL: server.URL}}})
+		_, err := idle.order()
+		assert.Nil(t, err)
+		idle.Lock()
+		idle.lastUsed = time.Now().Add(-time.Hour)
+		idle.Unlock()
+
+		pool.removeIdle(time.Minute)
+
+		assert.Len(t, pool.balancers, 1)
+		for _, b := range pool.balancers {
+			assert.True(t, b == used)
+		}
+		assert.True(t, eventually(func() bool {
+			idle.Lock()
+			defer idle.Unlock()
+			return !idle.checking
+		}))
+	})
+}
+
+// eventually checks the condition until it's true or a second passes.
+func eventually(condition func() bool) bool {
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// idleTimeout is a time after which unused client, transport or token source is removed from the pool. Pooled
+	// values of removed or updated functions are not used anymore so they are removed once they are idle for this long.
+	idleTimeout  = 5 * time.Minute
+	reapInterval = time.Minute
+)
+
+var errInvalidCACert = errors.New("unable to parse CA certificate")
+
+type clientKey struct {
+	tls     TLS
+	timeout time.Duration
+}
+
+type pooledClient struct {
+	client   *http.Client
+	lastUsed time.Time
+}
+
+type pooledTransport struct {
+	transport *http.Transport
+	lastUsed  time.Time
+}
+
+type clientPool struct {
+	sync.Mutex
+	clients    map[clientKey]*pooledClient
+	transports map[TLS]*pooledTransport
+	reaping    sync.Once
+}
+
+// clients is a pool of HTTP clients reused across calls and function config updates. Clients with the same TLS config
+// share a transport and its pool of connections. Clients and transports idle for longer than idleTimeout are removed
+// from the pool so TLS keys of removed functions are not kept in memory.
+var clients = &clientPool{clients: map[clientKey]*pooledClient{}, transports: map[TLS]*pooledTransport{}}
+
+func (p *clientPool) get(key clientKey) (*http.Client, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.reaping.Do(func() {
+		go p.reap(reapInterval, idleTimeout)
+	})
+
+	now := time.Now()
+	transport, ok := p.transports[key.tls]
+	if !ok {
+		config, err := tlsConfig(key.tls)
+		if err != nil {
+			return nil, err
+		}
+		transport = &pooledTransport{transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			TLSClientConfig:       config,
+		}}
+		p.transports[key.tls] = transport
+	}
+	transport.lastUsed = now
+
+	client, ok := p.clients[key]
+	if !ok {
+		client = &pooledClient{client: &http.Client{Transport: transport.transport, Timeout: key.timeout}}
+		p.clients[key] = client
+	}
+	client.lastUsed = now
+	return client.client, nil
+}
+
+// reap removes idle clients and transports every interval.
+func (p *clientPool) reap(interval, idleTimeout time.Duration) {
+	for range time.Tick(interval) {
+		p.removeIdle(idleTimeout)
+	}
+}
+
+// removeIdle removes clients and transports not used for longer than idleTimeout. Idle connections of removed
+// transports are closed, requests in flight are not affected.
+func (p *clientPool) removeIdle(idleTimeout time.Duration) {
+	p.Lock()
+	defer p.Unlock()
+
+	for key, client := range p.clients {
+		if time.Since(client.lastUsed) > idleTimeout {
+			delete(p.clients, key)
+		}
+	}
+	for key, transport := range p.transports {
+		if time.Since(transport.lastUsed) > idleTimeout {
+			delete(p.transports, key)
+			transport.transport.CloseIdleConnections()
+		}
+	}
+}
+
+func tlsConfig(config TLS) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+	if config.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+			return nil, errInvalidCACert
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.ClientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(config.ClientCert), []byte(config.ClientKey))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+type tokenSourceKey struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       string
+}
+
+type pooledTokenSource struct {
+	tokenSource oauth2.TokenSource
+	lastUsed    time.Time
+}
+
+type tokenSourcePool struct {
+	sync.Mutex
+	tokenSources map[tokenSourceKey]*pooledTokenSource
+	reaping      sync.Once
+}
+
+// tokenSources is a pool of OAuth2 token sources reused across function config updates. Token source caches the token
+// until it expires. Token sources idle for longer than idleTimeout are removed from the pool.
+var tokenSources = &tokenSourcePool{tokenSources: map[tokenSourceKey]*pooledTokenSource{}}
+
+func (p *tokenSourcePool) get(auth Auth) oauth2.TokenSource {
+	p.Lock()
+	defer p.Unlock()
+
+	p.reaping.Do(func() {
+		go p.reap(reapInterval, idleTimeout)
+	})
+
+	key := tokenSourceKey{
+		tokenURL:     auth.TokenURL,
+		clientID:     auth.ClientID,
+		clientSecret: auth.ClientSecret,
+		scopes:       strings.Join(auth.Scopes, " "),
+	}
+	tokenSource, ok := p.tokenSources[key]
+	if !ok {
+		config := &clientcredentials.Config{
+			ClientID:     auth.ClientID,
+			ClientSecret: auth.ClientSecret,
+			TokenURL:     auth.TokenURL,
+			Scopes:       auth.Scopes,
+		}
+		tokenSource = &pooledTokenSource{tokenSource: config.TokenSource(context.Background())}
+		p.tokenSources[key] = tokenSource
+	}
+	tokenSource.lastUsed = time.Now()
+	return tokenSource.tokenSource
+}
+
+// reap removes idle token sources every interval.
+func (p *tokenSourcePool) reap(interval, idleTimeout time.Duration) {
+	for range time.Tick(interval) {
+		p.removeIdle(idleTimeout)
+	}
+}
+
+// removeIdle removes token sources not used for longer than idleTimeout.
+func (p *tokenSourcePool) removeIdle(idleTimeout time.Duration) {
+	p.Lock()
+	defer p.Unlock()
+
+	for key, tokenSource := range p.tokenSources {
+		if time.Since(tokenSource.lastUsed) > idleTimeout {
+			delete(p.tokenSources, key)
+		}
+	}
+}
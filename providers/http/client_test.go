@@ -0,0 +1,67 @@
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientPool(t *testing.T) {
+	t.Run("transport shared by clients with the same TLS config", func(t *testing.T) {
+		pool := &clientPool{clients: map[clientKey]*pooledClient{}, transports: map[TLS]*pooledTransport{}}
+
+		first, err := pool.get(clientKey{timeout: time.Second})
+		assert.Nil(t, err)
+		second, err := pool.get(clientKey{timeout: time.Minute})
+		assert.Nil(t, err)
+		third, err := pool.get(clientKey{timeout: time.Second})
+		assert.Nil(t, err)
+
+		assert.True(t, first == third)
+		assert.True(t, first != second)
+		assert.True(t, first.Transport == second.Transport)
+		assert.Len(t, pool.transports, 1)
+	})
+
+	t.Run("idle client and transport removed", func(t *testing.T) {
+		pool := &clientPool{clients: map[clientKey]*pooledClient{}, transports: map[TLS]*pooledTransport{}}
+		idleKey := clientKey{tls: TLS{InsecureSkipVerify: true}, timeout: time.Second}
+		usedKey := clientKey{timeout: time.Second}
+		pool.get(idleKey)
+		pool.get(usedKey)
+		pool.clients[idleKey].lastUsed = time.Now().Add(-time.Hour)
+		pool.transports[idleKey.tls].lastUsed = time.Now().Add(-time.Hour)
+
+		pool.removeIdle(time.Minute)
+
+		assert.Len(t, pool.clients, 1)
+		assert.NotNil(t, pool.clients[usedKey])
+		assert.Len(t, pool.transports, 1)
+		assert.NotNil(t, pool.transports[usedKey.tls])
+	})
+}
+
+func TestTokenSourcePool(t *testing.T) {
+	t.Run("token source shared by the same credentials", func(t *testing.T) {
+		pool := &tokenSourcePool{tokenSources: map[tokenSourceKey]*pooledTokenSource{}}
+		auth := Auth{Type: "oauth2", TokenURL: "https://example.com/token", ClientID: "id", ClientSecret: "secret"}
+
+		first := pool.get(auth)
+		second := pool.get(auth)
+
+		assert.True(t, first == second)
+	})
+
+	t.Run("idle token source removed", func(t *testing.T) {
+		pool := &tokenSourcePool{tokenSources: map[tokenSourceKey]*pooledTokenSource{}}
+		pool.get(Auth{Type: "oauth2", TokenURL: "https://example.com/token", ClientID: "id", ClientSecret: "secret"})
+		for _, tokenSource := range pool.tokenSources {
+			tokenSource.lastUsed = time.Now().Add(-time.Hour)
+		}
+
+		pool.removeIdle(time.Minute)
+
+		assert.Empty(t, pool.tokenSources)
+	})
+}
@@ -0,0 +1,120 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/serverless/libkv/store"
+)
+
+// Discovery types.
+const (
+	DNSDiscovery = "dns"
+	KVDiscovery  = "kv"
+)
+
+const (
+	defaultRefreshInterval = 30 * time.Second
+	defaultScheme          = "http"
+)
+
+var (
+	errRegistryNotConfigured = errors.New("service registry is not configured")
+	errNoTargets             = errors.New("no targets discovered")
+)
+
+// registry is a KV store used by "kv" discovery.
+var registry store.Store
+
+// lookupSRV resolves DNS SRV records. It's a variable so it can be replaced in tests.
+var lookupSRV = net.LookupSRV
+
+// SetRegistry sets the KV store used by "kv" discovery. Service instances are registered under "<service>/<instance>"
+// keys. Value is the instance URL or JSON encoded endpoint e.g. {"url": "http://10.0.0.1:8080/", "weight": 2}.
+func SetRegistry(kv store.Store) {
+	registry = kv
+}
+
+// resolve returns endpoints of the discovered service.
+func (d Discovery) resolve() ([]Endpoint, error) {
+	if d.Type == KVDiscovery {
+		return d.resolveKV()
+	}
+	return d.resolveDNS()
+}
+
+// resolveDNS returns endpoints from SRV records with the lowest priority. Records with higher priority values are
+// ignored.
+func (d Discovery) resolveDNS() ([]Endpoint, error) {
+	_, records, err := lookupSRV("", "", d.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := []Endpoint{}
+	for _, record := range records {
+		// records are sorted by priority
+		if record.Priority != records[0].Priority {
+			break
+		}
+		host := net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port)))
+		u := url.URL{Scheme: d.scheme(), Host: host, Path: d.Path}
+		endpoints = append(endpoints, Endpoint{URL: u.String(), Weight: int(record.Weight)})
+	}
+	if len(endpoints) == 0 {
+		return nil, errNoTargets
+	}
+	return endpoints, nil
+}
+
+// resolveKV returns endpoints registered in the service registry. Entries that are not valid URLs are skipped.
+func (d Discovery) resolveKV() ([]Endpoint, error) {
+	if registry == nil {
+		return nil, errRegistryNotConfigured
+	}
+
+	kvs, err := registry.List(strings.TrimSuffix(d.Name, "/")+"/", &store.ReadOptions{Consistent: true})
+	if err != nil && err != store.ErrKeyNotFound {
+		return nil, err
+	}
+
+	endpoints := []Endpoint{}
+	for _, kv := range kvs {
+		endpoint := Endpoint{}
+		err := json.Unmarshal(kv.Value, &endpoint)
+		if err != nil {
+			endpoint = Endpoint{URL: strings.TrimSpace(string(kv.Value))}
+		}
+		target, err := url.Parse(endpoint.URL)
+		if err != nil || target.Host == "" || (target.Scheme != "http" && target.Scheme != "https") {
+			continue
+		}
+		if endpoint.Weight < 0 {
+			endpoint.Weight = 0
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	if len(endpoints) == 0 {
+		return nil, errNoTargets
+	}
+	return endpoints, nil
+}
+
+func (d Discovery) scheme() string {
+	if d.Scheme == "" {
+		return defaultScheme
+	}
+	return d.Scheme
+}
+
+func (d Discovery) refreshInterval() time.Duration {
+	if d.Refresh == 0 {
+		return defaultRefreshInterval
+	}
+	return time.Duration(d.Refresh) * time.Millisecond
+}
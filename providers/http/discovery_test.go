@@ -0,0 +1,59 @@
+package http
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveDNS(t *testing.T) {
+	defer func() { lookupSRV = net.LookupSRV }()
+	var name string
+	lookupSRV = func(service, proto, n string) (string, []*net.SRV, error) {
+		name = n
+		return n, []*net.SRV{
+			{Target: "a.example.com.", Port: 8080, Priority: 10, Weight: 3},
+			{Target: "b.example.com.", Port: 8081, Priority: 10, Weight: 0},
+			{Target: "backup.example.com.", Port: 8080, Priority: 20, Weight: 1},
+		}, nil
+	}
+
+	endpoints, err := Discovery{Type: "dns", Name: "_api._tcp.example.com", Scheme: "https", Path: "/events"}.resolve()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "_api._tcp.example.com", name)
+	assert.Equal(t, []Endpoint{
+		{URL: "https://a.example.com:8080/events", Weight: 3},
+		{URL: "https://b.example.com:8081/events", Weight: 0},
+	}, endpoints)
+}
+
+func TestResolveDNS_Error(t *testing.T) {
+	defer func() { lookupSRV = net.LookupSRV }()
+	lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		return "", nil, errors.New("no such host")
+	}
+
+	_, err := Discovery{Type: "dns", Name: "_api._tcp.example.com"}.resolve()
+
+	assert.EqualError(t, err, "no such host")
+}
+
+func TestRefreshKeepsTargetsOnError(t *testing.T) {
+	defer func() { lookupSRV = net.LookupSRV }()
+	lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		return name, []*net.SRV{{Target: "a.example.com.", Port: 80}}, nil
+	}
+	b := newBalancer(HTTP{Discovery: &Discovery{Type: "dns", Name: "_api._tcp.example.com"}})
+	b.refresh()
+
+	lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		return "", nil, errors.New("no such host")
+	}
+	b.refresh()
+
+	assert.Len(t, b.targets, 1)
+	assert.Equal(t, "http://a.example.com:80", b.targets[0].url)
+}
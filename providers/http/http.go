@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/webhook"
 	"go.uber.org/zap/zapcore"
 	validator "gopkg.in/go-playground/validator.v9"
 )
@@ -17,31 +19,210 @@ import (
 // Type of provider.
 const Type = function.ProviderType("http")
 
+const (
+	defaultMethod  = http.MethodPost
+	defaultTimeout = 5 * time.Second
+)
+
 func init() {
 	function.RegisterProvider(Type, ProviderLoader{})
 }
 
 // HTTP function implementation
 type HTTP struct {
-	URL string `json:"url" validate:"required,url"`
+	URL string `json:"url,omitempty" validate:"omitempty,url"`
+	// Endpoints are used instead of URL to balance calls across multiple hosts.
+	Endpoints []Endpoint `json:"endpoints,omitempty" validate:"dive"`
+	// Discovery is used instead of URL to resolve endpoints dynamically.
+	Discovery *Discovery `json:"discovery,omitempty"`
+	// Balancing is one of "roundRobin", "leastOutstanding" or "weighted". By default "roundRobin".
+	Balancing   string       `json:"balancing,omitempty" validate:"omitempty,eq=roundRobin|eq=leastOutstanding|eq=weighted"`
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+	// Method is a HTTP method of the request. By default "POST".
+	Method string `json:"method,omitempty" validate:"omitempty,eq=GET|eq=POST|eq=PUT|eq=PATCH|eq=DELETE"`
+	// Headers are static headers sent with every request. Content-Type defaults to "application/cloudevents+json".
+	Headers map[string]string `json:"headers,omitempty"`
+	// Timeout is a time (in milliseconds) to wait for the response.
+	Timeout int      `json:"timeout,omitempty" validate:"min=0"`
+	Auth    *Auth    `json:"auth,omitempty"`
+	TLS     *TLS     `json:"tls,omitempty"`
+	Signing *Signing `json:"signing,omitempty"`
+}
+
+// Endpoint is one of the hosts calls are balanced across. Weight is used by "weighted" balancing, by default 1.
+type Endpoint struct {
+	URL    string `json:"url" validate:"required,url"`
+	Weight int    `json:"weight,omitempty" validate:"min=0"`
+}
+
+// Discovery config. Type is one of "dns" or "kv". For "dns", Name is a SRV record name e.g. "_api._tcp.example.com"
+// and endpoint URLs are built from Scheme, record target, port and Path. For "kv", Name is a service name in the
+// service registry stored in the Event Gateway KV store. Endpoints are refreshed in the background.
+type Discovery struct {
+	Type   string `json:"type" validate:"eq=dns|eq=kv"`
+	Name   string `json:"name" validate:"required"`
+	Scheme string `json:"scheme,omitempty" validate:"omitempty,eq=http|eq=https"`
+	Path   string `json:"path,omitempty"`
+	// Refresh is a time (in milliseconds) between endpoint refreshes. By default 30 seconds.
+	Refresh int `json:"refresh,omitempty" validate:"min=0"`
+}
+
+// HealthCheck config. Endpoints are periodically probed with GET request to the path resolved against endpoint URL.
+// Endpoint responding with 2xx or 3xx status code is healthy. Endpoint failing a call is ejected until it passes
+// HealthyThreshold consecutive checks.
+type HealthCheck struct {
+	Path string `json:"path" validate:"required"`
+	// Interval is a time (in milliseconds) between checks. By default 10 seconds.
+	Interval int `json:"interval,omitempty" validate:"min=0"`
+	// Timeout is a time (in milliseconds) to wait for the check response. By default 2 seconds.
+	Timeout int `json:"timeout,omitempty" validate:"min=0"`
+	// HealthyThreshold is a number of consecutive successful checks after which endpoint is healthy. By default 2.
+	HealthyThreshold int `json:"healthyThreshold,omitempty" validate:"min=0"`
+	// UnhealthyThreshold is a number of consecutive failed checks after which endpoint is unhealthy. By default 2.
+	UnhealthyThreshold int `json:"unhealthyThreshold,omitempty" validate:"min=0"`
+}
+
+// Auth config. Type is one of "basic", "bearer" or "oauth2". OAuth2 uses client credentials flow.
+type Auth struct {
+	Type         string   `json:"type" validate:"eq=basic|eq=bearer|eq=oauth2"`
+	Username     string   `json:"username,omitempty"`
+	Password     string   `json:"password,omitempty"`
+	Token        string   `json:"token,omitempty"`
+	TokenURL     string   `json:"tokenUrl,omitempty" validate:"omitempty,url"`
+	ClientID     string   `json:"clientId,omitempty"`
+	ClientSecret string   `json:"clientSecret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
 }
 
-// Call HTTP endpoint.
+// TLS config. Certificates and key are PEM encoded.
+type TLS struct {
+	CACert             string `json:"caCert,omitempty"`
+	ClientCert         string `json:"clientCert,omitempty"`
+	ClientKey          string `json:"clientKey,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// Signing config. Requests are signed with HMAC-SHA256 of the timestamp and the body. Signature is sent in
+// Event-Gateway-Signature header and can be verified with webhook package.
+type Signing struct {
+	// Secrets used to sign requests. Two secrets can be active at once so receivers can rotate the secret without
+	// rejecting requests. Request is signed with every secret.
+	Secrets []string `json:"secrets" validate:"min=1,max=2,dive,required"`
+}
+
+var (
+	errInvalidBasicAuth  = errors.New("username is required for basic auth")
+	errInvalidBearerAuth = errors.New("token is required for bearer auth")
+	errInvalidOAuth2     = errors.New("token URL, client ID and client secret are required for OAuth2 auth")
+	errInvalidHeaders    = errors.New("header name cannot be empty")
+	errInvalidEndpoints  = errors.New("exactly one of URL, endpoints or discovery is required")
+)
+
+// Call HTTP endpoint. Responses with 5xx and 429 status codes are treated as failed calls that can be retried, other
+// 4xx status codes are treated as function errors. If endpoints or discovery are configured, failed call is retried on
+// the next endpoint picked by the balancer.
 func (h HTTP) Call(payload []byte) ([]byte, error) {
-	client := http.Client{
-		Timeout: time.Second * 5,
+	if len(h.Endpoints) == 0 && h.Discovery == nil {
+		return h.call(h.URL, payload)
 	}
 
-	resp, err := client.Post(h.URL, "application/cloudevents+json", bytes.NewReader(payload))
+	b := balancers.get(h)
+	targets, err := b.order()
 	if err != nil {
 		return nil, &function.ErrFunctionCallFailed{Original: err}
 	}
-	if resp.StatusCode == http.StatusInternalServerError {
-		return nil, &function.ErrFunctionError{Original: fmt.Errorf("HTTP status code: %d", http.StatusInternalServerError)}
+	for _, target := range targets {
+		var output []byte
+		b.start(target)
+		output, err = h.call(target.url, payload)
+		_, failed := err.(*function.ErrFunctionCallFailed)
+		b.done(target, failed)
+		if !failed {
+			return output, err
+		}
 	}
+	return nil, err
+}
 
+func (h HTTP) call(url string, payload []byte) ([]byte, error) {
+	client, err := clients.get(clientKey{tls: h.tlsConfig(), timeout: h.timeout()})
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	req, err := http.NewRequest(h.method(), url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	for name, value := range h.Headers {
+		req.Header.Set(name, value)
+	}
+	err = h.authorize(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if h.Signing != nil {
+		req.Header.Set(webhook.SignatureHeader, webhook.Sign(payload, time.Now(), h.Signing.Secrets...))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
 	defer resp.Body.Close()
-	return ioutil.ReadAll(resp.Body)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if err := function.ErrFromStatusCode(resp.StatusCode); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// authorize sets Authorization header of the request.
+func (h HTTP) authorize(req *http.Request) error {
+	if h.Auth == nil {
+		return nil
+	}
+
+	switch h.Auth.Type {
+	case "basic":
+		req.SetBasicAuth(h.Auth.Username, h.Auth.Password)
+	case "bearer":
+		req.Header.Set("Authorization", "Bearer "+h.Auth.Token)
+	case "oauth2":
+		token, err := tokenSources.get(*h.Auth).Token()
+		if err != nil {
+			return err
+		}
+		token.SetAuthHeader(req)
+	}
+	return nil
+}
+
+func (h HTTP) method() string {
+	if h.Method == "" {
+		return defaultMethod
+	}
+	return h.Method
+}
+
+func (h HTTP) timeout() time.Duration {
+	if h.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(h.Timeout) * time.Millisecond
+}
+
+func (h HTTP) tlsConfig() TLS {
+	if h.TLS == nil {
+		return TLS{}
+	}
+	return *h.TLS
 }
 
 // validate provider config.
@@ -51,12 +232,110 @@ func (h HTTP) validate() error {
 	if err != nil {
 		return err
 	}
+	targets := 0
+	if h.URL != "" {
+		targets++
+	}
+	if len(h.Endpoints) > 0 {
+		targets++
+	}
+	if h.Discovery != nil {
+		targets++
+	}
+	if targets != 1 {
+		return errInvalidEndpoints
+	}
+	for name := range h.Headers {
+		if strings.TrimSpace(name) == "" {
+			return errInvalidHeaders
+		}
+	}
+	if h.Auth != nil {
+		switch h.Auth.Type {
+		case "basic":
+			if h.Auth.Username == "" {
+				return errInvalidBasicAuth
+			}
+		case "bearer":
+			if h.Auth.Token == "" {
+				return errInvalidBearerAuth
+			}
+		case "oauth2":
+			if h.Auth.TokenURL == "" || h.Auth.ClientID == "" || h.Auth.ClientSecret == "" {
+				return errInvalidOAuth2
+			}
+		}
+	}
 	return nil
 }
 
 // MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
 func (h HTTP) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddString("url", h.URL)
+	if h.URL != "" {
+		enc.AddString("url", h.URL)
+	}
+	if len(h.Endpoints) > 0 {
+		urls := []string{}
+		for _, endpoint := range h.Endpoints {
+			urls = append(urls, endpoint.URL)
+		}
+		enc.AddString("endpoints", strings.Join(urls, ","))
+	}
+	if h.Discovery != nil {
+		enc.AddString("discoveryType", h.Discovery.Type)
+		enc.AddString("discoveryName", h.Discovery.Name)
+	}
+	if h.Balancing != "" {
+		enc.AddString("balancing", h.Balancing)
+	}
+	if h.HealthCheck != nil {
+		enc.AddString("healthCheckPath", h.HealthCheck.Path)
+	}
+	if h.Method != "" {
+		enc.AddString("method", h.Method)
+	}
+	if len(h.Headers) > 0 {
+		names := []string{}
+		for name := range h.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		// values are not logged as they may contain secrets
+		enc.AddString("headers", strings.Join(names, ","))
+	}
+	if h.Timeout != 0 {
+		enc.AddInt("timeout", h.Timeout)
+	}
+	if h.Auth != nil {
+		enc.AddString("authType", h.Auth.Type)
+		if h.Auth.Username != "" {
+			enc.AddString("authUsername", h.Auth.Username)
+		}
+		if h.Auth.Password != "" {
+			enc.AddString("authPassword", "*****")
+		}
+		if h.Auth.Token != "" {
+			enc.AddString("authToken", "*****")
+		}
+		if h.Auth.TokenURL != "" {
+			enc.AddString("authTokenUrl", h.Auth.TokenURL)
+		}
+		if h.Auth.ClientID != "" {
+			enc.AddString("authClientId", h.Auth.ClientID)
+		}
+		if h.Auth.ClientSecret != "" {
+			enc.AddString("authClientSecret", "*****")
+		}
+	}
+	if h.TLS != nil {
+		enc.AddBool("tls", true)
+		if h.TLS.ClientKey != "" {
+			enc.AddString("tlsClientKey", "*****")
+		}
+	}
+	if h.Signing != nil {
+		enc.AddInt("signingSecrets", len(h.Signing.Secrets))
+	}
 	return nil
 }
 
@@ -73,7 +352,12 @@ func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
 
 	err = provider.validate()
 	if err != nil {
-		return nil, errors.New("missing required fields for HTTP endpoint")
+		return nil, &function.ErrFunctionValidation{Message: err.Error()}
+	}
+
+	_, err = clients.get(clientKey{tls: provider.tlsConfig(), timeout: provider.timeout()})
+	if err != nil {
+		return nil, errors.New("invalid TLS config for HTTP endpoint: " + err.Error())
 	}
 
 	return provider, nil
@@ -1,14 +1,25 @@
 package http_test
 
 import (
+	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/mock"
 	httpprovider "github.com/serverless/event-gateway/providers/http"
+	"github.com/serverless/event-gateway/webhook"
+	"github.com/serverless/libkv/store"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestLoad(t *testing.T) {
@@ -18,7 +29,19 @@ func TestLoad(t *testing.T) {
 	provider, err := loader.Load(config)
 
 	assert.Nil(t, provider)
-	assert.EqualError(t, err, "missing required fields for HTTP endpoint")
+	expected := &function.ErrFunctionValidation{Message: "exactly one of URL, endpoints or discovery is required"}
+	assert.Equal(t, expected, err)
+}
+
+func TestLoadValidation(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := httpprovider.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
 }
 
 func TestCall(t *testing.T) {
@@ -50,5 +73,499 @@ func TestCall_InternalError(t *testing.T) {
 
 	_, err := provider.Call([]byte("hello"))
 
-	assert.EqualError(t, err, "Function call failed because of runtime error. Error: HTTP status code: 500")
+	assert.EqualError(t, err, "Function call failed. Error: HTTP status code: 500")
+}
+
+func TestCall_MethodAndHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s %s", r.Method, r.Header.Get("Content-Type"), r.Header.Get("X-Api-Version"))
+	}))
+	defer ts.Close()
+	provider := load(t, `{"url": "`+ts.URL+`", "method": "PUT", "headers": {"Content-Type": "application/json",
+		"X-Api-Version": "2"}}`)
+
+	resp, err := provider.Call([]byte("hello"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "PUT application/json 2", string(resp))
+}
+
+func TestCall_Auth(t *testing.T) {
+	tokenRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokenRequests++
+			clientID, _, _ := r.BasicAuth()
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token": "token-%s-%s", "token_type": "Bearer", "expires_in": 3600}`,
+				clientID, r.PostFormValue("scope"))
+			return
+		}
+		fmt.Fprint(w, r.Header.Get("Authorization"))
+	}))
+	defer ts.Close()
+
+	for _, testCase := range []struct {
+		auth     string
+		expected string
+	}{
+		{`{"type": "basic", "username": "user", "password": "pass"}`, "Basic dXNlcjpwYXNz"},
+		{`{"type": "bearer", "token": "secret"}`, "Bearer secret"},
+		{`{"type": "oauth2", "tokenUrl": "` + ts.URL + `/token", "clientId": "client", "clientSecret": "secret",
+			"scopes": ["read"]}`, "Bearer token-client-read"},
+		{`{"type": "oauth2", "tokenUrl": "` + ts.URL + `/token", "clientId": "client", "clientSecret": "secret",
+			"scopes": ["read"]}`, "Bearer token-client-read"},
+	} {
+		resp, err := load(t, `{"url": "`+ts.URL+`", "auth": `+testCase.auth+`}`).Call([]byte("hello"))
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expected, string(resp))
+	}
+	assert.Equal(t, 1, tokenRequests)
+}
+
+func TestCall_TLS(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "secure")
+	}))
+	defer ts.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	config, _ := json.Marshal(map[string]interface{}{"url": ts.URL, "tls": map[string]string{"caCert": string(caCert)}})
+
+	resp, err := load(t, string(config)).Call([]byte("hello"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "secure", string(resp))
+
+	_, err = load(t, `{"url": "`+ts.URL+`"}`).Call([]byte("hello"))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestCall_Signing(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := webhook.VerifyRequest(r, webhook.DefaultTolerance, "new-secret")
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, "verified")
+	}))
+	defer ts.Close()
+
+	resp, err := load(t, `{"url": "`+ts.URL+`", "signing": {"secrets": ["old-secret", "new-secret"]}}`).
+		Call([]byte("hello"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "verified", string(resp))
+
+	_, err = load(t, `{"url": "`+ts.URL+`", "signing": {"secrets": ["old-secret"]}}`).Call([]byte("hello"))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("HTTP status code: 401")}, err)
+}
+
+func TestCall_StatusCodes(t *testing.T) {
+	for _, testCase := range statusTests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(testCase.status)
+		}))
+
+		_, err := httpprovider.HTTP{URL: ts.URL}.Call([]byte("hello"))
+		ts.Close()
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall_Timeout(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	_, err := load(t, `{"url": "`+ts.URL+`", "timeout": 50}`).Call([]byte("hello"))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestCall_EndpointsRoundRobin(t *testing.T) {
+	first, firstCalls := countingServer(http.StatusOK)
+	defer first.Close()
+	second, secondCalls := countingServer(http.StatusOK)
+	defer second.Close()
+	provider := load(t, `{"endpoints": [{"url": "`+first.URL+`"}, {"url": "`+second.URL+`"}]}`)
+
+	for i := 0; i < 4; i++ {
+		_, err := provider.Call([]byte("hello"))
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(firstCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(secondCalls))
+}
+
+func TestCall_EndpointsWeighted(t *testing.T) {
+	first, firstCalls := countingServer(http.StatusOK)
+	defer first.Close()
+	second, secondCalls := countingServer(http.StatusOK)
+	defer second.Close()
+	provider := load(t, `{"balancing": "weighted", "endpoints": [{"url": "`+first.URL+`", "weight": 3},
+		{"url": "`+second.URL+`"}]}`)
+
+	for i := 0; i < 8; i++ {
+		_, err := provider.Call([]byte("hello"))
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, int32(6), atomic.LoadInt32(firstCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(secondCalls))
+}
+
+func TestCall_EndpointsFailover(t *testing.T) {
+	down, downCalls := countingServer(http.StatusServiceUnavailable)
+	defer down.Close()
+	up, upCalls := countingServer(http.StatusOK)
+	defer up.Close()
+	provider := load(t, `{"balancing": "leastOutstanding", "endpoints": [{"url": "`+down.URL+`"}, {"url": "`+up.URL+`"}]}`)
+
+	for i := 0; i < 4; i++ {
+		_, err := provider.Call([]byte("hello"))
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(downCalls))
+	assert.Equal(t, int32(4), atomic.LoadInt32(upCalls))
+}
+
+func TestCall_EndpointsAllFailed(t *testing.T) {
+	first, _ := countingServer(http.StatusBadGateway)
+	defer first.Close()
+	second, _ := countingServer(http.StatusBadGateway)
+	defer second.Close()
+	provider := load(t, `{"endpoints": [{"url": "`+first.URL+`"}, {"url": "`+second.URL+`"}]}`)
+
+	_, err := provider.Call([]byte("hello"))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 502")}, err)
+}
+
+func TestCall_EndpointsFunctionErrorNotRetried(t *testing.T) {
+	first, firstCalls := countingServer(http.StatusNotFound)
+	defer first.Close()
+	second, secondCalls := countingServer(http.StatusNotFound)
+	defer second.Close()
+	provider := load(t, `{"endpoints": [{"url": "`+first.URL+`"}, {"url": "`+second.URL+`"}]}`)
+
+	_, err := provider.Call([]byte("hello"))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("HTTP status code: 404")}, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(firstCalls)+atomic.LoadInt32(secondCalls))
+}
+
+func TestCall_EndpointsHealthCheck(t *testing.T) {
+	var healthy int32 = 1
+	var calls int32
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			if atomic.LoadInt32(&healthy) == 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			return
+		}
+		atomic.AddInt32(&calls, 1)
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer flaky.Close()
+	stable, _ := countingServer(http.StatusOK)
+	defer stable.Close()
+	provider := load(t, `{"endpoints": [{"url": "`+flaky.URL+`/fn"}, {"url": "`+stable.URL+`"}],
+		"healthCheck": {"path": "/health", "interval": 20, "healthyThreshold": 1}}`)
+
+	atomic.StoreInt32(&healthy, 0)
+	for i := 0; i < 4; i++ {
+		_, err := provider.Call([]byte("hello"))
+		assert.Nil(t, err)
+	}
+	// flaky endpoint is ejected after the first failed call
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	atomic.StoreInt32(&healthy, 1)
+	time.Sleep(200 * time.Millisecond)
+	for i := 0; i < 4; i++ {
+		_, err := provider.Call([]byte("hello"))
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestCall_DiscoveryKV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	first, firstCalls := countingServer(http.StatusOK)
+	defer first.Close()
+	second, secondCalls := countingServer(http.StatusOK)
+	defer second.Close()
+	registry := mock.NewMockStore(ctrl)
+	registry.EXPECT().List("api/", &store.ReadOptions{Consistent: true}).Return([]*store.KVPair{
+		{Key: "api/1", Value: []byte(first.URL)},
+		{Key: "api/2", Value: []byte(`{"url": "` + second.URL + `", "weight": 1}`)},
+		{Key: "api/3", Value: []byte("invalid")},
+	}, nil)
+	httpprovider.SetRegistry(registry)
+	defer httpprovider.SetRegistry(nil)
+	provider := load(t, `{"discovery": {"type": "kv", "name": "api"}}`)
+
+	for i := 0; i < 4; i++ {
+		_, err := provider.Call([]byte("hello"))
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(firstCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(secondCalls))
+}
+
+func TestCall_DiscoveryKVNoTargets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	registry := mock.NewMockStore(ctrl)
+	registry.EXPECT().List("orders/", gomock.Any()).Return(nil, store.ErrKeyNotFound)
+	httpprovider.SetRegistry(registry)
+	defer httpprovider.SetRegistry(nil)
+	provider := load(t, `{"discovery": {"type": "kv", "name": "orders"}}`)
+
+	_, err := provider.Call([]byte("hello"))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("no targets discovered")}, err)
+}
+
+func TestCall_DiscoveryNoRegistry(t *testing.T) {
+	provider := load(t, `{"discovery": {"type": "kv", "name": "payments"}}`)
+
+	_, err := provider.Call([]byte("hello"))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("service registry is not configured")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+
+	httpprovider.HTTP{
+		URL:     "https://example.com",
+		Method:  "PUT",
+		Headers: map[string]string{"X-Api-Key": "secret", "Accept": "application/json"},
+		Timeout: 1000,
+		Auth: &httpprovider.Auth{
+			Type:         "oauth2",
+			TokenURL:     "https://auth.example.com/token",
+			ClientID:     "client",
+			ClientSecret: "secret",
+		},
+		TLS:     &httpprovider.TLS{ClientCert: "cert", ClientKey: "key"},
+		Signing: &httpprovider.Signing{Secrets: []string{"old-secret", "new-secret"}},
+	}.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{
+		"url":              "https://example.com",
+		"method":           "PUT",
+		"headers":          "Accept,X-Api-Key",
+		"timeout":          1000,
+		"authType":         "oauth2",
+		"authTokenUrl":     "https://auth.example.com/token",
+		"authClientId":     "client",
+		"authClientSecret": "*****",
+		"tls":              true,
+		"tlsClientKey":     "*****",
+		"signingSecrets":   2,
+	}, enc.Fields)
+}
+
+func TestMarshalLogObject_Endpoints(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+
+	httpprovider.HTTP{
+		Endpoints:   []httpprovider.Endpoint{{URL: "https://a.example.com"}, {URL: "https://b.example.com", Weight: 2}},
+		Balancing:   "weighted",
+		HealthCheck: &httpprovider.HealthCheck{Path: "/health"},
+	}.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{
+		"endpoints":       "https://a.example.com,https://b.example.com",
+		"balancing":       "weighted",
+		"healthCheckPath": "/health",
+	}, enc.Fields)
+}
+
+func TestMarshalLogObject_Discovery(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+
+	httpprovider.HTTP{Discovery: &httpprovider.Discovery{Type: "dns", Name: "_api._tcp.example.com"}}.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{
+		"discoveryType": "dns",
+		"discoveryName": "_api._tcp.example.com",
+	}, enc.Fields)
+}
+
+// countingServer returns server responding with the status code and a counter of received requests.
+func countingServer(status int) (*httptest.Server, *int32) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(status)
+	}))
+	return ts, &calls
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := httpprovider.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"url": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"url": "https://example.com", "method": "HEAD"}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Method' Error:Field validation for 'Method' failed on the " +
+				"'eq=GET|eq=POST|eq=PUT|eq=PATCH|eq=DELETE' tag",
+		},
+	},
+	{
+		`{"url": "https://example.com", "timeout": -1}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Timeout' Error:Field validation for 'Timeout' failed on the 'min' tag",
+		},
+	},
+	{
+		`{"url": "https://example.com", "headers": {"": "value"}}`,
+		&function.ErrFunctionValidation{Message: "header name cannot be empty"},
+	},
+	{
+		`{"url": "https://example.com", "auth": {"type": "digest"}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Auth.Type' Error:Field validation for 'Type' failed on the 'eq=basic|eq=bearer|eq=oauth2' tag",
+		},
+	},
+	{
+		`{"url": "https://example.com", "auth": {"type": "basic"}}`,
+		&function.ErrFunctionValidation{Message: "username is required for basic auth"},
+	},
+	{
+		`{"url": "https://example.com", "auth": {"type": "bearer"}}`,
+		&function.ErrFunctionValidation{Message: "token is required for bearer auth"},
+	},
+	{
+		`{"url": "https://example.com", "auth": {"type": "oauth2", "clientId": "client"}}`,
+		&function.ErrFunctionValidation{Message: "token URL, client ID and client secret are required for OAuth2 auth"},
+	},
+	{
+		`{"url": "https://example.com", "signing": {"secrets": []}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Signing.Secrets' Error:Field validation for 'Secrets' failed on the 'min' tag",
+		},
+	},
+	{
+		`{"url": "https://example.com", "signing": {"secrets": ["a", "b", "c"]}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Signing.Secrets' Error:Field validation for 'Secrets' failed on the 'max' tag",
+		},
+	},
+	{
+		`{"url": "https://example.com", "signing": {"secrets": [""]}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Signing.Secrets[0]' Error:Field validation for 'Secrets[0]' failed on the 'required' tag",
+		},
+	},
+	{
+		`{"url": "https://example.com", "endpoints": [{"url": "https://a.example.com"}]}`,
+		&function.ErrFunctionValidation{Message: "exactly one of URL, endpoints or discovery is required"},
+	},
+	{
+		`{"endpoints": [{"url": "not a url"}]}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Endpoints[0].URL' Error:Field validation for 'URL' failed on the 'url' tag",
+		},
+	},
+	{
+		`{"endpoints": [{"url": "https://a.example.com", "weight": -1}]}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Endpoints[0].Weight' Error:Field validation for 'Weight' failed on the 'min' tag",
+		},
+	},
+	{
+		`{"endpoints": [{"url": "https://a.example.com"}], "balancing": "random"}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Balancing' Error:Field validation for 'Balancing' failed on the " +
+				"'eq=roundRobin|eq=leastOutstanding|eq=weighted' tag",
+		},
+	},
+	{
+		`{"endpoints": [{"url": "https://a.example.com"}], "healthCheck": {"interval": 1000}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.HealthCheck.Path' Error:Field validation for 'Path' failed on the 'required' tag",
+		},
+	},
+	{
+		`{"endpoints": [{"url": "https://a.example.com", "weight": 2}, {"url": "https://b.example.com"}],
+			"balancing": "weighted", "healthCheck": {"path": "/health", "interval": 1000, "unhealthyThreshold": 3}}`,
+		nil,
+	},
+	{
+		`{"url": "https://example.com", "discovery": {"type": "dns", "name": "_api._tcp.example.com"}}`,
+		&function.ErrFunctionValidation{Message: "exactly one of URL, endpoints or discovery is required"},
+	},
+	{
+		`{"discovery": {"type": "consul", "name": "api"}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Discovery.Type' Error:Field validation for 'Type' failed on the 'eq=dns|eq=kv' tag",
+		},
+	},
+	{
+		`{"discovery": {"type": "dns"}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Discovery.Name' Error:Field validation for 'Name' failed on the 'required' tag",
+		},
+	},
+	{
+		`{"discovery": {"type": "dns", "name": "_api._tcp.example.com", "scheme": "ftp"}}`,
+		&function.ErrFunctionValidation{
+			Message: "Key: 'HTTP.Discovery.Scheme' Error:Field validation for 'Scheme' failed on the 'eq=http|eq=https' tag",
+		},
+	},
+	{
+		`{"discovery": {"type": "dns", "name": "_api._tcp.example.com", "scheme": "https", "path": "/events",
+			"refresh": 5000}, "balancing": "weighted"}`,
+		nil,
+	},
+	{
+		`{"url": "https://example.com", "tls": {"caCert": "invalid"}}`,
+		errors.New("invalid TLS config for HTTP endpoint: unable to parse CA certificate"),
+	},
+	{
+		`{"url": "https://example.com", "method": "PUT", "headers": {"X-Api-Key": "secret"}, "timeout": 1000,
+			"auth": {"type": "basic", "username": "user"}, "tls": {"insecureSkipVerify": true}}`,
+		nil,
+	},
+}
+
+var statusTests = []struct {
+	status        int
+	expectedError error
+}{
+	{http.StatusCreated, nil},
+	{http.StatusBadRequest, &function.ErrFunctionError{Original: errors.New("HTTP status code: 400")}},
+	{http.StatusNotFound, &function.ErrFunctionError{Original: errors.New("HTTP status code: 404")}},
+	{http.StatusTooManyRequests, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 429")}},
+	{http.StatusBadGateway, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 502")}},
 }
@@ -0,0 +1,168 @@
+package kafka
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("kafka")
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// Kafka function implementation. Event is produced to the topic.
+type Kafka struct {
+	Producer sarama.SyncProducer `json:"-" validate:"-"`
+
+	Brokers []string `json:"brokers" validate:"required,min=1,dive,required"`
+	Topic   string   `json:"topic" validate:"required"`
+	// PartitionKey is a path to the event attribute used as a message key e.g. "source", "extensions.tenant" or
+	// "data.userId". Messages without a key are distributed randomly across partitions.
+	PartitionKey string `json:"partitionKey,omitempty"`
+	Acks         string `json:"acks,omitempty" validate:"omitempty,eq=none|eq=leader|eq=all"`
+	Compression  string `json:"compression,omitempty" validate:"omitempty,eq=none|eq=gzip|eq=snappy|eq=lz4"`
+	SASL         *SASL  `json:"sasl,omitempty"`
+	TLS          *TLS   `json:"tls,omitempty"`
+}
+
+// SASL authentication config.
+type SASL struct {
+	Mechanism string `json:"mechanism,omitempty" validate:"omitempty,eq=PLAIN"`
+	Username  string `json:"username" validate:"required"`
+	Password  string `json:"password" validate:"required"`
+}
+
+// TLS config. Certificates and key are PEM encoded.
+type TLS struct {
+	CACert             string `json:"caCert,omitempty"`
+	ClientCert         string `json:"clientCert,omitempty"`
+	ClientKey          string `json:"clientKey,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+// Call produces payload to Kafka topic.
+func (k Kafka) Call(payload []byte) ([]byte, error) {
+	syncProducer := k.Producer
+	if syncProducer == nil {
+		shared := producers.acquire(newProducerKey(&k))
+		defer producers.release(shared)
+
+		var err error
+		syncProducer, err = shared.get()
+		if err != nil {
+			return nil, &function.ErrFunctionCallFailed{Original: err}
+		}
+	}
+
+	message := &sarama.ProducerMessage{
+		Topic: k.Topic,
+		Value: sarama.ByteEncoder(payload),
+	}
+	if key := partitionKey(payload, k.PartitionKey); key != nil {
+		message.Key = sarama.ByteEncoder(key)
+	}
+
+	partition, offset, err := syncProducer.SendMessage(message)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	return json.Marshal(result{Partition: partition, Offset: offset})
+}
+
+type result struct {
+	Partition int32 `json:"partition"`
+	Offset    int64 `json:"offset"`
+}
+
+// partitionKey returns value of the event attribute pointed by the path. String values are used as is, other values
+// are JSON encoded. It returns nil if the attribute doesn't exist.
+func partitionKey(payload []byte, path string) []byte {
+	if path == "" {
+		return nil
+	}
+
+	var event interface{}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil
+	}
+	value, ok := eventpkg.Lookup(event, path)
+	if !ok {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []byte(v)
+	default:
+		key, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		return key
+	}
+}
+
+// validate provider config.
+func (k Kafka) validate() error {
+	validate := validator.New()
+	err := validate.Struct(k)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (k Kafka) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("brokers", strings.Join(k.Brokers, ","))
+	enc.AddString("topic", k.Topic)
+	if k.PartitionKey != "" {
+		enc.AddString("partitionKey", k.PartitionKey)
+	}
+	if k.Acks != "" {
+		enc.AddString("acks", k.Acks)
+	}
+	if k.Compression != "" {
+		enc.AddString("compression", k.Compression)
+	}
+	if k.SASL != nil {
+		enc.AddString("saslUsername", k.SASL.Username)
+		enc.AddString("saslPassword", "*****")
+	}
+	if k.TLS != nil {
+		enc.AddBool("tls", true)
+		if k.TLS.ClientKey != "" {
+			enc.AddString("tlsClientKey", "*****")
+		}
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &Kafka{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for Kafka function")
+	}
+
+	return provider, nil
+}
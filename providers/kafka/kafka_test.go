@@ -0,0 +1,200 @@
+package kafka_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/kafka"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := kafka.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	for _, testCase := range callTests {
+		producerMock := mocks.NewSyncProducer(t, nil)
+		if testCase.sendError != nil {
+			producerMock.ExpectSendMessageAndFail(testCase.sendError)
+		} else {
+			producerMock.ExpectSendMessageAndSucceed()
+		}
+
+		provider := kafka.Kafka{
+			Producer: producerMock,
+			Brokers:  []string{"localhost:9092"},
+			Topic:    "testtopic",
+		}
+
+		output, err := provider.Call([]byte("testpayload"))
+
+		assert.Equal(t, testCase.expectedResult, output)
+		assert.Equal(t, testCase.expectedError, err)
+		producerMock.Close()
+	}
+}
+
+func TestCallPartitionKey(t *testing.T) {
+	for _, testCase := range partitionKeyTests {
+		producer := &recordingProducer{}
+		provider := kafka.Kafka{
+			Producer:     producer,
+			Brokers:      []string{"localhost:9092"},
+			Topic:        "testtopic",
+			PartitionKey: testCase.partitionKey,
+		}
+
+		_, err := provider.Call([]byte(`{"eventID":"1","source":"/service","extensions":{"tenant":"acme"},` +
+			`"data":{"userId":7}}`))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "testtopic", producer.message.Topic)
+		if testCase.expectedKey == nil {
+			assert.Nil(t, producer.message.Key)
+		} else {
+			assert.Equal(t, sarama.ByteEncoder(testCase.expectedKey), producer.message.Key)
+		}
+	}
+}
+
+func TestCallBrokerUnavailable(t *testing.T) {
+	provider, _ := kafka.ProviderLoader{}.Load([]byte(`{"brokers": ["127.0.0.1:1"], "topic": "test"}`))
+
+	_, err := provider.Call([]byte("testpayload"))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+type recordingProducer struct {
+	sarama.SyncProducer
+	message *sarama.ProducerMessage
+}
+
+func (p *recordingProducer) SendMessage(message *sarama.ProducerMessage) (int32, int64, error) {
+	p.message = message
+	return 0, 0, nil
+}
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"brokers": ["localhost:9092"], "topic": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"brokers": [], "topic": "test"}`,
+		errors.New("missing required fields for Kafka function"),
+	},
+	{
+		`{"brokers": ["localhost:9092"], "topic": ""}`,
+		errors.New("missing required fields for Kafka function"),
+	},
+	{
+		`{"brokers": ["localhost:9092"], "topic": "test", "acks": "some"}`,
+		errors.New("missing required fields for Kafka function"),
+	},
+	{
+		`{"brokers": ["localhost:9092"], "topic": "test", "compression": "zstd"}`,
+		errors.New("missing required fields for Kafka function"),
+	},
+	{
+		`{"brokers": ["localhost:9092"], "topic": "test", "sasl": {"username": "user"}}`,
+		errors.New("missing required fields for Kafka function"),
+	},
+	{
+		`{"brokers": ["localhost:9092"], "topic": "test", "acks": "all", "compression": "gzip",
+		  "sasl": {"username": "user", "password": "pass"}, "tls": {"insecureSkipVerify": true}}`,
+		nil,
+	},
+}
+
+var callTests = []struct {
+	sendError      error
+	expectedResult []byte
+	expectedError  error
+}{
+	{
+		nil,
+		[]byte(`{"partition":0,"offset":1}`),
+		nil,
+	},
+	{
+		sarama.ErrNotLeaderForPartition,
+		[]byte(nil),
+		&function.ErrFunctionCallFailed{Original: sarama.ErrNotLeaderForPartition},
+	},
+}
+
+var partitionKeyTests = []struct {
+	partitionKey string
+	expectedKey  []byte
+}{
+	{"", nil},
+	{"source", []byte("/service")},
+	{"extensions.tenant", []byte("acme")},
+	{"data.userId", []byte("7")},
+	{"data.missing", nil},
+	{"eventID.nested", nil},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		kafka.Kafka{
+			Brokers: []string{"broker1:9092", "broker2:9092"},
+			Topic:   "test",
+		},
+		map[string]interface{}{
+			"brokers": "broker1:9092,broker2:9092",
+			"topic":   "test",
+		},
+	},
+	{
+		kafka.Kafka{
+			Brokers:      []string{"broker1:9092"},
+			Topic:        "test",
+			PartitionKey: "source",
+			Acks:         "all",
+			Compression:  "lz4",
+			SASL:         &kafka.SASL{Username: "user", Password: "pass"},
+			TLS:          &kafka.TLS{ClientCert: "cert", ClientKey: "key"},
+		},
+		map[string]interface{}{
+			"brokers":      "broker1:9092",
+			"topic":        "test",
+			"partitionKey": "source",
+			"acks":         "all",
+			"compression":  "lz4",
+			"saslUsername": "user",
+			"saslPassword": "*****",
+			"tls":          true,
+			"tlsClientKey": "*****",
+		},
+	},
+}
@@ -0,0 +1,216 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+const (
+	// producerIdleTimeout is a time after which unused producer is closed. Producers of removed or updated functions
+	// are not used anymore so they are closed once they are idle for this long.
+	producerIdleTimeout = 5 * time.Minute
+	reapInterval        = time.Minute
+)
+
+// producerKey identifies producers by connection and delivery settings. Topic and partition key are set per message
+// so functions producing to different topics share one producer.
+type producerKey struct {
+	brokers            string
+	acks               string
+	compression        string
+	saslMechanism      string
+	saslUsername       string
+	saslPassword       string
+	tls                bool
+	caCert             string
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+}
+
+func newProducerKey(k *Kafka) producerKey {
+	key := producerKey{
+		brokers:     strings.Join(k.Brokers, ","),
+		acks:        k.Acks,
+		compression: k.Compression,
+	}
+	if k.SASL != nil {
+		key.saslMechanism = k.SASL.Mechanism
+		key.saslUsername = k.SASL.Username
+		key.saslPassword = k.SASL.Password
+	}
+	if k.TLS != nil {
+		key.tls = true
+		key.caCert = k.TLS.CACert
+		key.clientCert = k.TLS.ClientCert
+		key.clientKey = k.TLS.ClientKey
+		key.insecureSkipVerify = k.TLS.InsecureSkipVerify
+	}
+	return key
+}
+
+// sharedProducer is a Kafka sync producer shared by all functions with the same producer key. It connects on first
+// call. If connecting fails it's retried on the next call.
+type sharedProducer struct {
+	sync.Mutex
+	key      producerKey
+	producer sarama.SyncProducer
+
+	// calls and lastUsed are guarded by the pool lock.
+	calls    int
+	lastUsed time.Time
+}
+
+func (p *sharedProducer) get() (sarama.SyncProducer, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.producer != nil {
+		return p.producer, nil
+	}
+
+	config, err := p.key.config()
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducer(strings.Split(p.key.brokers, ","), config)
+	if err != nil {
+		return nil, err
+	}
+	p.producer = producer
+	return producer, nil
+}
+
+func (p *sharedProducer) close() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.producer != nil {
+		p.producer.Close()
+		p.producer = nil
+	}
+}
+
+var errInvalidCACert = errors.New("unable to parse CA certificate")
+
+// config creates sarama config from producer settings.
+func (key producerKey) config() (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	config.ClientID = "event-gateway"
+	config.Producer.Return.Successes = true
+
+	switch key.acks {
+	case "none":
+		config.Producer.RequiredAcks = sarama.NoResponse
+	case "all":
+		config.Producer.RequiredAcks = sarama.WaitForAll
+	default:
+		config.Producer.RequiredAcks = sarama.WaitForLocal
+	}
+
+	switch key.compression {
+	case "gzip":
+		config.Producer.Compression = sarama.CompressionGZIP
+	case "snappy":
+		config.Producer.Compression = sarama.CompressionSnappy
+	case "lz4":
+		// LZ4 requires at least Kafka 0.10.
+		config.Version = sarama.V0_10_0_0
+		config.Producer.Compression = sarama.CompressionLZ4
+	default:
+		config.Producer.Compression = sarama.CompressionNone
+	}
+
+	if key.saslUsername != "" {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = key.saslUsername
+		config.Net.SASL.Password = key.saslPassword
+	}
+
+	if key.tls {
+		tlsConfig := &tls.Config{InsecureSkipVerify: key.insecureSkipVerify}
+		if key.caCert != "" {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM([]byte(key.caCert)) {
+				return nil, errInvalidCACert
+			}
+			tlsConfig.RootCAs = pool
+		}
+		if key.clientCert != "" {
+			cert, err := tls.X509KeyPair([]byte(key.clientCert), []byte(key.clientKey))
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+
+	return config, nil
+}
+
+type producerPool struct {
+	sync.Mutex
+	producers map[producerKey]*sharedProducer
+	reaping   sync.Once
+}
+
+// producers is a pool of producers reused across function config updates. Producers idle for longer than
+// producerIdleTimeout are closed and removed from the pool.
+var producers = &producerPool{producers: map[producerKey]*sharedProducer{}}
+
+// acquire returns producer for the key. Producer is not closed until it's released.
+func (p *producerPool) acquire(key producerKey) *sharedProducer {
+	p.Lock()
+	defer p.Unlock()
+
+	producer, ok := p.producers[key]
+	if !ok {
+		producer = &sharedProducer{key: key}
+		p.producers[key] = producer
+		p.reaping.Do(func() {
+			go p.reap(reapInterval, producerIdleTimeout)
+		})
+	}
+	producer.calls++
+	return producer
+}
+
+func (p *producerPool) release(producer *sharedProducer) {
+	p.Lock()
+	defer p.Unlock()
+
+	producer.calls--
+	producer.lastUsed = time.Now()
+}
+
+// reap closes idle producers every interval.
+func (p *producerPool) reap(interval, idleTimeout time.Duration) {
+	for range time.Tick(interval) {
+		p.closeIdle(idleTimeout)
+	}
+}
+
+// closeIdle closes and removes producers not used for longer than idleTimeout. Producers in use are not affected.
+func (p *producerPool) closeIdle(idleTimeout time.Duration) {
+	p.Lock()
+	idle := []*sharedProducer{}
+	for key, producer := range p.producers {
+		if producer.calls == 0 && time.Since(producer.lastUsed) > idleTimeout {
+			idle = append(idle, producer)
+			delete(p.producers, key)
+		}
+	}
+	p.Unlock()
+
+	for _, producer := range idle {
+		producer.close()
+	}
+}
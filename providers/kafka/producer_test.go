@@ -0,0 +1,59 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProducerPool(t *testing.T) {
+	t.Run("producer shared by the same key", func(t *testing.T) {
+		pool := &producerPool{producers: map[producerKey]*sharedProducer{}}
+		key := producerKey{brokers: "localhost:9092"}
+
+		first := pool.acquire(key)
+		second := pool.acquire(key)
+
+		assert.True(t, first == second)
+		assert.Equal(t, 2, first.calls)
+	})
+
+	t.Run("idle producer closed and removed", func(t *testing.T) {
+		pool := &producerPool{producers: map[producerKey]*sharedProducer{}}
+		key := producerKey{brokers: "localhost:9092"}
+		producer := pool.acquire(key)
+		producerMock := mocks.NewSyncProducer(t, nil)
+		producer.producer = producerMock
+		pool.release(producer)
+		producer.lastUsed = time.Now().Add(-time.Hour)
+
+		pool.closeIdle(time.Minute)
+
+		assert.Empty(t, pool.producers)
+		assert.Nil(t, producer.producer)
+		assert.False(t, pool.acquire(key) == producer)
+	})
+
+	t.Run("producer in use not removed", func(t *testing.T) {
+		pool := &producerPool{producers: map[producerKey]*sharedProducer{}}
+		key := producerKey{brokers: "localhost:9092"}
+		producer := pool.acquire(key)
+		producer.lastUsed = time.Now().Add(-time.Hour)
+
+		pool.closeIdle(time.Minute)
+
+		assert.True(t, pool.acquire(key) == producer)
+	})
+
+	t.Run("recently used producer not removed", func(t *testing.T) {
+		pool := &producerPool{producers: map[producerKey]*sharedProducer{}}
+		key := producerKey{brokers: "localhost:9092"}
+		pool.release(pool.acquire(key))
+
+		pool.closeIdle(time.Minute)
+
+		assert.Len(t, pool.producers, 1)
+	})
+}
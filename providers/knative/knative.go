@@ -0,0 +1,314 @@
+package knative
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("knative")
+
+const (
+	defaultTimeout = 5 * time.Second
+	// specVersion is a CloudEvents spec version required by Knative event contract.
+	specVersion = "1.0"
+	// maxAttributeNameLength is a maximum length of CloudEvents attribute name.
+	maxAttributeNameLength = 20
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// Knative function implementation. Event is delivered to Knative Service or Broker as CloudEvents v1.0 in HTTP binary
+// content mode.
+type Knative struct {
+	// URL is an address of Knative Service e.g. "http://hello.default.svc.cluster.local" or Broker ingress e.g.
+	// "http://broker-ingress.knative-eventing.svc.cluster.local/default/default".
+	URL string `json:"url" validate:"required,url"`
+	// Timeout is a time (in milliseconds) to wait for the response.
+	Timeout int `json:"timeout,omitempty" validate:"min=0"`
+}
+
+var errInvalidEvent = errors.New("payload is not a CloudEvent")
+
+// attributes maps CloudEvents v0.1 attributes to v1.0 attributes.
+var attributes = map[string]string{
+	"eventID":   "id",
+	"source":    "source",
+	"eventType": "type",
+	"eventTime": "time",
+	"schemaURL": "dataschema",
+}
+
+// replyAttributes maps CloudEvents v1.0 attributes of reply events to v0.1 attributes.
+var replyAttributes = map[string]string{
+	"id":         "eventID",
+	"source":     "source",
+	"type":       "eventType",
+	"time":       "eventTime",
+	"dataschema": "schemaURL",
+}
+
+// Call delivers event to Knative Service or Broker. Event data is sent as the request body and attributes as "ce-"
+// headers. Reply event sent back in HTTP binary content mode is returned as CloudEvents v0.1 JSON. Other responses
+// (including replies in structured content mode) are returned as is.
+func (k Knative) Call(payload []byte) ([]byte, error) {
+	var event map[string]interface{}
+	err := json.Unmarshal(payload, &event)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: errInvalidEvent}
+	}
+
+	contentType, _ := event["contentType"].(string)
+	body, contentType, err := encodeData(event["data"], contentType)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, k.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("ce-specversion", specVersion)
+	for name, attribute := range attributes {
+		if value, ok := event[name].(string); ok && value != "" {
+			req.Header.Set("ce-"+attribute, value)
+		}
+	}
+	if eventTypeVersion, ok := event["eventTypeVersion"].(string); ok && eventTypeVersion != "" {
+		req.Header.Set("ce-eventtypeversion", eventTypeVersion)
+	}
+	if extensions, ok := event["extensions"].(map[string]interface{}); ok {
+		for name, value := range extensions {
+			name = attributeName(name)
+			if name == "" {
+				continue
+			}
+			req.Header.Set("ce-"+name, attributeValue(value))
+		}
+	}
+	for _, required := range []string{"ce-id", "ce-source", "ce-type"} {
+		if req.Header.Get(required) == "" {
+			return nil, &function.ErrFunctionCallFailed{Original: errInvalidEvent}
+		}
+	}
+
+	client := http.Client{Timeout: k.timeout()}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	defer resp.Body.Close()
+
+	reply, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	if err := function.ErrFromStatusCode(resp.StatusCode); err != nil {
+		return nil, err
+	}
+
+	return replyEvent(resp.Header, reply)
+}
+
+// replyEvent converts reply event sent in HTTP binary content mode to CloudEvents v0.1 JSON. "ce-" headers are mapped
+// back to attributes and extensions, the body becomes event data. Body of responses without "ce-specversion" header
+// is returned as is.
+func replyEvent(header http.Header, body []byte) ([]byte, error) {
+	if header.Get("ce-specversion") == "" {
+		return body, nil
+	}
+
+	event := map[string]interface{}{"cloudEventsVersion": "0.1"}
+	extensions := map[string]interface{}{}
+	for name := range header {
+		attribute := strings.ToLower(name)
+		if !strings.HasPrefix(attribute, "ce-") {
+			continue
+		}
+		attribute = strings.TrimPrefix(attribute, "ce-")
+		value := header.Get(name)
+
+		switch attribute {
+		case "specversion":
+		case "eventtypeversion":
+			event["eventTypeVersion"] = value
+		default:
+			if name, ok := replyAttributes[attribute]; ok {
+				event[name] = value
+			} else {
+				extensions[attribute] = value
+			}
+		}
+	}
+	if len(extensions) > 0 {
+		event["extensions"] = extensions
+	}
+
+	contentType := header.Get("Content-Type")
+	if contentType != "" {
+		event["contentType"] = contentType
+	}
+	if len(body) > 0 {
+		event["data"] = decodeData(body, contentType)
+	}
+
+	reply, err := json.Marshal(event)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	return reply, nil
+}
+
+// encodeData returns encoded event data and its content type. JSON data is sent as is, strings are sent raw except for
+// binary content types, which Event Gateway encodes with base64.
+func encodeData(value interface{}, contentType string) ([]byte, string, error) {
+	if value == nil {
+		return nil, contentType, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	str, isString := value.(string)
+	switch {
+	case !isString || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		body, err := json.Marshal(value)
+		if err != nil {
+			return nil, "", err
+		}
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		return body, contentType, nil
+	case strings.HasPrefix(mediaType, "text/") || strings.HasPrefix(mediaType, "multipart/") ||
+		mediaType == "application/x-www-form-urlencoded":
+		return []byte(str), contentType, nil
+	default:
+		body, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return []byte(str), contentType, nil
+		}
+		return body, contentType, nil
+	}
+}
+
+// decodeData returns event data from the reply body. It reverses encodeData: JSON is kept as is, text is returned as a
+// string and binary data is base64 encoded.
+func decodeData(body []byte, contentType string) interface{} {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case (mediaType == "" || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")) && json.Valid(body):
+		return json.RawMessage(body)
+	case mediaType == "" || strings.HasPrefix(mediaType, "text/") || strings.HasPrefix(mediaType, "multipart/") ||
+		mediaType == "application/x-www-form-urlencoded":
+		return string(body)
+	default:
+		return base64.StdEncoding.EncodeToString(body)
+	}
+}
+
+// attributeName converts extension name to a valid CloudEvents v1.0 attribute name. Attribute names consist of lower
+// case letters and digits only. It returns empty string if the name cannot be converted.
+func attributeName(name string) string {
+	converted := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return -1
+		}
+	}, name)
+	if len(converted) > maxAttributeNameLength {
+		return ""
+	}
+	if _, reserved := reservedAttributes[converted]; reserved {
+		return ""
+	}
+	return converted
+}
+
+var reservedAttributes = map[string]struct{}{
+	"":                {},
+	"specversion":     {},
+	"id":              {},
+	"source":          {},
+	"type":            {},
+	"time":            {},
+	"dataschema":      {},
+	"datacontenttype": {},
+	"subject":         {},
+	"data":            {},
+}
+
+// attributeValue returns header value of the extension. Values other than strings are JSON encoded.
+func attributeValue(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+func (k Knative) timeout() time.Duration {
+	if k.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(k.Timeout) * time.Millisecond
+}
+
+// validate provider config.
+func (k Knative) validate() error {
+	validate := validator.New()
+	err := validate.Struct(k)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (k Knative) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("url", k.URL)
+	if k.Timeout != 0 {
+		enc.AddInt("timeout", k.Timeout)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &Knative{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for Knative function")
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,217 @@
+package knative_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/knative"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := knative.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	for _, testCase := range callTests {
+		var headers http.Header
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers = r.Header
+			body, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+
+		_, err := load(t, `{"url": "`+server.URL+`/default/default"}`).Call([]byte(testCase.event))
+		server.Close()
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedBody, body)
+		for name, value := range testCase.expectedHeaders {
+			assert.Equal(t, value, headers.Get(name), name)
+		}
+	}
+}
+
+func TestCallReply(t *testing.T) {
+	for _, testCase := range replyTests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for name, value := range testCase.headers {
+				w.Header().Set(name, value)
+			}
+			w.Write(testCase.body)
+		}))
+
+		output, err := load(t, `{"url": "`+server.URL+`"}`).Call([]byte(jsonEvent))
+		server.Close()
+
+		assert.Nil(t, err)
+		assert.JSONEq(t, testCase.expectedOutput, string(output))
+	}
+}
+
+func TestCallFailed(t *testing.T) {
+	status := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	provider := load(t, `{"url": "`+server.URL+`"}`)
+
+	_, err := provider.Call([]byte(jsonEvent))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 500")}, err)
+
+	status = http.StatusBadRequest
+	_, err = provider.Call([]byte(jsonEvent))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("HTTP status code: 400")}, err)
+
+	_, err = provider.Call([]byte(`{"eventType":"user.created","data":{}}`))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("payload is not a CloudEvent")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+
+	knative.Knative{URL: "http://hello.default.svc.cluster.local", Timeout: 1000}.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{"url": "http://hello.default.svc.cluster.local", "timeout": 1000}, enc.Fields)
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := knative.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+const jsonEvent = `{"eventType":"user.created","eventTypeVersion":"1","cloudEventsVersion":"0.1","source":"/users",` +
+	`"eventID":"1","eventTime":"2018-05-01T10:00:00Z","schemaURL":"https://example.com/schema",` +
+	`"contentType":"application/json","extensions":{"tenant-id":"acme","priority":2,"id":"x"},"data":{"userId":7}}`
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"url": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"url": ""}`,
+		errors.New("missing required fields for Knative function"),
+	},
+	{
+		`{"url": "http://hello.default.svc.cluster.local", "timeout": -1}`,
+		errors.New("missing required fields for Knative function"),
+	},
+	{
+		`{"url": "http://hello.default.svc.cluster.local", "timeout": 1000}`,
+		nil,
+	},
+}
+
+var callTests = []struct {
+	event           string
+	expectedBody    []byte
+	expectedHeaders map[string]string
+}{
+	{
+		jsonEvent,
+		[]byte(`{"userId":7}`),
+		map[string]string{
+			"Content-Type":        "application/json",
+			"ce-specversion":      "1.0",
+			"ce-id":               "1",
+			"ce-source":           "/users",
+			"ce-type":             "user.created",
+			"ce-time":             "2018-05-01T10:00:00Z",
+			"ce-dataschema":       "https://example.com/schema",
+			"ce-eventtypeversion": "1",
+			"ce-tenantid":         "acme",
+			"ce-priority":         "2",
+		},
+	},
+	{
+		`{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+			`"contentType":"text/plain","data":"hello"}`,
+		[]byte("hello"),
+		map[string]string{
+			"Content-Type": "text/plain",
+			"ce-type":      "user.created",
+		},
+	},
+	{
+		`{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+			`"contentType":"application/octet-stream","data":"aGVsbG8="}`,
+		[]byte("hello"),
+		map[string]string{
+			"Content-Type": "application/octet-stream",
+		},
+	},
+	{
+		`{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+			`"data":{"userId":7}}`,
+		[]byte(`{"userId":7}`),
+		map[string]string{
+			"Content-Type": "application/json",
+		},
+	},
+}
+
+var replyTests = []struct {
+	headers        map[string]string
+	body           []byte
+	expectedOutput string
+}{
+	{
+		map[string]string{
+			"ce-specversion": "1.0",
+			"ce-id":          "2",
+			"ce-source":      "/welcome",
+			"ce-type":        "user.welcomed",
+			"ce-time":        "2018-04-05T17:31:00Z",
+			"ce-tenant":      "acme",
+			"Content-Type":   "application/json",
+		},
+		[]byte(`{"userId":7}`),
+		`{"cloudEventsVersion":"0.1","eventID":"2","source":"/welcome","eventType":"user.welcomed",
+			"eventTime":"2018-04-05T17:31:00Z","contentType":"application/json","extensions":{"tenant":"acme"},
+			"data":{"userId":7}}`,
+	},
+	{
+		map[string]string{
+			"ce-specversion": "1.0",
+			"ce-id":          "2",
+			"ce-source":      "/welcome",
+			"ce-type":        "user.welcomed",
+			"Content-Type":   "application/octet-stream",
+		},
+		[]byte("binary"),
+		`{"cloudEventsVersion":"0.1","eventID":"2","source":"/welcome","eventType":"user.welcomed",
+			"contentType":"application/octet-stream","data":"YmluYXJ5"}`,
+	},
+	{
+		map[string]string{"Content-Type": "application/cloudevents+json"},
+		[]byte(`{"specversion":"1.0","id":"2","source":"/welcome","type":"user.welcomed"}`),
+		`{"specversion":"1.0","id":"2","source":"/welcome","type":"user.welcomed"}`,
+	},
+	{
+		map[string]string{"Content-Type": "application/json"},
+		[]byte(`{"status":"ok"}`),
+		`{"status":"ok"}`,
+	},
+}
@@ -0,0 +1,124 @@
+package mqtt
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/satori/go.uuid"
+)
+
+const (
+	connectTimeout = 5 * time.Second
+	// connectionIdleTimeout is a time after which unused connection is closed. Connections of removed or updated
+	// functions are not used anymore so they are closed once they are idle for this long.
+	connectionIdleTimeout = 5 * time.Minute
+	reapInterval          = time.Minute
+)
+
+var errConnectTimeout = errors.New("connecting to MQTT broker timed out")
+
+type connectionKey struct {
+	broker   string
+	clientID string
+	username string
+	password string
+}
+
+// connection is a MQTT client shared by all functions publishing to the same broker with the same credentials. It
+// connects on first publish and reconnects automatically.
+type connection struct {
+	sync.Mutex
+	client paho.Client
+
+	// calls and lastUsed are guarded by the pool lock.
+	calls    int
+	lastUsed time.Time
+}
+
+func (c *connection) connect() error {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.client.IsConnected() {
+		return nil
+	}
+
+	token := c.client.Connect()
+	if !token.WaitTimeout(connectTimeout) {
+		return errConnectTimeout
+	}
+	return token.Error()
+}
+
+type connectionPool struct {
+	sync.Mutex
+	connections map[connectionKey]*connection
+	reaping     sync.Once
+}
+
+// connections is a pool of clients reused across function config updates. Connections idle for longer than
+// connectionIdleTimeout are closed and removed from the pool.
+var connections = &connectionPool{connections: map[connectionKey]*connection{}}
+
+// acquire returns connection for the key. Connection is not closed until it's released.
+func (p *connectionPool) acquire(key connectionKey) *connection {
+	p.Lock()
+	defer p.Unlock()
+
+	conn, ok := p.connections[key]
+	if !ok {
+		clientID := key.clientID
+		if clientID == "" {
+			clientID = "event-gateway-" + uuid.NewV4().String()
+		}
+		options := paho.NewClientOptions().
+			AddBroker(key.broker).
+			SetClientID(clientID).
+			SetUsername(key.username).
+			SetPassword(key.password).
+			SetConnectTimeout(connectTimeout).
+			SetAutoReconnect(true)
+
+		conn = &connection{client: paho.NewClient(options)}
+		p.connections[key] = conn
+		p.reaping.Do(func() {
+			go p.reap(reapInterval, connectionIdleTimeout)
+		})
+	}
+	conn.calls++
+	return conn
+}
+
+func (p *connectionPool) release(conn *connection) {
+	p.Lock()
+	defer p.Unlock()
+
+	conn.calls--
+	conn.lastUsed = time.Now()
+}
+
+// reap closes idle connections every interval.
+func (p *connectionPool) reap(interval, idleTimeout time.Duration) {
+	for range time.Tick(interval) {
+		p.closeIdle(idleTimeout)
+	}
+}
+
+// closeIdle closes and removes connections not used for longer than idleTimeout. Connections in use are not affected.
+func (p *connectionPool) closeIdle(idleTimeout time.Duration) {
+	p.Lock()
+	idle := []*connection{}
+	for key, conn := range p.connections {
+		if conn.calls == 0 && time.Since(conn.lastUsed) > idleTimeout {
+			idle = append(idle, conn)
+			delete(p.connections, key)
+		}
+	}
+	p.Unlock()
+
+	for _, conn := range idle {
+		conn.client.Disconnect(250)
+	}
+}
@@ -0,0 +1,55 @@
+package mqtt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectionPool(t *testing.T) {
+	t.Run("connection shared by the same key", func(t *testing.T) {
+		pool := &connectionPool{connections: map[connectionKey]*connection{}}
+		key := connectionKey{broker: "tcp://localhost:1883", clientID: "eg"}
+
+		first := pool.acquire(key)
+		second := pool.acquire(key)
+
+		assert.True(t, first == second)
+		assert.Equal(t, 2, first.calls)
+	})
+
+	t.Run("idle connection removed", func(t *testing.T) {
+		pool := &connectionPool{connections: map[connectionKey]*connection{}}
+		key := connectionKey{broker: "tcp://localhost:1883"}
+		conn := pool.acquire(key)
+		pool.release(conn)
+		conn.lastUsed = time.Now().Add(-time.Hour)
+
+		pool.closeIdle(time.Minute)
+
+		assert.Empty(t, pool.connections)
+		assert.False(t, pool.acquire(key) == conn)
+	})
+
+	t.Run("connection in use not removed", func(t *testing.T) {
+		pool := &connectionPool{connections: map[connectionKey]*connection{}}
+		key := connectionKey{broker: "tcp://localhost:1883"}
+		conn := pool.acquire(key)
+		conn.lastUsed = time.Now().Add(-time.Hour)
+
+		pool.closeIdle(time.Minute)
+
+		assert.True(t, pool.acquire(key) == conn)
+	})
+
+	t.Run("recently used connection not removed", func(t *testing.T) {
+		pool := &connectionPool{connections: map[connectionKey]*connection{}}
+		key := connectionKey{broker: "tcp://localhost:1883"}
+		pool.release(pool.acquire(key))
+
+		pool.closeIdle(time.Minute)
+
+		assert.Len(t, pool.connections, 1)
+	})
+}
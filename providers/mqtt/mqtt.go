@@ -0,0 +1,117 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("mqtt")
+
+const publishTimeout = 5 * time.Second
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// MQTT function implementation. Event is published to the topic.
+type MQTT struct {
+	// Broker is a broker URL e.g. "tcp://localhost:1883", "ssl://localhost:8883" or "ws://localhost:8080".
+	Broker   string `json:"broker" validate:"required,url"`
+	Topic    string `json:"topic" validate:"required"`
+	QoS      byte   `json:"qos,omitempty" validate:"max=2"`
+	Retain   bool   `json:"retain,omitempty"`
+	ClientID string `json:"clientId,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// Call publishes payload to MQTT topic.
+func (m MQTT) Call(payload []byte) ([]byte, error) {
+	conn := connections.acquire(connectionKey{
+		broker:   m.Broker,
+		clientID: m.ClientID,
+		username: m.Username,
+		password: m.Password,
+	})
+	defer connections.release(conn)
+
+	err := conn.connect()
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	token := conn.client.Publish(m.Topic, m.QoS, m.Retain, payload)
+	if !token.WaitTimeout(publishTimeout) {
+		return nil, &function.ErrFunctionCallFailed{Original: errPublishTimeout}
+	}
+	if token.Error() != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: token.Error()}
+	}
+
+	return []byte{}, nil
+}
+
+var (
+	errWildcardTopic  = errors.New("topic cannot contain wildcards")
+	errPublishTimeout = errors.New("publishing to MQTT broker timed out")
+)
+
+// validate provider config.
+func (m MQTT) validate() error {
+	validate := validator.New()
+	err := validate.Struct(m)
+	if err != nil {
+		return err
+	}
+	if strings.ContainsAny(m.Topic, "+#") {
+		return errWildcardTopic
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (m MQTT) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("broker", m.Broker)
+	enc.AddString("topic", m.Topic)
+	enc.AddUint8("qos", m.QoS)
+	enc.AddBool("retain", m.Retain)
+	if m.ClientID != "" {
+		enc.AddString("clientId", m.ClientID)
+	}
+	if m.Username != "" {
+		enc.AddString("username", m.Username)
+	}
+	if m.Password != "" {
+		enc.AddString("password", "*****")
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &MQTT{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err == errWildcardTopic {
+		return nil, errors.New("MQTT topic cannot contain wildcards")
+	}
+	if err != nil {
+		return nil, errors.New("missing required fields for MQTT function")
+	}
+
+	return provider, nil
+}
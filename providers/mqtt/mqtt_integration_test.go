@@ -0,0 +1,102 @@
+// +build integration
+
+package mqtt_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/mqtt"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCallBroker publishes to MQTT broker (e.g. Mosquitto) running on MQTT_URL, by default tcp://localhost:1883.
+func TestCallBroker(t *testing.T) {
+	url := os.Getenv("MQTT_URL")
+	if url == "" {
+		url = "tcp://localhost:1883"
+	}
+	messages := subscribe(t, url, "users/#")
+
+	t.Run("event published to topic", func(t *testing.T) {
+		provider, _ := mqtt.ProviderLoader{}.Load([]byte(`{"broker": "` + url + `", "topic": "users/created", "qos": 1}`))
+
+		output, err := provider.Call([]byte("testpayload"))
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{}, output)
+		message := receive(t, messages)
+		assert.Equal(t, "users/created", message.Topic())
+		assert.Equal(t, []byte("testpayload"), message.Payload())
+		assert.Equal(t, byte(1), message.Qos())
+	})
+
+	t.Run("retained event delivered to new subscribers", func(t *testing.T) {
+		provider, _ := mqtt.ProviderLoader{}.Load([]byte(`{"broker": "` + url + `", "topic": "users/retained", "retain": true}`))
+		_, err := provider.Call([]byte("retained"))
+		assert.Nil(t, err)
+		receive(t, messages)
+
+		message := receive(t, subscribe(t, url, "users/retained"))
+
+		assert.Equal(t, []byte("retained"), message.Payload())
+		assert.True(t, message.Retained())
+		// empty retained message removes the retained message from the broker
+		provider.Call([]byte{})
+		receive(t, messages)
+	})
+
+	t.Run("connection shared by functions", func(t *testing.T) {
+		first, _ := mqtt.ProviderLoader{}.Load([]byte(`{"broker": "` + url + `", "topic": "users/created", "clientId": "shared"}`))
+		second, _ := mqtt.ProviderLoader{}.Load([]byte(`{"broker": "` + url + `", "topic": "users/deleted", "qos": 2, "clientId": "shared"}`))
+
+		_, err := first.Call([]byte("first"))
+		assert.Nil(t, err)
+		_, err = second.Call([]byte("second"))
+		assert.Nil(t, err)
+
+		assert.Equal(t, "users/created", receive(t, messages).Topic())
+		message := receive(t, messages)
+		assert.Equal(t, "users/deleted", message.Topic())
+		assert.Equal(t, byte(2), message.Qos())
+	})
+
+	t.Run("error if broker unavailable", func(t *testing.T) {
+		provider, _ := mqtt.ProviderLoader{}.Load([]byte(`{"broker": "tcp://127.0.0.1:1", "topic": "users/created"}`))
+
+		_, err := provider.Call([]byte("testpayload"))
+
+		assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+	})
+}
+
+// subscribe connects new client to the broker and returns channel of messages received on the topic filter.
+func subscribe(t *testing.T, url, filter string) <-chan paho.Message {
+	client := paho.NewClient(paho.NewClientOptions().AddBroker(url))
+	token := client.Connect()
+	if token.WaitTimeout(5*time.Second) && token.Error() != nil {
+		t.Fatal(token.Error())
+	}
+
+	messages := make(chan paho.Message, 10)
+	token = client.Subscribe(filter, 2, func(client paho.Client, message paho.Message) {
+		messages <- message
+	})
+	if token.WaitTimeout(5*time.Second) && token.Error() != nil {
+		t.Fatal(token.Error())
+	}
+	return messages
+}
+
+func receive(t *testing.T, messages <-chan paho.Message) paho.Message {
+	select {
+	case message := <-messages:
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not published")
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package mqtt_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/mqtt"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := mqtt.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"broker": "", "topic": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"broker": "", "topic": "users/created"}`,
+		errors.New("missing required fields for MQTT function"),
+	},
+	{
+		`{"broker": "tcp://localhost:1883", "topic": ""}`,
+		errors.New("missing required fields for MQTT function"),
+	},
+	{
+		`{"broker": "tcp://localhost:1883", "topic": "users/created", "qos": 3}`,
+		errors.New("missing required fields for MQTT function"),
+	},
+	{
+		`{"broker": "tcp://localhost:1883", "topic": "users/+"}`,
+		errors.New("MQTT topic cannot contain wildcards"),
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		mqtt.MQTT{
+			Broker: "tcp://localhost:1883",
+			Topic:  "users/created",
+			QoS:    1,
+		},
+		map[string]interface{}{
+			"broker": "tcp://localhost:1883",
+			"topic":  "users/created",
+			"qos":    uint8(1),
+			"retain": false,
+		},
+	},
+	{
+		mqtt.MQTT{
+			Broker:   "tcp://localhost:1883",
+			Topic:    "users/created",
+			Retain:   true,
+			ClientID: "eg",
+			Username: "user",
+			Password: "secret",
+		},
+		map[string]interface{}{
+			"broker":   "tcp://localhost:1883",
+			"topic":    "users/created",
+			"qos":      uint8(0),
+			"retain":   true,
+			"clientId": "eg",
+			"username": "user",
+			"password": "*****",
+		},
+	},
+}
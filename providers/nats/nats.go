@@ -0,0 +1,300 @@
+package nats
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("nats")
+
+const (
+	// ModePublish publishes event to the subject without waiting for any acknowledgement.
+	ModePublish = "publish"
+	// ModeJetStream publishes event to the JetStream stream and waits for the stream to ack it.
+	ModeJetStream = "jetstream"
+	// ModeRequest sends event as a request and returns data of the reply as function response.
+	ModeRequest = "request"
+)
+
+const (
+	connectTimeout = 5 * time.Second
+	defaultTimeout = 5 * time.Second
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// NATS function implementation.
+type NATS struct {
+	// URL is a comma separated list of server URLs e.g. "nats://localhost:4222".
+	URL string `json:"url" validate:"required"`
+	// Subject is a text/template rendered with event attributes e.g. "events.{{.eventType}}" or
+	// "{{.extensions.tenant}}.events".
+	Subject string `json:"subject" validate:"required"`
+	Mode    string `json:"mode,omitempty" validate:"omitempty,eq=publish|eq=jetstream|eq=request"`
+	// Stream is a name of JetStream stream that is expected to store the event.
+	Stream string `json:"stream,omitempty"`
+	// AckWait is a time (in milliseconds) to wait for JetStream ack.
+	AckWait int `json:"ackWait,omitempty" validate:"min=0"`
+	// Timeout is a time (in milliseconds) to wait for a reply in request mode.
+	Timeout  int    `json:"timeout,omitempty" validate:"min=0"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
+
+	subject *template.Template
+	conn    *connection
+}
+
+var errInvalidSubject = errors.New("subject cannot be empty or contain wildcards or whitespaces")
+
+// Call publishes payload to NATS subject.
+func (n NATS) Call(payload []byte) ([]byte, error) {
+	attributes := map[string]interface{}{}
+	// payload that is not a CloudEvent can be only published to a subject that doesn't refer to event attributes
+	json.Unmarshal(payload, &attributes)
+
+	subject, err := n.renderSubject(attributes)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	conn, err := n.conn.connect()
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	switch n.Mode {
+	case ModeJetStream:
+		return n.publishToStream(subject, attributes, payload)
+	case ModeRequest:
+		reply, err := conn.Request(subject, payload, duration(n.Timeout))
+		if err != nil {
+			return nil, &function.ErrFunctionCallFailed{Original: err}
+		}
+		return reply.Data, nil
+	default:
+		err = conn.Publish(subject, payload)
+		if err != nil {
+			return nil, &function.ErrFunctionCallFailed{Original: err}
+		}
+		return []byte{}, nil
+	}
+}
+
+// publishToStream publishes payload to JetStream. Event ID is used as a message ID so JetStream can discard
+// duplicates. It returns JetStream ack.
+func (n NATS) publishToStream(subject string, attributes map[string]interface{}, payload []byte) ([]byte, error) {
+	js, err := n.conn.jetStream()
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	options := []natsgo.PubOpt{natsgo.AckWait(duration(n.AckWait))}
+	if n.Stream != "" {
+		options = append(options, natsgo.ExpectStream(n.Stream))
+	}
+	if eventID, ok := attributes["eventID"].(string); ok && eventID != "" {
+		options = append(options, natsgo.MsgId(eventID))
+	}
+
+	ack, err := js.Publish(subject, payload, options...)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	return json.Marshal(ack)
+}
+
+func (n NATS) renderSubject(attributes map[string]interface{}) (string, error) {
+	tmpl := n.subject
+	if tmpl == nil {
+		var err error
+		tmpl, err = parseSubject(n.Subject)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var subject bytes.Buffer
+	err := tmpl.Execute(&subject, attributes)
+	if err != nil {
+		return "", err
+	}
+	if subject.Len() == 0 || strings.ContainsAny(subject.String(), "*> \t\r\n") {
+		return "", errInvalidSubject
+	}
+	return subject.String(), nil
+}
+
+func parseSubject(subject string) (*template.Template, error) {
+	return template.New("subject").Option("missingkey=error").Parse(subject)
+}
+
+func duration(milliseconds int) time.Duration {
+	if milliseconds == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(milliseconds) * time.Millisecond
+}
+
+// validate provider config.
+func (n NATS) validate() error {
+	validate := validator.New()
+	err := validate.Struct(n)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (n NATS) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("url", n.URL)
+	enc.AddString("subject", n.Subject)
+	if n.Mode != "" {
+		enc.AddString("mode", n.Mode)
+	}
+	if n.Stream != "" {
+		enc.AddString("stream", n.Stream)
+	}
+	if n.AckWait != 0 {
+		enc.AddInt("ackWait", n.AckWait)
+	}
+	if n.Timeout != 0 {
+		enc.AddInt("timeout", n.Timeout)
+	}
+	if n.Username != "" {
+		enc.AddString("username", n.Username)
+	}
+	if n.Password != "" {
+		enc.AddString("password", "*****")
+	}
+	if n.Token != "" {
+		enc.AddString("token", "*****")
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &NATS{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for NATS function")
+	}
+
+	provider.subject, err = parseSubject(provider.Subject)
+	if err != nil {
+		return nil, errors.New("invalid subject template for NATS function: " + err.Error())
+	}
+
+	provider.conn = connections.get(connectionKey{
+		url:      provider.URL,
+		username: provider.Username,
+		password: provider.Password,
+		token:    provider.Token,
+	})
+	return provider, nil
+}
+
+type connectionKey struct {
+	url      string
+	username string
+	password string
+	token    string
+}
+
+// connection is a NATS connection shared by all functions connecting to the same servers with the same credentials.
+// It connects on first call. Once connected, the client reconnects automatically.
+type connection struct {
+	sync.Mutex
+	key connectionKey
+	nc  *natsgo.Conn
+	js  natsgo.JetStreamContext
+}
+
+func (c *connection) connect() (*natsgo.Conn, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.nc != nil && !c.nc.IsClosed() {
+		return c.nc, nil
+	}
+
+	options := []natsgo.Option{
+		natsgo.Name("event-gateway"),
+		natsgo.Timeout(connectTimeout),
+		natsgo.MaxReconnects(-1),
+	}
+	if c.key.username != "" {
+		options = append(options, natsgo.UserInfo(c.key.username, c.key.password))
+	}
+	if c.key.token != "" {
+		options = append(options, natsgo.Token(c.key.token))
+	}
+
+	nc, err := natsgo.Connect(c.key.url, options...)
+	if err != nil {
+		return nil, err
+	}
+	c.nc = nc
+	c.js = nil
+	return nc, nil
+}
+
+func (c *connection) jetStream() (natsgo.JetStreamContext, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.js != nil {
+		return c.js, nil
+	}
+
+	js, err := c.nc.JetStream()
+	if err != nil {
+		return nil, err
+	}
+	c.js = js
+	return js, nil
+}
+
+type connectionPool struct {
+	sync.Mutex
+	connections map[connectionKey]*connection
+}
+
+// connections is a pool of connections reused across function config updates.
+var connections = &connectionPool{connections: map[connectionKey]*connection{}}
+
+func (p *connectionPool) get(key connectionKey) *connection {
+	p.Lock()
+	defer p.Unlock()
+
+	if conn, ok := p.connections[key]; ok {
+		return conn
+	}
+
+	conn := &connection{key: key}
+	p.connections[key] = conn
+	return conn
+}
@@ -0,0 +1,137 @@
+// +build integration
+
+package nats_test
+
+import (
+	"os"
+	"testing"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/serverless/event-gateway/function"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests run against NATS servers (2.2 or newer) running on NATS_URL, by default nats://localhost:4222, with JetStream
+// enabled and on NATS_NO_JETSTREAM_URL, by default nats://localhost:4223, without JetStream.
+
+func TestCallPublish(t *testing.T) {
+	url := serverURL("NATS_URL", "nats://localhost:4222")
+	messages := subscribe(t, url, "acme.>")
+	provider := load(t, `{"url": "`+url+`", "subject": "{{.extensions.tenant}}.{{.eventType}}"}`)
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{}, output)
+	msg := <-messages
+	assert.Equal(t, "acme.user.created", msg.Subject)
+	assert.Equal(t, []byte(event), msg.Data)
+}
+
+func TestCallJetStream(t *testing.T) {
+	url := serverURL("NATS_URL", "nats://localhost:4222")
+	js := jetStream(t, url)
+	_, err := js.AddStream(&natsgo.StreamConfig{Name: "USERS", Subjects: []string{"users.user.created"}})
+	assert.Nil(t, err)
+	defer js.DeleteStream("USERS")
+	provider := load(t, `{"url": "`+url+`", "subject": "users.{{.eventType}}", "mode": "jetstream",
+		"stream": "USERS", "ackWait": 1000}`)
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"stream":"USERS","seq":1}`), output)
+	msg, err := js.GetMsg("USERS", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "users.user.created", msg.Subject)
+	assert.Equal(t, []byte(event), msg.Data)
+	assert.Equal(t, "1", msg.Header.Get("Nats-Msg-Id"))
+
+	output, err = provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"stream":"USERS","seq":1,"duplicate":true}`), output)
+	info, err := js.StreamInfo("USERS")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), info.State.Msgs)
+}
+
+func TestCallJetStreamUnexpectedStream(t *testing.T) {
+	url := serverURL("NATS_URL", "nats://localhost:4222")
+	js := jetStream(t, url)
+	_, err := js.AddStream(&natsgo.StreamConfig{Name: "USERS", Subjects: []string{"users.>"}})
+	assert.Nil(t, err)
+	defer js.DeleteStream("USERS")
+	provider := load(t, `{"url": "`+url+`", "subject": "users.{{.eventType}}", "mode": "jetstream",
+		"stream": "ORDERS"}`)
+
+	_, err = provider.Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+	assert.Contains(t, err.Error(), "expected stream does not match")
+}
+
+func TestCallJetStreamNotEnabled(t *testing.T) {
+	url := serverURL("NATS_NO_JETSTREAM_URL", "nats://localhost:4223")
+	provider := load(t, `{"url": "`+url+`", "subject": "users", "mode": "jetstream"}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: natsgo.ErrJetStreamNotEnabled}, err)
+}
+
+func TestCallRequest(t *testing.T) {
+	url := serverURL("NATS_URL", "nats://localhost:4222")
+	responder, err := natsgo.Connect(url)
+	assert.Nil(t, err)
+	defer responder.Close()
+	responder.QueueSubscribe("users.>", "responders", func(msg *natsgo.Msg) {
+		msg.Respond([]byte(`{"statusCode":201,"body":"created"}`))
+	})
+	responder.Flush()
+	provider := load(t, `{"url": "`+url+`", "subject": "users.{{.eventType}}", "mode": "request"}`)
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"statusCode":201,"body":"created"}`), output)
+}
+
+func TestCallRequestNoResponders(t *testing.T) {
+	url := serverURL("NATS_URL", "nats://localhost:4222")
+	provider := load(t, `{"url": "`+url+`", "subject": "orders", "mode": "request", "timeout": 1000}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: natsgo.ErrNoResponders}, err)
+}
+
+func serverURL(env, defaultURL string) string {
+	url := os.Getenv(env)
+	if url == "" {
+		url = defaultURL
+	}
+	return url
+}
+
+func subscribe(t *testing.T, url, subject string) <-chan *natsgo.Msg {
+	nc, err := natsgo.Connect(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := make(chan *natsgo.Msg, 10)
+	_, err = nc.ChanSubscribe(subject, messages)
+	assert.Nil(t, err)
+	nc.Flush()
+	return messages
+}
+
+func jetStream(t *testing.T, url string) natsgo.JetStreamContext {
+	nc, err := natsgo.Connect(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	js, err := nc.JetStream()
+	assert.Nil(t, err)
+	return js
+}
@@ -0,0 +1,135 @@
+package nats_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/nats"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := nats.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestLoadInvalidSubject(t *testing.T) {
+	_, err := nats.ProviderLoader{}.Load([]byte(`{"url": "nats://localhost:4222", "subject": "{{.eventType"}`))
+
+	assert.Contains(t, err.Error(), "invalid subject template for NATS function: ")
+}
+
+func TestCallInvalidSubject(t *testing.T) {
+	for _, subject := range []string{"{{.extensions.missing}}", "{{.source}}.*"} {
+		provider := load(t, `{"url": "nats://127.0.0.1:1", "subject": "`+subject+`"}`)
+
+		_, err := provider.Call([]byte(event))
+
+		assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+	}
+}
+
+func TestCallServerUnavailable(t *testing.T) {
+	provider := load(t, `{"url": "nats://127.0.0.1:1", "subject": "users"}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := nats.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+const event = `{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+	`"extensions":{"tenant":"acme"},"data":{"userId":7}}`
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"url": "nats://localhost:4222", "subject": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"url": "", "subject": "users"}`,
+		errors.New("missing required fields for NATS function"),
+	},
+	{
+		`{"url": "nats://localhost:4222", "subject": ""}`,
+		errors.New("missing required fields for NATS function"),
+	},
+	{
+		`{"url": "nats://localhost:4222", "subject": "users", "mode": "stream"}`,
+		errors.New("missing required fields for NATS function"),
+	},
+	{
+		`{"url": "nats://localhost:4222", "subject": "users", "mode": "jetstream", "ackWait": -1}`,
+		errors.New("missing required fields for NATS function"),
+	},
+	{
+		`{"url": "nats://localhost:4222", "subject": "users.{{.eventType}}", "mode": "request", "timeout": 1000}`,
+		nil,
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		nats.NATS{
+			URL:     "nats://localhost:4222",
+			Subject: "users",
+		},
+		map[string]interface{}{
+			"url":     "nats://localhost:4222",
+			"subject": "users",
+		},
+	},
+	{
+		nats.NATS{
+			URL:      "nats://localhost:4222",
+			Subject:  "users",
+			Mode:     "jetstream",
+			Stream:   "USERS",
+			AckWait:  1000,
+			Timeout:  2000,
+			Username: "user",
+			Password: "pass",
+			Token:    "token",
+		},
+		map[string]interface{}{
+			"url":      "nats://localhost:4222",
+			"subject":  "users",
+			"mode":     "jetstream",
+			"stream":   "USERS",
+			"ackWait":  1000,
+			"timeout":  2000,
+			"username": "user",
+			"password": "*****",
+			"token":    "*****",
+		},
+	},
+}
@@ -0,0 +1,206 @@
+package openwhisk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("openwhisk")
+
+const (
+	defaultNamespace = "_"
+	defaultTimeout   = 5 * time.Second
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// OpenWhisk function implementation. Action is invoked with OpenWhisk REST API. Event is passed as action parameters.
+type OpenWhisk struct {
+	// APIHost is a URL of OpenWhisk API e.g. "https://openwhisk.example.com".
+	APIHost string `json:"apiHost" validate:"required,url"`
+	// Namespace of the action. By default namespace of the auth key ("_") is used.
+	Namespace string `json:"namespace,omitempty"`
+	// Action name, optionally prefixed with package name e.g. "utils/echo".
+	Action string `json:"action" validate:"required"`
+	// AuthKey is a "<uuid>:<key>" API key.
+	AuthKey string `json:"authKey" validate:"required"`
+	// Blocking indicates if Call waits for action result. Non-blocking invocation returns activation ID.
+	Blocking bool `json:"blocking,omitempty"`
+	// Timeout is a time (in milliseconds) to wait for the API response.
+	Timeout int `json:"timeout,omitempty" validate:"min=0"`
+}
+
+var (
+	errInvalidAuthKey   = errors.New("auth key has to be in <uuid>:<key> format")
+	errInvalidNamespace = errors.New("namespace cannot contain \"/\"")
+	errInvalidAction    = errors.New("action has to be in <action> or <package>/<action> format")
+)
+
+// activation is a response of non-blocking invocation or blocking invocation that didn't finish in time.
+type activation struct {
+	ActivationID string `json:"activationId"`
+}
+
+// actionError is a result of failed blocking invocation.
+type actionError struct {
+	Error interface{} `json:"error"`
+}
+
+// Call invokes OpenWhisk action. Blocking invocation returns action result, non-blocking returns activation ID.
+func (o OpenWhisk) Call(payload []byte) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, o.invokeURL(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	credentials := strings.SplitN(o.AuthKey, ":", 2)
+	req.SetBasicAuth(credentials[0], credentials[1])
+
+	client := http.Client{Timeout: o.timeout()}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK && o.Blocking:
+		return body, nil
+	case resp.StatusCode == http.StatusAccepted:
+		result := activation{}
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, &function.ErrFunctionCallFailed{Original: err}
+		}
+		if o.Blocking {
+			// blocking invocation didn't finish before OpenWhisk blocking timeout
+			return nil, &function.ErrFunctionCallFailed{
+				Original: fmt.Errorf("action didn't complete, activation ID: %s", result.ActivationID),
+			}
+		}
+		return []byte(result.ActivationID), nil
+	case resp.StatusCode == http.StatusBadGateway:
+		// action completed with application or developer error
+		result := actionError{}
+		json.Unmarshal(body, &result)
+		if result.Error != nil {
+			return nil, &function.ErrFunctionError{Original: fmt.Errorf("action error: %v", result.Error)}
+		}
+		return nil, &function.ErrFunctionError{Original: fmt.Errorf("HTTP status code: %d", resp.StatusCode)}
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return nil, &function.ErrFunctionAccessDenied{Original: fmt.Errorf("HTTP status code: %d", resp.StatusCode)}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return nil, &function.ErrFunctionProviderError{Original: fmt.Errorf("HTTP status code: %d", resp.StatusCode)}
+	default:
+		return nil, &function.ErrFunctionCallFailed{Original: fmt.Errorf("HTTP status code: %d", resp.StatusCode)}
+	}
+}
+
+// invokeURL returns URL of the action invoke endpoint.
+func (o OpenWhisk) invokeURL() string {
+	namespace := o.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	action := []string{}
+	for _, segment := range strings.Split(o.Action, "/") {
+		action = append(action, url.PathEscape(segment))
+	}
+
+	query := url.Values{}
+	query.Set("blocking", strconv.FormatBool(o.Blocking))
+	query.Set("result", strconv.FormatBool(o.Blocking))
+
+	return strings.TrimSuffix(o.APIHost, "/") + "/api/v1/namespaces/" + url.PathEscape(namespace) + "/actions/" +
+		strings.Join(action, "/") + "?" + query.Encode()
+}
+
+func (o OpenWhisk) timeout() time.Duration {
+	if o.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(o.Timeout) * time.Millisecond
+}
+
+// validate provider config.
+func (o OpenWhisk) validate() error {
+	validate := validator.New()
+	err := validate.Struct(o)
+	if err != nil {
+		return err
+	}
+	credentials := strings.SplitN(o.AuthKey, ":", 2)
+	if len(credentials) != 2 || credentials[0] == "" || credentials[1] == "" {
+		return errInvalidAuthKey
+	}
+	if strings.Contains(o.Namespace, "/") {
+		return errInvalidNamespace
+	}
+	segments := strings.Split(o.Action, "/")
+	if len(segments) > 2 {
+		return errInvalidAction
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return errInvalidAction
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (o OpenWhisk) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("apiHost", o.APIHost)
+	if o.Namespace != "" {
+		enc.AddString("namespace", o.Namespace)
+	}
+	enc.AddString("action", o.Action)
+	if o.AuthKey != "" {
+		enc.AddString("authKey", "*****")
+	}
+	enc.AddBool("blocking", o.Blocking)
+	if o.Timeout != 0 {
+		enc.AddInt("timeout", o.Timeout)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &OpenWhisk{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for OpenWhisk function")
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,166 @@
+package openwhisk_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/openwhisk"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := openwhisk.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCallBlocking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, _ := r.BasicAuth()
+		if user != "uuid" || password != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "/api/v1/namespaces/guest/actions/utils/echo", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("blocking"))
+		assert.Equal(t, "true", r.URL.Query().Get("result"))
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	output, err := load(t, `{"apiHost": "`+server.URL+`", "namespace": "guest", "action": "utils/echo",
+		"authKey": "uuid:key", "blocking": true}`).Call([]byte(`{"eventType":"user.created"}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"eventType":"user.created"}`), output)
+
+	_, err = load(t, `{"apiHost": "`+server.URL+`", "action": "utils/echo", "authKey": "uuid:wrong",
+		"blocking": true}`).Call([]byte(`{}`))
+
+	assert.Equal(t, &function.ErrFunctionAccessDenied{Original: errors.New("HTTP status code: 401")}, err)
+}
+
+func TestCallNonBlocking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/namespaces/_/actions/echo", r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("blocking"))
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"activationId":"a1b2c3"}`))
+	}))
+	defer server.Close()
+
+	output, err := load(t, `{"apiHost": "`+server.URL+`", "action": "echo", "authKey": "uuid:key"}`).
+		Call([]byte(`{}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("a1b2c3"), output)
+}
+
+func TestCallFailed(t *testing.T) {
+	for _, testCase := range callFailedTests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(testCase.status)
+			w.Write([]byte(testCase.body))
+		}))
+
+		_, err := load(t, `{"apiHost": "`+server.URL+`", "action": "echo", "authKey": "uuid:key",
+			"blocking": true}`).Call([]byte(`{}`))
+		server.Close()
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+
+	openwhisk.OpenWhisk{
+		APIHost:   "https://openwhisk.example.com",
+		Namespace: "guest",
+		Action:    "echo",
+		AuthKey:   "uuid:key",
+		Blocking:  true,
+	}.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{
+		"apiHost":   "https://openwhisk.example.com",
+		"namespace": "guest",
+		"action":    "echo",
+		"authKey":   "*****",
+		"blocking":  true,
+	}, enc.Fields)
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := openwhisk.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"apiHost": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"apiHost": "https://openwhisk.example.com", "authKey": "uuid:key"}`,
+		errors.New("missing required fields for OpenWhisk function"),
+	},
+	{
+		`{"apiHost": "https://openwhisk.example.com", "action": "echo", "authKey": "key"}`,
+		errors.New("missing required fields for OpenWhisk function"),
+	},
+	{
+		`{"apiHost": "https://openwhisk.example.com", "namespace": "a/b", "action": "echo", "authKey": "uuid:key"}`,
+		errors.New("missing required fields for OpenWhisk function"),
+	},
+	{
+		`{"apiHost": "https://openwhisk.example.com", "action": "a/b/c", "authKey": "uuid:key"}`,
+		errors.New("missing required fields for OpenWhisk function"),
+	},
+	{
+		`{"apiHost": "https://openwhisk.example.com", "action": "utils/echo", "authKey": "uuid:key", "timeout": 1000}`,
+		nil,
+	},
+}
+
+var callFailedTests = []struct {
+	status        int
+	body          string
+	expectedError error
+}{
+	{
+		http.StatusBadGateway,
+		`{"error":"boom"}`,
+		&function.ErrFunctionError{Original: errors.New("action error: boom")},
+	},
+	{
+		http.StatusAccepted,
+		`{"activationId":"a1b2c3"}`,
+		&function.ErrFunctionCallFailed{Original: errors.New("action didn't complete, activation ID: a1b2c3")},
+	},
+	{
+		http.StatusServiceUnavailable,
+		``,
+		&function.ErrFunctionProviderError{Original: errors.New("HTTP status code: 503")},
+	},
+	{
+		http.StatusNotFound,
+		`{"error":"The requested resource does not exist."}`,
+		&function.ErrFunctionCallFailed{Original: errors.New("HTTP status code: 404")},
+	},
+}
@@ -0,0 +1,260 @@
+package redisstreams
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("redisstreams")
+
+const (
+	dialTimeout  = 5 * time.Second
+	writeTimeout = 5 * time.Second
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// wholeEvent is a field mapping path that refers to the whole event.
+const wholeEvent = "."
+
+// RedisStreams function implementation. Event is appended to the stream with XADD command.
+type RedisStreams struct {
+	Client redis.Cmdable `json:"-" validate:"-"`
+
+	// Address is a Redis server address e.g. "localhost:6379".
+	Address  string `json:"address" validate:"required"`
+	DB       int    `json:"db,omitempty" validate:"min=0"`
+	Password string `json:"password,omitempty"`
+	TLS      *TLS   `json:"tls,omitempty"`
+	Stream   string `json:"stream" validate:"required"`
+	// MaxLen trims the stream to given number of entries. 0 means no trimming.
+	MaxLen int64 `json:"maxLen,omitempty" validate:"min=0"`
+	// ApproximateMaxLen enables trimming with "~" which is more efficient but keeps a few entries over MaxLen.
+	ApproximateMaxLen bool `json:"approximateMaxLen,omitempty"`
+	// Fields maps entry field names to event attribute paths e.g. {"type": "eventType", "tenant": "extensions.tenant"}.
+	// "." refers to the whole event. By default the whole event is stored in "event" field.
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// TLS config. Certificates and key are PEM encoded.
+type TLS struct {
+	CACert             string `json:"caCert,omitempty"`
+	ClientCert         string `json:"clientCert,omitempty"`
+	ClientKey          string `json:"clientKey,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+var (
+	errNoFields      = errors.New("event has none of the mapped attributes")
+	errInvalidFields = errors.New("field name and attribute path cannot be empty")
+)
+
+// Call appends payload to Redis stream. It returns ID of the added entry.
+func (r RedisStreams) Call(payload []byte) ([]byte, error) {
+	values, err := r.values(payload)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	args := &redis.XAddArgs{
+		Stream: r.Stream,
+		Values: values,
+	}
+	if r.ApproximateMaxLen {
+		args.MaxLenApprox = r.MaxLen
+	} else {
+		args.MaxLen = r.MaxLen
+	}
+
+	id, err := r.Client.XAdd(args).Result()
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: err}
+	}
+
+	return []byte(id), nil
+}
+
+// values maps event attributes to entry fields. String values are stored as is, other values are JSON encoded.
+// Attributes missing in the event are skipped.
+func (r RedisStreams) values(payload []byte) (map[string]interface{}, error) {
+	fields := r.Fields
+	if len(fields) == 0 {
+		fields = map[string]string{"event": wholeEvent}
+	}
+
+	var event interface{}
+	// payload that is not JSON can be stored only as the whole event
+	json.Unmarshal(payload, &event)
+
+	values := map[string]interface{}{}
+	for field, path := range fields {
+		if path == wholeEvent {
+			values[field] = string(payload)
+			continue
+		}
+
+		value, ok := eventpkg.Lookup(event, path)
+		if !ok {
+			continue
+		}
+		if str, ok := value.(string); ok {
+			values[field] = str
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		values[field] = string(encoded)
+	}
+
+	if len(values) == 0 {
+		return nil, errNoFields
+	}
+	return values, nil
+}
+
+// validate provider config.
+func (r RedisStreams) validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	for field, path := range r.Fields {
+		if field == "" || path == "" {
+			return errInvalidFields
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (r RedisStreams) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("address", r.Address)
+	enc.AddInt("db", r.DB)
+	if r.Password != "" {
+		enc.AddString("password", "*****")
+	}
+	if r.TLS != nil {
+		enc.AddBool("tls", true)
+		if r.TLS.ClientKey != "" {
+			enc.AddString("tlsClientKey", "*****")
+		}
+	}
+	enc.AddString("stream", r.Stream)
+	if r.MaxLen != 0 {
+		enc.AddInt64("maxLen", r.MaxLen)
+		enc.AddBool("approximateMaxLen", r.ApproximateMaxLen)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &RedisStreams{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for Redis Streams function")
+	}
+
+	key := clientKey{address: provider.Address, db: provider.DB, password: provider.Password}
+	if provider.TLS != nil {
+		key.tls = *provider.TLS
+		key.tlsEnabled = true
+	}
+	client, err := clients.get(key)
+	if err != nil {
+		return nil, errors.New("invalid TLS config for Redis Streams function: " + err.Error())
+	}
+
+	provider.Client = client
+	return provider, nil
+}
+
+type clientKey struct {
+	address    string
+	db         int
+	password   string
+	tlsEnabled bool
+	tls        TLS
+}
+
+type clientPool struct {
+	sync.Mutex
+	clients map[clientKey]*redis.Client
+}
+
+// clients is a pool of Redis clients reused across function config updates. Every client maintains its own pool of
+// connections.
+var clients = &clientPool{clients: map[clientKey]*redis.Client{}}
+
+func (p *clientPool) get(key clientKey) (*redis.Client, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if client, ok := p.clients[key]; ok {
+		return client, nil
+	}
+
+	options := &redis.Options{
+		Addr:         key.address,
+		DB:           key.db,
+		Password:     key.password,
+		DialTimeout:  dialTimeout,
+		WriteTimeout: writeTimeout,
+	}
+	if key.tlsEnabled {
+		config, err := tlsConfig(key.tls)
+		if err != nil {
+			return nil, err
+		}
+		options.TLSConfig = config
+	}
+
+	client := redis.NewClient(options)
+	p.clients[key] = client
+	return client, nil
+}
+
+var errInvalidCACert = errors.New("unable to parse CA certificate")
+
+func tlsConfig(config TLS) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+	if config.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+			return nil, errInvalidCACert
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.ClientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(config.ClientCert), []byte(config.ClientKey))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
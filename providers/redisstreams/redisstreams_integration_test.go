@@ -0,0 +1,107 @@
+// +build integration
+
+package redisstreams_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/serverless/event-gateway/function"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests run against Redis servers (5.0 or newer) running on REDIS_ADDRESS, by default localhost:6379, and on
+// REDIS_AUTH_ADDRESS, by default localhost:6380, requiring "secret" password.
+
+func TestCall(t *testing.T) {
+	address := serverAddress("REDIS_ADDRESS", "localhost:6379")
+	client := connect(t, address, 0, "", "events")
+	provider := load(t, `{"address": "`+address+`", "stream": "events"}`)
+
+	output, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	entries := stream(t, client, "events")
+	assert.Len(t, entries, 1)
+	assert.Equal(t, entries[0].ID, string(output))
+	assert.Equal(t, map[string]interface{}{"event": event}, entries[0].Values)
+}
+
+func TestCallFieldMapping(t *testing.T) {
+	address := serverAddress("REDIS_ADDRESS", "localhost:6379")
+	client := connect(t, address, 0, "", "events")
+	provider := load(t, `{"address": "`+address+`", "stream": "events", "fields": {
+		"type": "eventType", "tenant": "extensions.tenant", "data": "data", "missing": "extensions.missing",
+		"event": "."}}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"type":   "user.created",
+		"tenant": "acme",
+		"data":   `{"userId":7}`,
+		"event":  event,
+	}, stream(t, client, "events")[0].Values)
+}
+
+func TestCallMaxLen(t *testing.T) {
+	address := serverAddress("REDIS_ADDRESS", "localhost:6379")
+	client := connect(t, address, 0, "", "exact", "approximate")
+	for _, config := range []string{
+		`{"address": "` + address + `", "stream": "exact", "maxLen": 2}`,
+		`{"address": "` + address + `", "stream": "approximate", "maxLen": 2, "approximateMaxLen": true}`,
+	} {
+		provider := load(t, config)
+
+		for i := 0; i < 3; i++ {
+			_, err := provider.Call([]byte(event))
+			assert.Nil(t, err)
+		}
+	}
+
+	assert.Len(t, stream(t, client, "exact"), 2)
+	// Redis trims approximately capped streams by whole nodes only, so the small stream is kept as it is.
+	assert.Len(t, stream(t, client, "approximate"), 3)
+}
+
+func TestCallDBAndPassword(t *testing.T) {
+	address := serverAddress("REDIS_AUTH_ADDRESS", "localhost:6380")
+	client := connect(t, address, 3, "secret", "events")
+	provider := load(t, `{"address": "`+address+`", "db": 3, "password": "secret", "stream": "events"}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Nil(t, err)
+	assert.Len(t, stream(t, client, "events"), 1)
+
+	provider = load(t, `{"address": "`+address+`", "db": 3, "password": "wrong", "stream": "events"}`)
+
+	_, err = provider.Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func serverAddress(env, defaultAddress string) string {
+	address := os.Getenv(env)
+	if address == "" {
+		address = defaultAddress
+	}
+	return address
+}
+
+// connect returns a client of the server database and removes given streams left by previous runs.
+func connect(t *testing.T, address string, db int, password string, streams ...string) *redis.Client {
+	client := redis.NewClient(&redis.Options{Addr: address, DB: db, Password: password})
+	if err := client.Del(streams...).Err(); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func stream(t *testing.T, client *redis.Client, key string) []redis.XMessage {
+	entries, err := client.XRange(key, "-", "+").Result()
+	assert.Nil(t, err)
+	return entries
+}
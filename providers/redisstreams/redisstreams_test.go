@@ -0,0 +1,129 @@
+package redisstreams_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/redisstreams"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := redisstreams.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCallNoMappedAttributes(t *testing.T) {
+	provider := load(t, `{"address": "127.0.0.1:1", "stream": "events", "fields": {"tenant": "extensions.missing"}}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("event has none of the mapped attributes")}, err)
+}
+
+func TestCallServerUnavailable(t *testing.T) {
+	provider := load(t, `{"address": "127.0.0.1:1", "stream": "events"}`)
+
+	_, err := provider.Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionCallFailed{}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	for _, testCase := range logTests {
+		enc := zapcore.NewMapObjectEncoder()
+
+		testCase.provider.MarshalLogObject(enc)
+
+		assert.Equal(t, testCase.expectedFields, enc.Fields)
+	}
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := redisstreams.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+const event = `{"eventType":"user.created","cloudEventsVersion":"0.1","source":"/users","eventID":"1",` +
+	`"extensions":{"tenant":"acme"},"data":{"userId":7}}`
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"address": "localhost:6379", "stream": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"address": "", "stream": "events"}`,
+		errors.New("missing required fields for Redis Streams function"),
+	},
+	{
+		`{"address": "localhost:6379", "stream": ""}`,
+		errors.New("missing required fields for Redis Streams function"),
+	},
+	{
+		`{"address": "localhost:6379", "stream": "events", "maxLen": -1}`,
+		errors.New("missing required fields for Redis Streams function"),
+	},
+	{
+		`{"address": "localhost:6379", "stream": "events", "fields": {"type": ""}}`,
+		errors.New("missing required fields for Redis Streams function"),
+	},
+	{
+		`{"address": "localhost:6379", "stream": "events", "tls": {"caCert": "invalid"}}`,
+		errors.New("invalid TLS config for Redis Streams function: unable to parse CA certificate"),
+	},
+	{
+		`{"address": "localhost:6379", "db": 1, "stream": "events", "maxLen": 1000, "tls": {}}`,
+		nil,
+	},
+}
+
+var logTests = []struct {
+	provider       function.Provider
+	expectedFields map[string]interface{}
+}{
+	{
+		redisstreams.RedisStreams{
+			Address: "localhost:6379",
+			Stream:  "events",
+		},
+		map[string]interface{}{
+			"address": "localhost:6379",
+			"db":      0,
+			"stream":  "events",
+		},
+	},
+	{
+		redisstreams.RedisStreams{
+			Address:           "localhost:6379",
+			DB:                2,
+			Password:          "secret",
+			TLS:               &redisstreams.TLS{ClientCert: "cert", ClientKey: "key"},
+			Stream:            "events",
+			MaxLen:            1000,
+			ApproximateMaxLen: true,
+		},
+		map[string]interface{}{
+			"address":           "localhost:6379",
+			"db":                2,
+			"password":          "*****",
+			"tls":               true,
+			"tlsClientKey":      "*****",
+			"stream":            "events",
+			"maxLen":            int64(1000),
+			"approximateMaxLen": true,
+		},
+	},
+}
@@ -0,0 +1,182 @@
+package script
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/serverless/event-gateway/function"
+	starlarkjson "go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"go.uber.org/zap/zapcore"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// Type of provider.
+const Type = function.ProviderType("script")
+
+const (
+	filename        = "script.star"
+	defaultHandler  = "handler"
+	defaultTimeout  = time.Second
+	defaultMaxSteps = 1000000
+)
+
+func init() {
+	function.RegisterProvider(Type, ProviderLoader{})
+}
+
+// Script function implementation. Function is a Starlark (https://github.com/bazelbuild/starlark) script executed
+// in-process. Script defines a handler function that is called with the event and returns function response. Scripts
+// are sandboxed, they cannot access files, network or load other modules.
+type Script struct {
+	// Source of the script e.g. "def handler(event):\n  return {\"statusCode\": 200, \"body\": event[\"eventID\"]}".
+	Source string `json:"source" validate:"required"`
+	// Handler is a name of the function called with the event. By default "handler".
+	Handler string `json:"handler,omitempty"`
+	// Timeout is a maximum time (in milliseconds) of script execution. At most 30 seconds.
+	Timeout int `json:"timeout,omitempty" validate:"min=0,max=30000"`
+	// MaxSteps is a maximum number of Starlark computation steps. It limits CPU used by a single call. At most
+	// 100000000.
+	//
+	// Memory used by a call is not limited. Starlark only rejects a single string or list repetition larger than 1 GiB,
+	// so allocation is bounded by the number of steps rather than by a fixed size.
+	MaxSteps uint64 `json:"maxSteps,omitempty" validate:"max=100000000"`
+
+	program *starlark.Program
+}
+
+var (
+	errInvalidEvent   = errors.New("event is not a valid JSON")
+	errMissingHandler = errors.New("script doesn't define handler function")
+)
+
+// predeclared contains modules available to scripts.
+var predeclared = starlark.StringDict{
+	"json": starlarkjson.Module,
+}
+
+// Call executes the script and calls the handler with the event decoded from JSON. String returned by the handler is
+// used as function response as it is, other values are JSON encoded e.g. HTTP response object for sync subscriptions.
+func (s Script) Call(payload []byte) ([]byte, error) {
+	thread := &starlark.Thread{
+		Name: "event-gateway",
+		// print output is discarded
+		Print: func(*starlark.Thread, string) {},
+	}
+	thread.SetMaxExecutionSteps(s.maxSteps())
+	timer := time.AfterFunc(s.timeout(), func() { thread.Cancel("timeout") })
+	defer timer.Stop()
+
+	decode := starlarkjson.Module.Members["decode"]
+	event, err := starlark.Call(thread, decode, starlark.Tuple{starlark.String(payload)}, nil)
+	if err != nil {
+		return nil, &function.ErrFunctionCallFailed{Original: errInvalidEvent}
+	}
+
+	globals, err := s.program.Init(thread, predeclared)
+	if err != nil {
+		return nil, &function.ErrFunctionError{Original: err}
+	}
+	handler, ok := globals[s.handler()].(starlark.Callable)
+	if !ok {
+		return nil, &function.ErrFunctionError{Original: errMissingHandler}
+	}
+
+	result, err := starlark.Call(thread, handler, starlark.Tuple{event}, nil)
+	if err != nil {
+		return nil, &function.ErrFunctionError{Original: err}
+	}
+
+	switch result := result.(type) {
+	case starlark.NoneType:
+		return []byte{}, nil
+	case starlark.String:
+		return []byte(result.GoString()), nil
+	default:
+		encode := starlarkjson.Module.Members["encode"]
+		encoded, err := starlark.Call(thread, encode, starlark.Tuple{result}, nil)
+		if err != nil {
+			return nil, &function.ErrFunctionError{Original: err}
+		}
+		return []byte(encoded.(starlark.String).GoString()), nil
+	}
+}
+
+func (s Script) handler() string {
+	if s.Handler == "" {
+		return defaultHandler
+	}
+	return s.Handler
+}
+
+func (s Script) timeout() time.Duration {
+	if s.Timeout == 0 {
+		return defaultTimeout
+	}
+	return time.Duration(s.Timeout) * time.Millisecond
+}
+
+func (s Script) maxSteps() uint64 {
+	if s.MaxSteps == 0 {
+		return defaultMaxSteps
+	}
+	return s.MaxSteps
+}
+
+// compile parses and resolves the script.
+func (s *Script) compile() error {
+	_, program, err := starlark.SourceProgram(filename, s.Source, predeclared.Has)
+	if err != nil {
+		return err
+	}
+	s.program = program
+	return nil
+}
+
+// validate provider config.
+func (s Script) validate() error {
+	validate := validator.New()
+	err := validate.Struct(s)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface.
+func (s Script) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt("sourceLength", len(s.Source))
+	enc.AddString("handler", s.handler())
+	if s.Timeout != 0 {
+		enc.AddInt("timeout", s.Timeout)
+	}
+	if s.MaxSteps != 0 {
+		enc.AddUint64("maxSteps", s.MaxSteps)
+	}
+	return nil
+}
+
+// ProviderLoader implementation
+type ProviderLoader struct{}
+
+// Load decode JSON data as Config and return initialized Provider instance.
+func (p ProviderLoader) Load(data []byte) (function.Provider, error) {
+	provider := &Script{}
+	err := json.Unmarshal(data, provider)
+	if err != nil {
+		return nil, errors.New("unable to load function provider config: " + err.Error())
+	}
+
+	err = provider.validate()
+	if err != nil {
+		return nil, errors.New("missing required fields for script function")
+	}
+
+	err = provider.compile()
+	if err != nil {
+		return nil, errors.New("invalid script for script function: " + err.Error())
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,150 @@
+package script_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/providers/script"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoad(t *testing.T) {
+	for _, testCase := range loadTests {
+		config := []byte(testCase.config)
+		loader := script.ProviderLoader{}
+
+		_, err := loader.Load(config)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestCall(t *testing.T) {
+	for _, testCase := range callTests {
+		output, err := load(t, testCase.config).Call([]byte(event))
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedOutput, string(output))
+	}
+}
+
+func TestCallFunctionError(t *testing.T) {
+	_, err := load(t, `{"source": "def handler(event):\n  return event[\"missing\"]"}`).Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionError{}, err)
+	assert.Contains(t, err.Error(), `key "missing" not in dict`)
+
+	_, err = load(t, `{"source": "def transform(event):\n  return event"}`).Call([]byte(event))
+
+	assert.Equal(t, &function.ErrFunctionError{Original: errors.New("script doesn't define handler function")}, err)
+}
+
+func TestCallLimits(t *testing.T) {
+	loop := `{"source": "def handler(event):\n  n = 0\n  for i in range(100000000):\n    n += i\n  return n"`
+
+	_, err := load(t, loop+`, "maxSteps": 1000}`).Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionError{}, err)
+	assert.Contains(t, err.Error(), "too many steps")
+
+	_, err = load(t, loop+`, "maxSteps": 100000000, "timeout": 10}`).Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionError{}, err)
+	assert.Contains(t, err.Error(), "timeout")
+
+	_, err = load(t, `{"source": "def handler(event):\n  return \"x\" * 2000000000"}`).Call([]byte(event))
+
+	assert.IsType(t, &function.ErrFunctionError{}, err)
+	assert.Contains(t, err.Error(), "excessive repeat")
+}
+
+func TestCallInvalidEvent(t *testing.T) {
+	_, err := load(t, `{"source": "def handler(event):\n  return event"}`).Call([]byte("not json"))
+
+	assert.Equal(t, &function.ErrFunctionCallFailed{Original: errors.New("event is not a valid JSON")}, err)
+}
+
+func TestMarshalLogObject(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+
+	script.Script{Source: "def handler(event):\n  return event", Timeout: 100, MaxSteps: 1000}.MarshalLogObject(enc)
+
+	assert.Equal(t, map[string]interface{}{
+		"sourceLength": 34,
+		"handler":      "handler",
+		"timeout":      100,
+		"maxSteps":     uint64(1000),
+	}, enc.Fields)
+}
+
+func load(t *testing.T, config string) function.Provider {
+	provider, err := script.ProviderLoader{}.Load([]byte(config))
+	assert.Nil(t, err)
+	return provider
+}
+
+const event = `{"eventType":"http.request","eventID":"1","source":"/users",` +
+	`"data":{"path":"/users","body":{"name":"John"}}}`
+
+var loadTests = []struct {
+	config        string
+	expectedError error
+}{
+	{
+		`{"source": `,
+		errors.New("unable to load function provider config: unexpected end of JSON input"),
+	},
+	{
+		`{"source": ""}`,
+		errors.New("missing required fields for script function"),
+	},
+	{
+		`{"source": "def handler(event):\n  return event", "timeout": -1}`,
+		errors.New("missing required fields for script function"),
+	},
+	{
+		`{"source": "def handler(event):\n  return event", "timeout": 30001}`,
+		errors.New("missing required fields for script function"),
+	},
+	{
+		`{"source": "def handler(event):\n  return event", "maxSteps": 100000001}`,
+		errors.New("missing required fields for script function"),
+	},
+	{
+		`{"source": "def handler(event)\n  return event"}`,
+		errors.New("invalid script for script function: script.star:2:1: got newline, want ':'"),
+	},
+	{
+		`{"source": "def handler(event):\n  return undefined"}`,
+		errors.New("invalid script for script function: script.star:2:10: undefined: undefined"),
+	},
+	{
+		`{"source": "def transform(event):\n  return event", "handler": "transform", "timeout": 100, "maxSteps": 1000}`,
+		nil,
+	},
+}
+
+var callTests = []struct {
+	config         string
+	expectedOutput string
+}{
+	{
+		`{"source": "def handler(event):\n  return {\"statusCode\": 201, \"headers\": {\"X-Id\": event[\"eventID\"]},` +
+			` \"body\": \"Hello \" + event[\"data\"][\"body\"][\"name\"]}"}`,
+		`{"body":"Hello John","headers":{"X-Id":"1"},"statusCode":201}`,
+	},
+	{
+		`{"source": "def transform(event):\n  return event[\"source\"]", "handler": "transform"}`,
+		`/users`,
+	},
+	{
+		`{"source": "def handler(event):\n  return json.encode({\"type\": event[\"eventType\"]})"}`,
+		`{"type":"http.request"}`,
+	},
+	{
+		`{"source": "def handler(event):\n  print(event)"}`,
+		``,
+	},
+}
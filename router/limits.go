@@ -0,0 +1,249 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/httpapi"
+	"github.com/serverless/event-gateway/internal/blob"
+)
+
+// Limits restricts size of events accepted by the router.
+type Limits struct {
+	// MaxBodySize is a maximum size (in bytes) of request body. 0 means no limit.
+	MaxBodySize int64
+	// SpaceMaxBodySize overrides MaxBodySize for events delivered to functions in a given space. It can only lower
+	// the global limit. Body is read up to the largest limit of spaces subscribed on the request path.
+	SpaceMaxBodySize map[string]int64
+	// SpaceMaxHeaderSize limits size (in bytes) of request line and headers of events delivered to functions in
+	// a given space. Global limit is set on the HTTP server.
+	SpaceMaxHeaderSize map[string]int64
+	// SpaceReadTimeout limits duration of reading request body of events delivered to functions in a given space. It
+	// can only shorten the read timeout of the HTTP server. Body is read up to the longest timeout of spaces subscribed
+	// on the request path.
+	SpaceReadTimeout map[string]time.Duration
+	// ClaimCheckThreshold is a payload size (in bytes) above which event payload is offloaded to the BlobStore and
+	// the event carries only a reference to the blob. 0 disables offloading.
+	ClaimCheckThreshold int64
+	BlobStore           blob.Store
+}
+
+// ClaimCheck is a reference to the event payload offloaded to the blob store. It replaces event data.
+type ClaimCheck struct {
+	BlobID      blob.ID `json:"blobId"`
+	Size        int64   `json:"size"`
+	ContentType string  `json:"contentType,omitempty"`
+}
+
+// SetLimits configures event size limits.
+func (router *Router) SetLimits(limits Limits) {
+	router.limits = limits
+}
+
+var (
+	// errBodyTooLarge is returned while reading request body larger than the limit.
+	errBodyTooLarge = errors.New("http: request body too large")
+	// errHeaderTooLarge is returned if request headers are larger than the limit of the space.
+	errHeaderTooLarge = errors.New("http: request header too large")
+	// errReadTimeout is returned if reading request body takes longer than the read timeout.
+	errReadTimeout = errors.New("http: request body read timeout")
+)
+
+// checkSpaceLimits returns HTTP status code and error if the request exceeds limits configured for the space. Body
+// read time is known only for requests received by ServeHTTP.
+func (router *Router) checkSpaceLimits(space string, size int64, r *http.Request) (int, error) {
+	if limit, ok := router.limits.SpaceMaxBodySize[space]; ok && limit > 0 && size > limit {
+		return http.StatusRequestEntityTooLarge, errBodyTooLarge
+	}
+	if limit, ok := router.limits.SpaceMaxHeaderSize[space]; ok && limit > 0 && headerSize(r) > limit {
+		return http.StatusRequestHeaderFieldsTooLarge, errHeaderTooLarge
+	}
+	if timeout, ok := router.limits.SpaceReadTimeout[space]; ok && timeout > 0 {
+		if body, ok := r.Body.(*countingReader); ok && body.readTime > timeout {
+			return http.StatusRequestTimeout, errReadTimeout
+		}
+	}
+	return 0, nil
+}
+
+// bodyLimit returns maximum size of request body accepted on the path. If all spaces subscribed on the path have
+// a limit configured, the largest of them lowers the global limit so too large body is rejected while reading.
+// Limits of individual spaces are checked once the subscribers are known.
+func (router *Router) bodyLimit(method, path string) int64 {
+	limit := router.limits.MaxBodySize
+	if len(router.limits.SpaceMaxBodySize) == 0 {
+		return limit
+	}
+	spacesLimit := router.spacesLimit(method, path, func(space string) int64 {
+		return router.limits.SpaceMaxBodySize[space]
+	})
+	if spacesLimit > 0 && (limit <= 0 || spacesLimit < limit) {
+		return spacesLimit
+	}
+	return limit
+}
+
+// readTimeout returns maximum duration of reading request body on the path. It's the longest read timeout of spaces
+// subscribed on the path or 0 if any of them has no timeout configured.
+func (router *Router) readTimeout(method, path string) time.Duration {
+	if len(router.limits.SpaceReadTimeout) == 0 {
+		return 0
+	}
+	return time.Duration(router.spacesLimit(method, path, func(space string) int64 {
+		return int64(router.limits.SpaceReadTimeout[space])
+	}))
+}
+
+// spacesLimit returns the largest limit of spaces subscribed on the path. It returns 0 if there are no spaces
+// subscribed on the path or any of them has no limit configured.
+func (router *Router) spacesLimit(method, path string, spaceLimit func(space string) int64) int64 {
+	spaces := router.targetCache.Spaces(method, path)
+	largest := int64(0)
+	for _, space := range spaces {
+		limit := spaceLimit(space)
+		if limit <= 0 {
+			return 0
+		}
+		if limit > largest {
+			largest = limit
+		}
+	}
+	return largest
+}
+
+// headerSize returns size of the request line and headers the way they are counted by HTTP server.
+func headerSize(r *http.Request) int64 {
+	size := len(r.Method) + len(r.RequestURI) + len(r.Proto) + 4
+	size += len("Host: ") + len(r.Host) + 2
+	for name, values := range r.Header {
+		for _, value := range values {
+			size += len(name) + len(": ") + len(value) + 2
+		}
+	}
+	return int64(size)
+}
+
+// limitBody wraps request body so it cannot exceed limit and its size and read time can be checked later. Reading
+// the body fails if it's not read before the timeout.
+func (router *Router) limitBody(r *http.Request, limit int64, timeout time.Duration) *countingReader {
+	if r.Body == nil {
+		return &countingReader{}
+	}
+	if timeout > 0 {
+		httpapi.ShortenReadDeadline(r, time.Now().Add(timeout))
+	}
+
+	body := r.Body
+	if limit > 0 {
+		body = &limitedReader{ReadCloser: body, remaining: limit}
+	}
+	counter := &countingReader{ReadCloser: body, started: time.Now()}
+	r.Body = counter
+	return counter
+}
+
+var errBlobStoreNotConfigured = errors.New("blob store not configured")
+
+// claimCheck offloads event data to the blob store of the space if body size exceeds claim check threshold. Only
+// the body is offloaded from HTTP request events so the function still receives the request metadata. It has to be
+// called after the schema validation as the validation requires the original payload.
+func (router *Router) claimCheck(space string, event *eventpkg.Event, size int64) error {
+	if router.limits.ClaimCheckThreshold <= 0 || size <= router.limits.ClaimCheckThreshold {
+		return nil
+	}
+	if router.limits.BlobStore == nil {
+		return errBlobStoreNotConfigured
+	}
+
+	if httpRequestData, ok := event.Data.(*eventpkg.HTTPRequestData); ok {
+		check, err := router.offload(space, httpRequestData.Body, httpRequestData.Headers["Content-Type"])
+		if err != nil {
+			return err
+		}
+
+		data := *httpRequestData
+		data.Body = check
+		event.Data = &data
+		return nil
+	}
+
+	check, err := router.offload(space, event.Data, event.ContentType)
+	if err != nil {
+		return err
+	}
+
+	event.Data = check
+	event.ContentType = mimeJSON
+	return nil
+}
+
+// offload stores payload in the blob store and returns reference to it.
+func (router *Router) offload(space string, data interface{}, contentType string) (ClaimCheck, error) {
+	payload, ok := data.([]byte)
+	if !ok {
+		var err error
+		payload, err = json.Marshal(data)
+		if err != nil {
+			return ClaimCheck{}, err
+		}
+	}
+
+	id, err := router.limits.BlobStore.Put(space, payload)
+	if err != nil {
+		return ClaimCheck{}, err
+	}
+
+	return ClaimCheck{BlobID: id, Size: int64(len(payload)), ContentType: contentType}, nil
+}
+
+// limitedReader returns errBodyTooLarge once more than remaining bytes are read from the underlying reader. Unlike
+// io.LimitedReader it fails instead of silently truncating the body.
+type limitedReader struct {
+	io.ReadCloser
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.exceeded {
+		return 0, errBodyTooLarge
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+
+	n, err := l.ReadCloser.Read(p)
+	if int64(n) <= l.remaining {
+		l.remaining -= int64(n)
+		return n, err
+	}
+
+	n = int(l.remaining)
+	l.remaining = 0
+	l.exceeded = true
+	return n, errBodyTooLarge
+}
+
+// countingReader counts bytes read from the underlying reader and time spent reading. Timeout errors are replaced with
+// errReadTimeout.
+type countingReader struct {
+	io.ReadCloser
+	size     int64
+	started  time.Time
+	readTime time.Duration
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.size += int64(n)
+	c.readTime = time.Since(c.started)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		err = errReadTimeout
+	}
+	return n, err
+}
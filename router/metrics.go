@@ -11,9 +11,13 @@ func init() {
 	prometheus.MustRegister(metricEventsReceived)
 	prometheus.MustRegister(metricEventsProcessed)
 	prometheus.MustRegister(metricEventsDropped)
+	prometheus.MustRegister(metricEventsRouted)
 
 	prometheus.MustRegister(metricBacklog)
 	prometheus.MustRegister(metricProcessingDuration)
+
+	prometheus.MustRegister(metricSocketConnections)
+	prometheus.MustRegister(metricStreamConnections)
 }
 
 const customEventType = "custom"
@@ -42,6 +46,14 @@ var metricEventsDropped = prometheus.NewCounterVec(
 		Help:      "Total of events dropped due to insufficient processing power.",
 	}, []string{"space", "type"})
 
+var metricEventsRouted = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "eventgateway",
+		Subsystem: "events",
+		Name:      "routed_total",
+		Help:      "Total of events routed to subscribed functions, labelled by the function picked by traffic split.",
+	}, []string{"space", "function"})
+
 var metricBacklog = prometheus.NewGauge(
 	prometheus.GaugeOpts{
 		Namespace: "eventgateway",
@@ -60,6 +72,22 @@ var metricProcessingDuration = prometheus.NewHistogram(
 		Buckets: prometheus.ExponentialBuckets(0.00001, 2, 20),
 	})
 
+var metricSocketConnections = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "eventgateway",
+		Subsystem: "sockets",
+		Name:      "connections",
+		Help:      "Gauge of WebSocket clients connected to the node.",
+	}, []string{"space"})
+
+var metricStreamConnections = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "eventgateway",
+		Subsystem: "streams",
+		Name:      "connections",
+		Help:      "Gauge of Server-Sent Events clients connected to the node.",
+	}, []string{"space"})
+
 var receivedEventsMutex = sync.Mutex{}
 var receivedEvents = map[string]time.Time{}
 
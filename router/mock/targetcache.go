@@ -9,6 +9,7 @@ import (
 	event "github.com/serverless/event-gateway/event"
 	function "github.com/serverless/event-gateway/function"
 	router "github.com/serverless/event-gateway/router"
+	schema "github.com/serverless/event-gateway/schema"
 	cors "github.com/serverless/event-gateway/subscription/cors"
 	reflect "reflect"
 )
@@ -84,6 +85,30 @@ func (mr *MockTargeterMockRecorder) Function(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Function", reflect.TypeOf((*MockTargeter)(nil).Function), arg0, arg1)
 }
 
+// Schema mocks base method
+func (m *MockTargeter) Schema(arg0 string, arg1 schema.ID) *schema.Schema {
+	ret := m.ctrl.Call(m, "Schema", arg0, arg1)
+	ret0, _ := ret[0].(*schema.Schema)
+	return ret0
+}
+
+// Schema indicates an expected call of Schema
+func (mr *MockTargeterMockRecorder) Schema(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schema", reflect.TypeOf((*MockTargeter)(nil).Schema), arg0, arg1)
+}
+
+// Spaces mocks base method
+func (m *MockTargeter) Spaces(arg0, arg1 string) []string {
+	ret := m.ctrl.Call(m, "Spaces", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Spaces indicates an expected call of Spaces
+func (mr *MockTargeterMockRecorder) Spaces(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Spaces", reflect.TypeOf((*MockTargeter)(nil).Spaces), arg0, arg1)
+}
+
 // SyncSubscriber mocks base method
 func (m *MockTargeter) SyncSubscriber(arg0, arg1 string, arg2 event.TypeName) *router.SyncSubscriber {
 	ret := m.ctrl.Call(m, "SyncSubscriber", arg0, arg1, arg2)
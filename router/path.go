@@ -13,3 +13,9 @@ func systemPathFromSpace(space string) string {
 func systemPathFromURL(host, path string) string {
 	return basePath
 }
+
+// pathInSpace returns always true as spaces don't own paths outside of the hosted version. Events emitted on any path
+// are delivered to socket clients of every space that has the event type.
+func pathInSpace(path, space string) bool {
+	return true
+}
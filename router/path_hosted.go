@@ -34,3 +34,8 @@ func systemPathFromURL(host, path string) string {
 	}
 	return basePath
 }
+
+// pathInSpace checks if path on which the event was emitted belongs to the space.
+func pathInSpace(path, space string) bool {
+	return strings.HasPrefix(path, basePath+space+"/") || path == basePath+space
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/gorilla/websocket"
 	"github.com/jinzhu/copier"
 	"github.com/rs/cors"
 	"go.uber.org/zap"
@@ -35,6 +36,9 @@ type Router struct {
 	drainWaitGroup sync.WaitGroup
 	active         bool
 	backlog        chan backlogEvent
+	limits         Limits
+	streaming      Streaming
+	sockets        *sockets
 }
 
 // New instantiates a new Router
@@ -66,38 +70,47 @@ func (router *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
 		reqMethod = r.Header.Get("Access-Control-Request-Method")
 	}
+	if router.streaming.WebSocket && websocket.IsWebSocketUpgrade(r) {
+		if space, ok := socketSpace(r.URL.EscapedPath()); ok {
+			router.serveWebSocket(space, w, r)
+			return
+		}
+	}
+
 	path := extractPath(r.Host, r.URL.EscapedPath())
 
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		event, err := eventpkg.FromRequest(r)
-		if err != nil {
-			w.WriteHeader(http.StatusBadRequest)
+		limit := router.bodyLimit(r.Method, path)
+		if limit > 0 && r.ContentLength > limit {
 			w.Header().Set("Content-Type", "application/json")
-			encoder.Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: err.Error()}}})
-			return
-		}
-		if event.IsSystem() { // System event can only be emitted from inside EG
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			encoder.Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: errBodyTooLarge.Error()}}})
 			return
 		}
 
-		router.log.Debug("Event received.", zap.String("path", path), zap.Object("event", event))
-		err = router.emitSystemEventReceived(path, *event, r)
+		body := router.limitBody(r, limit, router.readTimeout(r.Method, path))
+		event, err := eventpkg.FromRequest(r)
+		httpapi.RestoreReadDeadline(r)
 		if err != nil {
-			router.log.Debug("Event processing stopped because sync plugin subscription returned an error.",
-				zap.Object("event", event),
-				zap.Error(err))
+			status := http.StatusBadRequest
+			if err == errBodyTooLarge {
+				status = http.StatusRequestEntityTooLarge
+			}
+			if err == errReadTimeout {
+				status = http.StatusRequestTimeout
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			encoder.Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: err.Error()}}})
 			return
 		}
 
-		syncSubscriber := router.targetCache.SyncSubscriber(r.Method, path, event.EventType)
-		if syncSubscriber != nil { // There is sync subscriber and possibly async subscribers also
-			router.handleSyncSubscription(path, *event, *syncSubscriber, w, r)
-		}
+		router.handleEvent(path, event, body.size, w, r)
+	}
 
-		router.handleAsyncSubscriptions(r.Method, path, *event, r)
-		if syncSubscriber == nil {
-			w.WriteHeader(http.StatusAccepted)
+	if space, ok := streamSpace(r.URL.EscapedPath()); ok && router.streaming.ServerSentEvents && r.Method == http.MethodGet {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			router.serveStream(space, w, r)
 		}
 	}
 
@@ -117,6 +130,58 @@ func (router *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Emit passes event received by other API than Events API through the same pipeline as events received by
+// ServeHTTP. The request carries path and metadata used for routing, authorization and system events. Response of
+// a sync subscriber is written to w.
+func (router *Router) Emit(event *eventpkg.Event, size int64, w http.ResponseWriter, r *http.Request) {
+	encoder := json.NewEncoder(w)
+
+	if router.isDraining() {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		encoder.Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: http.StatusText(http.StatusServiceUnavailable)}}})
+		return
+	}
+
+	if router.limits.MaxBodySize > 0 && size > router.limits.MaxBodySize {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		encoder.Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: errBodyTooLarge.Error()}}})
+		return
+	}
+
+	path := extractPath(r.Host, r.URL.EscapedPath())
+	router.handleEvent(path, event, size, w, r)
+}
+
+// handleEvent emits system event and routes event to sync and async subscribers.
+func (router *Router) handleEvent(path string, event *eventpkg.Event, size int64, w http.ResponseWriter, r *http.Request) {
+	if event.IsSystem() { // System event can only be emitted from inside EG
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	router.log.Debug("Event received.", zap.String("path", path), zap.Object("event", event))
+	err := router.emitSystemEventReceived(path, *event, r)
+	if err != nil {
+		router.log.Debug("Event processing stopped because sync plugin subscription returned an error.",
+			zap.Object("event", event),
+			zap.Error(err))
+		return
+	}
+
+	syncSubscriber := router.targetCache.SyncSubscriber(r.Method, path, event.EventType)
+	if syncSubscriber != nil { // There is sync subscriber and possibly async subscribers also
+		router.handleSyncSubscription(path, *event, size, *syncSubscriber, w, r)
+	}
+
+	router.handleAsyncSubscriptions(r.Method, path, *event, size, r)
+	router.publishToSockets(path, *event, size, r)
+	if syncSubscriber == nil {
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
 // StartWorkers spins up workerNumber goroutines for processing
 // the event subscriptions.
 func (router *Router) StartWorkers() {
@@ -152,6 +217,10 @@ func (router *Router) Drain() {
 	}
 	router.Unlock()
 
+	if router.sockets != nil {
+		router.sockets.closeAll()
+	}
+
 	// wait for children to drain the work queue
 	router.drainWaitGroup.Wait()
 
@@ -166,22 +235,47 @@ var (
 	errUnableToLookUpRegisteredFunction = errors.New("unable to look up registered function")
 )
 
-func (router *Router) handleSyncSubscription(path string, event eventpkg.Event, subscriber SyncSubscriber, w http.ResponseWriter, r *http.Request) {
+func (router *Router) handleSyncSubscription(path string, event eventpkg.Event, size int64, subscriber SyncSubscriber, w http.ResponseWriter, r *http.Request) {
 	metricEventsReceived.WithLabelValues(subscriber.Space, string(event.EventType)).Inc()
 
+	if status, err := router.checkSpaceLimits(subscriber.Space, size, r); err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: err.Error()}}})
+		return
+	}
+
 	err := router.authorizeEventType(subscriber.Space, &event, r)
 	if err != nil {
 		w.WriteHeader(http.StatusForbidden)
 		return
 	}
 
+	err = router.applySchema(subscriber.Space, &event, subscriber.PayloadMode)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: err.Error()}}})
+		return
+	}
+
 	// add params to HTTP Request object
-	if event.EventType == eventpkg.TypeHTTPRequest {
-		httpRequestData := event.Data.(*eventpkg.HTTPRequestData)
+	if httpRequestData, ok := event.Data.(*eventpkg.HTTPRequestData); ok && event.EventType == eventpkg.TypeHTTPRequest {
 		httpRequestData.Params = subscriber.Params
 		event.Data = httpRequestData
 	}
-	router.httpRequestHandler(subscriber.Space, subscriber.FunctionID, &event)(w, r)
+
+	err = router.claimCheck(subscriber.Space, &event, size)
+	if err != nil {
+		router.log.Error("Offloading event payload to blob store failed.", zap.Object("event", event), zap.Error(err))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: err.Error()}}})
+		return
+	}
+	functionID := pickFunction(subscriber.FunctionID, subscriber.Targets, subscriber.Sticky, event, r)
+	metricEventsRouted.WithLabelValues(subscriber.Space, string(functionID)).Inc()
+	router.httpRequestHandler(subscriber.Space, functionID, &event)(w, r)
 
 	metricEventsProcessed.WithLabelValues(subscriber.Space, string(event.EventType)).Inc()
 }
@@ -191,7 +285,7 @@ func (router *Router) httpRequestHandler(space string, backingFunction function.
 	return func(w http.ResponseWriter, r *http.Request) {
 		encoder := json.NewEncoder(w)
 
-		resp, err := router.callFunction(space, backingFunction, *event)
+		resp, err := router.callFunction(space, backingFunction, *event, false)
 		if err != nil {
 			message := determineErrorMessage(err)
 
@@ -228,7 +322,7 @@ func (router *Router) httpRequestHandler(space string, backingFunction function.
 }
 
 // handleAsyncSubscriptions fetched events subscribers, runs authorization and enqueues event in the queue
-func (router *Router) handleAsyncSubscriptions(method, path string, event eventpkg.Event, r *http.Request) {
+func (router *Router) handleAsyncSubscriptions(method, path string, event eventpkg.Event, size int64, r *http.Request) {
 	if event.IsSystem() {
 		router.log.Debug("System event received.", zap.String("path", path), zap.Object("event", event))
 	}
@@ -237,12 +331,46 @@ func (router *Router) handleAsyncSubscriptions(method, path string, event eventp
 	for _, subscriber := range subscribers {
 		metricEventsReceived.WithLabelValues(subscriber.Space, customEventType).Inc()
 
+		if _, err := router.checkSpaceLimits(subscriber.Space, size, r); err != nil {
+			router.log.Info("Event dropped because it exceeds space limits.",
+				zap.String("space", subscriber.Space),
+				zap.String("functionId", string(subscriber.FunctionID)),
+				zap.Int64("size", size),
+				zap.Error(err),
+				zap.Object("event", event))
+			continue
+		}
+
 		subEvent := eventpkg.Event{}
 		copier.Copy(&subEvent, &event)
 		err := router.authorizeEventType(subscriber.Space, &subEvent, r)
-		if err == nil {
-			router.enqueueWork(method, path, subscriber.Space, subscriber.FunctionID, subEvent)
+		if err != nil {
+			continue
+		}
+
+		err = router.applySchema(subscriber.Space, &subEvent, subscriber.PayloadMode)
+		if err != nil {
+			router.log.Info("Event payload doesn't match schema.",
+				zap.String("space", subscriber.Space),
+				zap.String("functionId", string(subscriber.FunctionID)),
+				zap.Object("event", subEvent),
+				zap.Error(err))
+			continue
+		}
+
+		err = router.claimCheck(subscriber.Space, &subEvent, size)
+		if err != nil {
+			router.log.Error("Offloading event payload to blob store failed.",
+				zap.String("space", subscriber.Space),
+				zap.String("functionId", string(subscriber.FunctionID)),
+				zap.Object("event", subEvent),
+				zap.Error(err))
+			continue
 		}
+
+		functionID := pickFunction(subscriber.FunctionID, subscriber.Targets, subscriber.Sticky, subEvent, r)
+		metricEventsRouted.WithLabelValues(subscriber.Space, string(functionID)).Inc()
+		router.enqueueWork(method, path, subscriber.Space, functionID, subEvent)
 	}
 }
 
@@ -264,8 +392,8 @@ func (router *Router) enqueueWork(method, path, space string, functionID functio
 	}
 }
 
-// callFunction looks up a function and calls it.
-func (router *Router) callFunction(space string, backingFunctionID function.ID, event eventpkg.Event) ([]byte, error) {
+// callFunction looks up a function and calls it. Async call doesn't wait for the result if the provider supports it.
+func (router *Router) callFunction(space string, backingFunctionID function.ID, event eventpkg.Event, async bool) ([]byte, error) {
 	router.log.Debug("Invoking function.",
 		zap.String("space", space),
 		zap.String("functionId", string(backingFunctionID)),
@@ -289,7 +417,12 @@ func (router *Router) callFunction(space string, backingFunctionID function.ID,
 		return nil, err
 	}
 
-	result, err := f.Call(payload)
+	var result []byte
+	if async {
+		err = f.CallAsync(payload)
+	} else {
+		result, err = f.Call(payload)
+	}
 	if err != nil {
 		router.log.Info("Function invocation failed.",
 			zap.String("space", space),
@@ -435,7 +568,7 @@ func (router *Router) loop() {
 func (router *Router) processEvent(e backlogEvent) {
 	reportEventOutOfQueue(e.event.EventID)
 
-	router.callFunction(e.space, e.functionID, e.event)
+	router.callFunction(e.space, e.functionID, e.event, true)
 
 	metricEventsProcessed.WithLabelValues(e.space, customEventType).Inc()
 }
@@ -446,7 +579,7 @@ func (router *Router) emitSystemEventReceived(path string, event eventpkg.Event,
 		mimeJSON,
 		eventpkg.SystemEventReceivedData{Path: path, Event: event, Headers: ihttp.FlattenHeader(r.Header)},
 	)
-	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromURL(r.Host, path), *system, nil)
+	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromURL(r.Host, path), *system, 0, nil)
 	return router.plugins.React(system)
 }
 
@@ -460,7 +593,7 @@ func (router *Router) emitSystemFunctionInvoking(space string, functionID functi
 		mimeJSON,
 		eventpkg.SystemFunctionInvokingData{Space: space, FunctionID: functionID, Event: event},
 	)
-	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromSpace(space), *system, nil)
+	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromSpace(space), *system, 0, nil)
 
 	metricEventsReceived.WithLabelValues(space, string(eventpkg.SystemFunctionInvokingType)).Inc()
 
@@ -476,7 +609,7 @@ func (router *Router) emitSystemFunctionInvoked(space string, functionID functio
 		eventpkg.SystemFunctionInvokedType,
 		mimeJSON,
 		eventpkg.SystemFunctionInvokedData{Space: space, FunctionID: functionID, Event: event, Result: result})
-	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromSpace(space), *system, nil)
+	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromSpace(space), *system, 0, nil)
 
 	metricEventsReceived.WithLabelValues(space, string(eventpkg.SystemFunctionInvokedType)).Inc()
 
@@ -492,7 +625,7 @@ func (router *Router) emitSystemFunctionInvocationFailed(space string, functionI
 		eventpkg.SystemFunctionInvocationFailedType,
 		mimeJSON,
 		eventpkg.SystemFunctionInvocationFailedData{Space: space, FunctionID: functionID, Event: event, Error: err})
-	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromSpace(space), *system, nil)
+	router.handleAsyncSubscriptions(http.MethodPost, systemPathFromSpace(space), *system, 0, nil)
 
 	metricEventsReceived.WithLabelValues(space, string(eventpkg.SystemFunctionInvocationFailedType)).Inc()
 }
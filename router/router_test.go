@@ -5,18 +5,24 @@ package router_test
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/golang/mock/gomock"
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/internal/blob"
 	"github.com/serverless/event-gateway/plugin"
 	"github.com/serverless/event-gateway/router"
 	"github.com/serverless/event-gateway/router/mock"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/event-gateway/subscription"
 	"github.com/serverless/event-gateway/subscription/cors"
 	"github.com/stretchr/testify/assert"
 
@@ -102,6 +108,47 @@ func TestRouterServeHTTP(t *testing.T) {
 			assert.Equal(t, http.StatusOK, recorder.Code)
 		})
 
+		t.Run("call sync subscriber target picked by traffic split", func(t *testing.T) {
+			canaryID := function.ID("canary")
+			canary := &function.Function{
+				Space:        space,
+				ID:           canaryID,
+				ProviderType: httpprovider.Type,
+				Provider:     &httpprovider.HTTP{URL: testHTTPFunction(http.StatusAccepted, []byte(`{"statusCode": 202}`)).URL},
+			}
+			splitSubscriber := &router.SyncSubscriber{
+				Space:      space,
+				FunctionID: functionID,
+				Targets:    []subscription.Target{{FunctionID: canaryID, Weight: 50}},
+				Sticky:     &subscription.Sticky{Header: "X-User-Id"},
+			}
+			eventType := &event.Type{Space: space, Name: "http.request"}
+
+			for _, testCase := range []struct {
+				user         string
+				functionID   function.ID
+				fn           *function.Function
+				expectedCode int
+			}{
+				{"user-1", canaryID, canary, http.StatusAccepted},
+				{"user-2", functionID, fn, http.StatusOK},
+			} {
+				target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+				target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeHTTPRequest).Return(splitSubscriber).MaxTimes(1)
+				target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+				target.EXPECT().EventType(space, event.TypeHTTPRequest).Return(eventType)
+				target.EXPECT().Function(space, testCase.functionID).Return(testCase.fn)
+				router := setupTestRouter(target)
+
+				req, _ := http.NewRequest(http.MethodPost, "/", nil)
+				req.Header.Set("X-User-Id", testCase.user)
+				recorder := httptest.NewRecorder()
+				router.ServeHTTP(recorder, req)
+
+				assert.Equal(t, testCase.expectedCode, recorder.Code)
+			}
+		})
+
 		t.Run("status code and headers based on HTTP response object", func(t *testing.T) {
 			httpResponseObject := []byte(`{"statusCode": 206, "headers": {"x-custom": "custom value"}}`)
 			fn = &function.Function{
@@ -248,7 +295,364 @@ func TestRouterServeHTTP(t *testing.T) {
 				router.ServeHTTP(recorder, req)
 			})
 		})
+
+		t.Run("with schema", func(t *testing.T) {
+			schemaID := schema.ID("name")
+			eventType := &event.Type{Space: space, Name: "test.event", SchemaID: &schemaID}
+			nameSchema := &schema.Schema{Space: space, ID: schemaID, Format: schema.FormatAvro, Definition: `"string"`}
+			nameSchema.Compile()
+
+			t.Run("status Bad Request if payload doesn't match schema", func(t *testing.T) {
+				target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+				target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeName("test.event")).Return(subscriber).MaxTimes(1)
+				target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+				target.EXPECT().EventType(space, event.TypeName("test.event")).Return(eventType).Times(2)
+				target.EXPECT().Schema(space, schemaID).Return(nameSchema)
+				router := setupTestRouter(target)
+
+				req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte{0x08, 'j'}))
+				req.Header.Set("Event", "test.event")
+				req.Header.Set("Content-Type", "application/octet-stream")
+				recorder := httptest.NewRecorder()
+				router.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assert.Equal(t, `{"errors":[{"message":"Event payload doesn't match schema \"name\": unexpected end of Avro payload"}]}`+"\n",
+					recorder.Body.String())
+			})
+
+			t.Run("decoded payload delivered in decode mode", func(t *testing.T) {
+				targetFunction := httptest.NewServer(http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						cloudEvent := &event.Event{}
+						dec := json.NewDecoder(r.Body)
+						dec.Decode(cloudEvent)
+
+						assert.Equal(t, "application/json", cloudEvent.ContentType)
+						assert.Equal(t, "john", cloudEvent.Data)
+					}))
+				fn.Provider = &httpprovider.HTTP{URL: targetFunction.URL}
+				decodeSubscriber := &router.SyncSubscriber{Space: space, FunctionID: functionID, PayloadMode: subscription.PayloadModeDecode}
+				target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+				target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeName("test.event")).Return(decodeSubscriber).MaxTimes(1)
+				target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+				target.EXPECT().EventType(space, event.TypeName("test.event")).Return(eventType).Times(2)
+				target.EXPECT().Schema(space, schemaID).Return(nameSchema)
+				target.EXPECT().Function(space, functionID).Return(fn)
+				router := setupTestRouter(target)
+
+				req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte{0x08, 'j', 'o', 'h', 'n'}))
+				req.Header.Set("Event", "test.event")
+				req.Header.Set("Content-Type", "application/octet-stream")
+				recorder := httptest.NewRecorder()
+				router.ServeHTTP(recorder, req)
+			})
+		})
+	})
+}
+
+func TestRouterServeHTTP_Limits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	target := mock.NewMockTargeter(ctrl)
+
+	t.Run("status Request Entity Too Large if Content-Length exceeds limit", func(t *testing.T) {
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		limits := router.Limits{MaxBodySize: 4}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("too large")))
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	})
+
+	t.Run("status Request Entity Too Large if streamed body exceeds limit", func(t *testing.T) {
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		limits := router.Limits{MaxBodySize: 4}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("too large")))
+		req.ContentLength = -1
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, `{"errors":[{"message":"http: request body too large"}]}`+"\n", recorder.Body.String())
+	})
+
+	t.Run("status Request Entity Too Large if body exceeds space limit", func(t *testing.T) {
+		subscriber := &router.SyncSubscriber{Space: "small", FunctionID: "test"}
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		target.EXPECT().Spaces(http.MethodPost, "/").Return([]string{"small", "unlimited"})
+		target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeHTTPRequest).Return(subscriber)
+		target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+		limits := router.Limits{SpaceMaxBodySize: map[string]int64{"small": 4}}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("too large")))
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+
+	t.Run("status Request Entity Too Large while reading body if it exceeds limits of all spaces", func(t *testing.T) {
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		target.EXPECT().Spaces(http.MethodPost, "/").Return([]string{"small", "medium"})
+		limits := router.Limits{SpaceMaxBodySize: map[string]int64{"small": 2, "medium": 4}}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		body := &readCounter{Reader: bytes.NewReader(bytes.Repeat([]byte("a"), 1<<20))}
+		req, _ := http.NewRequest(http.MethodPost, "/", body)
+		req.ContentLength = -1
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.True(t, body.read < 1<<20)
+	})
+
+	t.Run("status Request Header Fields Too Large if headers exceed space limit", func(t *testing.T) {
+		subscriber := &router.SyncSubscriber{Space: "small", FunctionID: "test"}
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeHTTPRequest).Return(subscriber)
+		target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+		limits := router.Limits{SpaceMaxHeaderSize: map[string]int64{"small": 64}}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("event")))
+		req.Header.Set("X-Large", strings.Repeat("a", 64))
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusRequestHeaderFieldsTooLarge, recorder.Code)
+		assert.Equal(t, `{"errors":[{"message":"http: request header too large"}]}`+"\n", recorder.Body.String())
+	})
+
+	t.Run("status Request Timeout if reading body exceeds space read timeout", func(t *testing.T) {
+		subscriber := &router.SyncSubscriber{Space: "fast", FunctionID: "test"}
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		target.EXPECT().Spaces(http.MethodPost, "/").Return([]string{"fast", "slow"})
+		target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeHTTPRequest).Return(subscriber)
+		target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+		limits := router.Limits{SpaceReadTimeout: map[string]time.Duration{"fast": time.Millisecond, "slow": time.Second}}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", &slowReader{Reader: bytes.NewReader([]byte("event")), delay: 10 * time.Millisecond})
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusRequestTimeout, recorder.Code)
+		assert.Equal(t, `{"errors":[{"message":"http: request body read timeout"}]}`+"\n", recorder.Body.String())
+	})
+
+	t.Run("payload offloaded to blob store above claim check threshold", func(t *testing.T) {
+		targetFunction := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				cloudEvent := &event.Event{}
+				dec := json.NewDecoder(r.Body)
+				dec.Decode(cloudEvent)
+
+				assert.Equal(t, "application/json", cloudEvent.ContentType)
+				assert.Equal(t, map[string]interface{}{
+					"blobId":      "blob1",
+					"size":        float64(9),
+					"contentType": "application/octet-stream",
+				}, cloudEvent.Data)
+			}))
+		fn := &function.Function{
+			Space:        "default",
+			ID:           "test",
+			ProviderType: httpprovider.Type,
+			Provider:     &httpprovider.HTTP{URL: targetFunction.URL},
+		}
+		subscriber := &router.SyncSubscriber{Space: "default", FunctionID: "test"}
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeName("test.event")).Return(subscriber)
+		target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+		target.EXPECT().EventType("default", event.TypeName("test.event")).Return(nil).Times(2)
+		target.EXPECT().Function("default", function.ID("test")).Return(fn)
+		blobs := &memoryBlobStore{}
+		limits := router.Limits{ClaimCheckThreshold: 4, BlobStore: blobs}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("too large")))
+		req.Header.Set("Event", "test.event")
+		req.Header.Set("Content-Type", "application/octet-stream")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, []byte("too large"), blobs.data)
+		assert.Equal(t, "default", blobs.space)
+	})
+
+	t.Run("body of HTTP request offloaded to blob store for sync subscription", func(t *testing.T) {
+		targetFunction := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				cloudEvent := &event.Event{}
+				dec := json.NewDecoder(r.Body)
+				dec.Decode(cloudEvent)
+
+				data := cloudEvent.Data.(map[string]interface{})
+				assert.Equal(t, "/users", data["path"])
+				assert.Equal(t, map[string]interface{}{"id": "1"}, data["params"])
+				assert.Equal(t, map[string]interface{}{
+					"blobId":      "blob1",
+					"size":        float64(9),
+					"contentType": "text/plain",
+				}, data["body"])
+				w.Write([]byte(`{"statusCode":200}`))
+			}))
+		fn := &function.Function{
+			Space:        "default",
+			ID:           "test",
+			ProviderType: httpprovider.Type,
+			Provider:     &httpprovider.HTTP{URL: targetFunction.URL},
+		}
+		subscriber := &router.SyncSubscriber{Space: "default", FunctionID: "test", Params: map[string]string{"id": "1"}}
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		target.EXPECT().SyncSubscriber(http.MethodPost, "/users", event.TypeHTTPRequest).Return(subscriber)
+		target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+		target.EXPECT().EventType("default", event.TypeHTTPRequest).Return(nil)
+		target.EXPECT().Function("default", function.ID("test")).Return(fn)
+		blobs := &memoryBlobStore{}
+		limits := router.Limits{ClaimCheckThreshold: 4, BlobStore: blobs}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/users", bytes.NewReader([]byte("too large")))
+		req.Header.Set("Content-Type", "text/plain")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, []byte("too large"), blobs.data)
+	})
+
+	t.Run("payload validated against schema before offloading", func(t *testing.T) {
+		schemaID := schema.ID("name")
+		eventType := &event.Type{Space: "default", Name: "test.event", SchemaID: &schemaID}
+		nameSchema := &schema.Schema{Space: "default", ID: schemaID, Format: schema.FormatAvro, Definition: `"string"`}
+		nameSchema.Compile()
+		subscriber := &router.SyncSubscriber{Space: "default", FunctionID: "test"}
+		target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil)
+		target.EXPECT().SyncSubscriber(http.MethodPost, "/", event.TypeName("test.event")).Return(subscriber)
+		target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+		target.EXPECT().EventType("default", event.TypeName("test.event")).Return(eventType).Times(2)
+		target.EXPECT().Schema("default", schemaID).Return(nameSchema)
+		blobs := &memoryBlobStore{}
+		limits := router.Limits{ClaimCheckThreshold: 1, BlobStore: blobs}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte{0x08, 'j'}))
+		req.Header.Set("Event", "test.event")
+		req.Header.Set("Content-Type", "application/octet-stream")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Nil(t, blobs.data)
+	})
+}
+
+func TestRouterEmit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	target := mock.NewMockTargeter(ctrl)
+
+	t.Run("status Unavaliable when draining", func(t *testing.T) {
+		router := setupTestRouter(target)
+		router.Drain()
+
+		req, _ := http.NewRequest(http.MethodPost, "/", nil)
+		recorder := httptest.NewRecorder()
+		router.Emit(event.New("user.created", "application/json", nil), 0, recorder, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
 	})
+
+	t.Run("status Request Entity Too Large if size exceeds limit", func(t *testing.T) {
+		limits := router.Limits{MaxBodySize: 4}
+		router := setupTestRouter(target)
+		router.SetLimits(limits)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", nil)
+		recorder := httptest.NewRecorder()
+		router.Emit(event.New("user.created", "application/json", nil), 5, recorder, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+
+	t.Run("reject if system event", func(t *testing.T) {
+		router := setupTestRouter(target)
+
+		req, _ := http.NewRequest(http.MethodPost, "/", nil)
+		recorder := httptest.NewRecorder()
+		router.Emit(event.New("eventgateway.something", "application/json", nil), 0, recorder, req)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("event routed to async subscribers on request path", func(t *testing.T) {
+		target.EXPECT().SyncSubscriber(http.MethodPost, "/users", event.TypeName("user.created")).Return(nil)
+		target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+		router := setupTestRouter(target)
+
+		req, _ := http.NewRequest(http.MethodPost, "/users", nil)
+		recorder := httptest.NewRecorder()
+		router.Emit(event.New("user.created", "application/json", nil), 0, recorder, req)
+
+		assert.Equal(t, http.StatusAccepted, recorder.Code)
+	})
+}
+
+type memoryBlobStore struct {
+	space string
+	data  []byte
+}
+
+func (s *memoryBlobStore) Put(space string, data []byte) (blob.ID, error) {
+	s.space = space
+	s.data = data
+	return blob.ID("blob1"), nil
+}
+
+func (s *memoryBlobStore) Get(space string, id blob.ID) ([]byte, error) {
+	return s.data, nil
+}
+
+// readCounter counts bytes read from the reader.
+type readCounter struct {
+	io.Reader
+	read int
+}
+
+func (r *readCounter) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += n
+	return n, err
+}
+
+type slowReader struct {
+	io.Reader
+	delay time.Duration
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	time.Sleep(r.delay)
+	return r.Reader.Read(p)
 }
 
 func setupTestRouter(target router.Targeter) *router.Router {
@@ -0,0 +1,38 @@
+package router
+
+import (
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/event-gateway/subscription"
+)
+
+// applySchema validates binary event payload against the schema referenced by event type. In decode payload mode
+// event data is replaced with decoded payload.
+func (router *Router) applySchema(space string, event *eventpkg.Event, mode subscription.PayloadMode) error {
+	payload, ok := event.Data.([]byte)
+	if !ok {
+		return nil
+	}
+
+	eventType := router.targetCache.EventType(space, event.EventType)
+	if eventType == nil || eventType.SchemaID == nil {
+		return nil
+	}
+
+	s := router.targetCache.Schema(space, *eventType.SchemaID)
+	if s == nil {
+		return &schema.ErrSchemaNotFound{ID: *eventType.SchemaID}
+	}
+
+	data, err := s.Decode(payload)
+	if err != nil {
+		return err
+	}
+
+	if mode == subscription.PayloadModeDecode {
+		event.Data = data
+		event.ContentType = mimeJSON
+	}
+
+	return nil
+}
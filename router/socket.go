@@ -0,0 +1,336 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/copier"
+	"go.uber.org/zap"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+)
+
+// SocketRegistry keeps track of event types that clients connected to Event Gateway nodes are subscribed to. It allows
+// delivering events received by one node to clients connected to other nodes in the cluster. Methods are called with
+// sockets lock held so they must not block.
+type SocketRegistry interface {
+	// Subscribe registers that the node has clients subscribed to the event type in the space.
+	Subscribe(space string, eventType eventpkg.TypeName)
+	// Unsubscribe removes registration after the last client on the node unsubscribed from the event type.
+	Unsubscribe(space string, eventType eventpkg.TypeName)
+	// Spaces returns spaces in which clients connected to any node are subscribed to the event type.
+	Spaces(eventType eventpkg.TypeName) []string
+	// Publish queues the event for other nodes that have clients subscribed to the event type in the space.
+	Publish(space string, event eventpkg.Event) error
+	// Receive sets a callback invoked with events published by other nodes.
+	Receive(deliver func(space string, event eventpkg.Event))
+}
+
+// Streaming configures pushing events to clients connected over WebSocket or Server-Sent Events.
+type Streaming struct {
+	// WebSocket enables "/v1/spaces/<space>/ws" endpoint.
+	WebSocket bool
+	// ServerSentEvents enables "/v1/spaces/<space>/stream" endpoint.
+	ServerSentEvents bool
+	// BufferSize is a number of recently pushed events kept in memory for resuming Server-Sent Events streams.
+	BufferSize int
+	// Registry is optional and required only if Event Gateway runs in a cluster.
+	Registry SocketRegistry
+}
+
+// SocketRequest is a message sent by WebSocket client to manage its subscriptions.
+type SocketRequest struct {
+	Action    string            `json:"action"`
+	EventType eventpkg.TypeName `json:"eventType"`
+}
+
+// SocketMessage is a message sent to WebSocket client.
+type SocketMessage struct {
+	Type      string            `json:"type"`
+	EventType eventpkg.TypeName `json:"eventType,omitempty"`
+	Event     *eventpkg.Event   `json:"event,omitempty"`
+	Message   string            `json:"message,omitempty"`
+}
+
+const (
+	socketActionSubscribe   = "subscribe"
+	socketActionUnsubscribe = "unsubscribe"
+
+	socketMessageSubscribed   = "subscribed"
+	socketMessageUnsubscribed = "unsubscribed"
+	socketMessageEvent        = "event"
+	socketMessageError        = "error"
+)
+
+// subscriptionLinger is a time for which events are still buffered after the last client unsubscribed, so a client
+// reconnecting with Last-Event-ID doesn't miss events.
+const subscriptionLinger = 30 * time.Second
+
+// SetStreaming configures WebSocket and Server-Sent Events endpoints.
+func (router *Router) SetStreaming(streaming Streaming) {
+	router.streaming = streaming
+	if !streaming.WebSocket && !streaming.ServerSentEvents {
+		router.sockets = nil
+		return
+	}
+
+	router.sockets = newSockets(streaming.Registry, streaming.BufferSize, router.log)
+	if streaming.Registry != nil {
+		streaming.Registry.Receive(router.sockets.deliver)
+	}
+}
+
+// errSubscriptionNotAuthorized is returned when client subscribes to event type without authorizer. Without authorizer
+// anyone would be able to receive events of the event type.
+var errSubscriptionNotAuthorized = errors.New("event type has no authorizer, only event types with authorizer can be subscribed to")
+
+// authorizeSubscription checks if client is allowed to receive events of the event type. Only event types with
+// authorizer can be subscribed to. The authorizer is called with the subscription request.
+func (router *Router) authorizeSubscription(space string, name eventpkg.TypeName, r *http.Request) error {
+	eventType := router.targetCache.EventType(space, name)
+	if eventType == nil {
+		return &eventpkg.ErrEventTypeNotFound{Name: name}
+	}
+	if eventType.AuthorizerID == nil {
+		return errSubscriptionNotAuthorized
+	}
+
+	return router.authorizeEventType(space, eventpkg.New(name, mimeJSON, nil), r)
+}
+
+// publishToSockets delivers event to clients subscribed to the event type. The event is authorized for every space
+// the same way as it is for async subscriptions.
+func (router *Router) publishToSockets(path string, event eventpkg.Event, size int64, r *http.Request) {
+	if router.sockets == nil {
+		return
+	}
+
+	for _, space := range router.sockets.spaces(event.EventType) {
+		if !pathInSpace(path, space) || router.targetCache.EventType(space, event.EventType) == nil {
+			continue
+		}
+
+		spaceEvent := eventpkg.Event{}
+		copier.Copy(&spaceEvent, &event)
+		err := router.authorizeEventType(space, &spaceEvent, r)
+		if err != nil {
+			continue
+		}
+
+		err = router.claimCheck(space, &spaceEvent, size)
+		if err != nil {
+			router.log.Error("Offloading event payload to blob store failed.",
+				zap.String("space", space),
+				zap.Object("event", spaceEvent),
+				zap.Error(err))
+			continue
+		}
+
+		router.sockets.deliver(space, spaceEvent)
+		router.sockets.publish(space, spaceEvent)
+	}
+}
+
+// client is a connection that events are pushed to.
+type client interface {
+	// push queues event without blocking. It returns false if the event was dropped.
+	push(event eventpkg.Event) bool
+	close()
+}
+
+type socketKey struct {
+	space     string
+	eventType eventpkg.TypeName
+}
+
+// sockets keeps track of clients connected to this node.
+type sockets struct {
+	sync.RWMutex
+	registry  SocketRegistry
+	connected map[client]struct{}
+	clients   map[socketKey]map[client]struct{}
+	buffer    *eventBuffer
+	log       *zap.Logger
+}
+
+func newSockets(registry SocketRegistry, bufferSize int, log *zap.Logger) *sockets {
+	return &sockets{
+		registry:  registry,
+		connected: map[client]struct{}{},
+		clients:   map[socketKey]map[client]struct{}{},
+		buffer:    newEventBuffer(bufferSize),
+		log:       log,
+	}
+}
+
+func (s *sockets) connect(c client) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.connected[c] = struct{}{}
+}
+
+func (s *sockets) subscribe(c client, space string, eventType eventpkg.TypeName) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.add(c, socketKey{space: space, eventType: eventType})
+}
+
+// resume subscribes client to event types and pushes buffered events emitted after lastEventID. Both happen under
+// the lock so no event is lost or pushed twice.
+func (s *sockets) resume(c client, space string, eventTypes []eventpkg.TypeName, lastEventID string) {
+	s.Lock()
+	defer s.Unlock()
+
+	subscribed := map[eventpkg.TypeName]struct{}{}
+	for _, eventType := range eventTypes {
+		s.add(c, socketKey{space: space, eventType: eventType})
+		subscribed[eventType] = struct{}{}
+	}
+
+	if lastEventID == "" {
+		return
+	}
+	for _, buffered := range s.buffer.since(space, lastEventID) {
+		if _, ok := subscribed[buffered.event.EventType]; ok && buffered.space == space {
+			c.push(buffered.event)
+		}
+	}
+}
+
+func (s *sockets) add(c client, key socketKey) {
+	clients, ok := s.clients[key]
+	if !ok {
+		if s.registry != nil {
+			s.registry.Subscribe(key.space, key.eventType)
+		}
+		clients = map[client]struct{}{}
+		s.clients[key] = clients
+	}
+	clients[c] = struct{}{}
+}
+
+func (s *sockets) unsubscribe(c client, space string, eventType eventpkg.TypeName) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.remove(c, socketKey{space: space, eventType: eventType})
+}
+
+// disconnect removes client from all subscriptions.
+func (s *sockets) disconnect(c client) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.connected, c)
+	for key, clients := range s.clients {
+		if _, ok := clients[c]; ok {
+			s.remove(c, key)
+		}
+	}
+}
+
+func (s *sockets) remove(c client, key socketKey) {
+	clients, ok := s.clients[key]
+	if !ok {
+		return
+	}
+	delete(clients, c)
+
+	if len(clients) > 0 {
+		return
+	}
+	if s.buffer.capacity() == 0 {
+		s.drop(key)
+		return
+	}
+
+	// keep buffering events for a while so clients can resume the stream
+	time.AfterFunc(subscriptionLinger, func() {
+		s.Lock()
+		defer s.Unlock()
+
+		if clients, ok := s.clients[key]; ok && len(clients) == 0 {
+			s.drop(key)
+		}
+	})
+}
+
+func (s *sockets) drop(key socketKey) {
+	delete(s.clients, key)
+	if s.registry != nil {
+		s.registry.Unsubscribe(key.space, key.eventType)
+	}
+}
+
+// closeAll closes connections of all clients.
+func (s *sockets) closeAll() {
+	s.RLock()
+	defer s.RUnlock()
+
+	for c := range s.connected {
+		c.close()
+	}
+}
+
+// spaces returns spaces with clients subscribed to the event type on this or any other node.
+func (s *sockets) spaces(eventType eventpkg.TypeName) []string {
+	s.RLock()
+	defer s.RUnlock()
+
+	found := map[string]struct{}{}
+	for key := range s.clients {
+		if key.eventType == eventType {
+			found[key.space] = struct{}{}
+		}
+	}
+	if s.registry != nil {
+		for _, space := range s.registry.Spaces(eventType) {
+			found[space] = struct{}{}
+		}
+	}
+
+	spaces := []string{}
+	for space := range found {
+		spaces = append(spaces, space)
+	}
+	return spaces
+}
+
+// deliver pushes event to clients connected to this node.
+func (s *sockets) deliver(space string, event eventpkg.Event) {
+	s.Lock()
+	defer s.Unlock()
+
+	clients, ok := s.clients[socketKey{space: space, eventType: event.EventType}]
+	if !ok {
+		return
+	}
+
+	s.buffer.add(space, event)
+	for c := range clients {
+		if !c.push(event) {
+			s.log.Info("Event dropped because client is too slow.",
+				zap.String("space", space),
+				zap.Object("event", event))
+		}
+	}
+}
+
+// publish sends event to other nodes in the cluster.
+func (s *sockets) publish(space string, event eventpkg.Event) {
+	if s.registry == nil {
+		return
+	}
+
+	err := s.registry.Publish(space, event)
+	if err != nil {
+		s.log.Error("Publishing event to other nodes failed.",
+			zap.String("space", space),
+			zap.Object("event", event),
+			zap.Error(err))
+	}
+}
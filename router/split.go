@@ -0,0 +1,65 @@
+package router
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/subscription"
+)
+
+// pickFunction returns ID of the function that receives the event. If subscription has targets, events are split
+// between them by weight and the rest is delivered to functionID. With sticky routing events with the same key are
+// delivered to the same function. Events without the key are split randomly.
+func pickFunction(functionID function.ID, targets []subscription.Target, sticky *subscription.Sticky,
+	event eventpkg.Event, r *http.Request) function.ID {
+	if len(targets) == 0 {
+		return functionID
+	}
+
+	var bucket uint
+	key := stickyKey(sticky, event, r)
+	if key != "" {
+		hash := fnv.New32a()
+		hash.Write([]byte(key))
+		bucket = uint(hash.Sum32() % 100)
+	} else {
+		bucket = uint(rand.Intn(100))
+	}
+
+	for _, target := range targets {
+		if bucket < target.Weight {
+			return target.FunctionID
+		}
+		bucket -= target.Weight
+	}
+	return functionID
+}
+
+// stickyKey returns the value of the header or the event attribute used for sticky routing.
+func stickyKey(sticky *subscription.Sticky, event eventpkg.Event, r *http.Request) string {
+	if sticky == nil {
+		return ""
+	}
+	if sticky.Header != "" {
+		if r == nil {
+			return ""
+		}
+		return r.Header.Get(sticky.Header)
+	}
+
+	value, ok := event.Attribute(sticky.Attribute)
+	if !ok {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
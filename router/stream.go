@@ -0,0 +1,224 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/httpapi"
+)
+
+const (
+	mimeEventStream         = "text/event-stream"
+	streamKeepAliveInterval = 15 * time.Second
+	streamSendBuffer        = 256
+)
+
+var streamPathPattern = regexp.MustCompile(`^/v1/spaces/([a-zA-Z0-9\.\-_]+)/stream$`)
+
+var (
+	errStreamEventTypeRequired = errors.New("eventType query parameter is required")
+	errStreamingNotSupported   = errors.New("streaming not supported")
+)
+
+// streamSpace returns space name if path is a Server-Sent Events endpoint path.
+func streamSpace(path string) (string, bool) {
+	matches := streamPathPattern.FindStringSubmatch(path)
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// serveStream streams events of types specified in "eventType" query parameters as Server-Sent Events. The stream is
+// authorized with event type authorizers using the stream request. If the client sends Last-Event-ID header, buffered
+// events emitted after that event are sent first.
+func (router *Router) serveStream(space string, w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeStreamError(w, http.StatusInternalServerError, errStreamingNotSupported)
+		return
+	}
+
+	names := r.URL.Query()["eventType"]
+	if len(names) == 0 {
+		writeStreamError(w, http.StatusBadRequest, errStreamEventTypeRequired)
+		return
+	}
+
+	eventTypes := []eventpkg.TypeName{}
+	for _, name := range names {
+		eventType := eventpkg.TypeName(name)
+		err := router.authorizeSubscription(space, eventType, r)
+		if _, ok := err.(*eventpkg.ErrEventTypeNotFound); ok {
+			writeStreamError(w, http.StatusBadRequest, err)
+			return
+		}
+		if err != nil {
+			writeStreamError(w, http.StatusForbidden, err)
+			return
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	client := newStreamClient()
+	router.sockets.connect(client)
+	defer func() {
+		router.sockets.disconnect(client)
+		client.close()
+	}()
+
+	router.sockets.resume(client, space, eventTypes, r.Header.Get("Last-Event-ID"))
+
+	metricStreamConnections.WithLabelValues(space).Inc()
+	defer metricStreamConnections.WithLabelValues(space).Dec()
+
+	// stream is open until client disconnects so it cannot be limited by the server timeouts
+	httpapi.DisableTimeouts(r)
+
+	w.Header().Set("Content-Type", mimeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(streamKeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event := <-client.events:
+			err := writeStreamEvent(w, event)
+			if err != nil {
+				router.log.Debug("Writing event to stream failed.", zap.String("space", space), zap.Error(err))
+				return
+			}
+			flusher.Flush()
+		case <-ticker.C:
+			_, err := fmt.Fprint(w, ": keepalive\n\n")
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-client.done:
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func writeStreamEvent(w http.ResponseWriter, event eventpkg.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.EventID, event.EventType, payload)
+	return err
+}
+
+func writeStreamError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", mimeJSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&httpapi.Response{Errors: []httpapi.Error{{Message: err.Error()}}})
+}
+
+// streamClient is a client receiving events as Server-Sent Events.
+type streamClient struct {
+	events chan eventpkg.Event
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newStreamClient() *streamClient {
+	return &streamClient{
+		events: make(chan eventpkg.Event, streamSendBuffer),
+		done:   make(chan struct{}),
+	}
+}
+
+func (c *streamClient) push(event eventpkg.Event) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	select {
+	case c.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *streamClient) close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+type bufferedEvent struct {
+	space string
+	event eventpkg.Event
+}
+
+// eventBuffer is a fixed size ring buffer of recently pushed events.
+type eventBuffer struct {
+	events []bufferedEvent
+	next   int
+	count  int
+}
+
+func newEventBuffer(size int) *eventBuffer {
+	if size < 0 {
+		size = 0
+	}
+	return &eventBuffer{events: make([]bufferedEvent, size)}
+}
+
+func (b *eventBuffer) capacity() int {
+	return len(b.events)
+}
+
+func (b *eventBuffer) add(space string, event eventpkg.Event) {
+	if len(b.events) == 0 {
+		return
+	}
+
+	b.events[b.next] = bufferedEvent{space: space, event: event}
+	b.next = (b.next + 1) % len(b.events)
+	if b.count < len(b.events) {
+		b.count++
+	}
+}
+
+// since returns events buffered after the event with the ID in the space. It returns nil if the event is not in
+// the buffer anymore.
+func (b *eventBuffer) since(space, id string) []bufferedEvent {
+	if b.count == 0 {
+		return nil
+	}
+
+	start := (b.next - b.count + len(b.events)) % len(b.events)
+	for i := 0; i < b.count; i++ {
+		buffered := b.events[(start+i)%len(b.events)]
+		if buffered.space != space || buffered.event.EventID != id {
+			continue
+		}
+
+		events := []bufferedEvent{}
+		for j := i + 1; j < b.count; j++ {
+			events = append(events, b.events[(start+j)%len(b.events)])
+		}
+		return events
+	}
+	return nil
+}
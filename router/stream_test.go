@@ -0,0 +1,151 @@
+// +build !hosted
+
+package router_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/httpapi"
+	"github.com/serverless/event-gateway/router"
+	"github.com/serverless/event-gateway/router/mock"
+
+	httpprovider "github.com/serverless/event-gateway/providers/http"
+)
+
+func TestRouterStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	target := mock.NewMockTargeter(ctrl)
+	target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	target.EXPECT().SyncSubscriber(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+	authorizer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"authorization":{"principalId":"bob"}}`))
+	}))
+	defer authorizer.Close()
+	authorizerID := function.ID("allow")
+	target.EXPECT().EventType("default", event.TypeName("user.created")).
+		Return(&event.Type{Space: "default", Name: "user.created", AuthorizerID: &authorizerID}).AnyTimes()
+	target.EXPECT().Function("default", authorizerID).Return(&function.Function{
+		Space:        "default",
+		ID:           authorizerID,
+		ProviderType: httpprovider.Type,
+		Provider:     &httpprovider.HTTP{URL: authorizer.URL},
+	}).AnyTimes()
+	server := setupSocketServer(target)
+	defer server.Close()
+
+	t.Run("events streamed and resumed after Last-Event-ID", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/v1/spaces/default/stream?eventType=user.created")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		stream := bufio.NewReader(resp.Body)
+
+		emitUserCreated(t, server.URL, "alice")
+		emitUserCreated(t, server.URL, "bob")
+		first := readStreamEvent(t, stream)
+		second := readStreamEvent(t, stream)
+		assert.Equal(t, "user.created", first["event"])
+		assert.Contains(t, first["data"], `"alice"`)
+		assert.Contains(t, second["data"], `"bob"`)
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/spaces/default/stream?eventType=user.created", nil)
+		req.Header.Set("Last-Event-ID", first["id"])
+		resumed, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resumed.Body.Close()
+
+		replayed := readStreamEvent(t, bufio.NewReader(resumed.Body))
+		assert.Equal(t, second["id"], replayed["id"])
+	})
+
+	t.Run("status Bad Request if eventType is missing", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/v1/spaces/default/stream")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		httpresp := &httpapi.Response{}
+		json.NewDecoder(resp.Body).Decode(httpresp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "eventType query parameter is required", httpresp.Errors[0].Message)
+	})
+
+	t.Run("status Bad Request if event type doesn't exist", func(t *testing.T) {
+		target.EXPECT().EventType("default", event.TypeName("user.unknown")).Return(nil)
+
+		resp, err := http.Get(server.URL + "/v1/spaces/default/stream?eventType=user.unknown")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("status Forbidden if event type has no authorizer", func(t *testing.T) {
+		target.EXPECT().EventType("default", event.TypeName("user.updated")).
+			Return(&event.Type{Space: "default", Name: "user.updated"})
+
+		resp, err := http.Get(server.URL + "/v1/spaces/default/stream?eventType=user.updated")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		httpresp := &httpapi.Response{}
+		json.NewDecoder(resp.Body).Decode(httpresp)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "event type has no authorizer, only event types with authorizer can be subscribed to",
+			httpresp.Errors[0].Message)
+	})
+}
+
+func emitUserCreated(t *testing.T, url, name string) {
+	req, _ := http.NewRequest(http.MethodPost, url+"/", bytes.NewReader([]byte(`{"name":"`+name+`"}`)))
+	req.Header.Set("Event", "user.created")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+}
+
+// readStreamEvent reads fields of a single Server-Sent Event skipping comments.
+func readStreamEvent(t *testing.T, stream *bufio.Reader) map[string]string {
+	fields := map[string]string{}
+	for {
+		line, err := stream.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" && len(fields) > 0 {
+			return fields
+		}
+		if line == "" || strings.HasPrefix(line, ":") {
+			continue
+		}
+		segments := strings.SplitN(line, ": ", 2)
+		fields[segments[0]] = segments[1]
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/internal/pathtree"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/serverless/event-gateway/subscription"
 	"github.com/serverless/event-gateway/subscription/cors"
 )
 
@@ -13,18 +15,27 @@ type Targeter interface {
 	EventType(space string, name event.TypeName) *event.Type
 	AsyncSubscribers(method, path string, eventType event.TypeName) []AsyncSubscriber
 	SyncSubscriber(method, path string, eventType event.TypeName) *SyncSubscriber
+	Spaces(method, path string) []string
 	CORS(method, path string) *cors.CORS
+	Schema(space string, id schema.ID) *schema.Schema
 }
 
-// AsyncSubscriber store info about space and function ID.
+// AsyncSubscriber store info about space, function ID, payload mode and traffic split.
 type AsyncSubscriber struct {
-	Space      string
-	FunctionID function.ID
+	Space       string
+	FunctionID  function.ID
+	PayloadMode subscription.PayloadMode
+	Targets     []subscription.Target
+	Sticky      *subscription.Sticky
 }
 
-// SyncSubscriber store info about space, function ID and path params for sync subscriptions.
+// SyncSubscriber store info about space, function ID, payload mode, traffic split and path params for sync
+// subscriptions.
 type SyncSubscriber struct {
-	Space      string
-	FunctionID function.ID
-	Params     pathtree.Params
+	Space       string
+	FunctionID  function.ID
+	Params      pathtree.Params
+	PayloadMode subscription.PayloadMode
+	Targets     []subscription.Target
+	Sticky      *subscription.Sticky
 }
@@ -0,0 +1,207 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	eventpkg "github.com/serverless/event-gateway/event"
+)
+
+const (
+	socketWriteTimeout   = 10 * time.Second
+	socketPongTimeout    = 60 * time.Second
+	socketPingInterval   = (socketPongTimeout * 9) / 10
+	socketMaxMessageSize = 4096
+	socketSendBuffer     = 64
+)
+
+var socketPathPattern = regexp.MustCompile(`^/v1/spaces/([a-zA-Z0-9\.\-_]+)/ws$`)
+
+// socketSpace returns space name if path is a WebSocket endpoint path.
+func socketSpace(path string) (string, bool) {
+	matches := socketPathPattern.FindStringSubmatch(path)
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// serveWebSocket upgrades connection to WebSocket and handles client's subscription requests until the connection is
+// closed. Subscriptions are authorized with event type authorizer using the handshake request.
+func (router *Router) serveWebSocket(space string, w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{CheckOrigin: router.checkSocketOrigin}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		router.log.Debug("WebSocket handshake failed.", zap.String("space", space), zap.Error(err))
+		return
+	}
+
+	metricSocketConnections.WithLabelValues(space).Inc()
+	defer metricSocketConnections.WithLabelValues(space).Dec()
+
+	client := newSocketClient(space, conn)
+	router.sockets.connect(client)
+	go client.writeLoop()
+
+	router.readSocket(client, r)
+
+	router.sockets.disconnect(client)
+	client.close()
+}
+
+func (router *Router) readSocket(client *socketClient, r *http.Request) {
+	client.conn.SetReadLimit(socketMaxMessageSize)
+	client.conn.SetReadDeadline(time.Now().Add(socketPongTimeout))
+	client.conn.SetPongHandler(func(string) error {
+		return client.conn.SetReadDeadline(time.Now().Add(socketPongTimeout))
+	})
+
+	for {
+		_, data, err := client.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				router.log.Debug("WebSocket connection closed unexpectedly.", zap.String("space", client.space), zap.Error(err))
+			}
+			return
+		}
+
+		request := SocketRequest{}
+		err = json.Unmarshal(data, &request)
+		if err != nil {
+			client.send(SocketMessage{Type: socketMessageError, Message: err.Error()})
+			continue
+		}
+
+		switch request.Action {
+		case socketActionSubscribe:
+			err = router.subscribeSocket(client, request.EventType, r)
+			if err != nil {
+				client.send(SocketMessage{Type: socketMessageError, EventType: request.EventType, Message: err.Error()})
+				continue
+			}
+			client.send(SocketMessage{Type: socketMessageSubscribed, EventType: request.EventType})
+		case socketActionUnsubscribe:
+			router.sockets.unsubscribe(client, client.space, request.EventType)
+			client.send(SocketMessage{Type: socketMessageUnsubscribed, EventType: request.EventType})
+		default:
+			client.send(SocketMessage{Type: socketMessageError, Message: "unknown action " + request.Action})
+		}
+	}
+}
+
+// subscribeSocket checks if event type exists and the client is authorized to receive events of this type.
+func (router *Router) subscribeSocket(client *socketClient, name eventpkg.TypeName, r *http.Request) error {
+	err := router.authorizeSubscription(client.space, name, r)
+	if err != nil {
+		return err
+	}
+
+	router.sockets.subscribe(client, client.space, name)
+	return nil
+}
+
+// checkSocketOrigin allows same origin requests and requests from origins allowed by CORS configuration for the
+// WebSocket endpoint.
+func (router *Router) checkSocketOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if u.Host == r.Host {
+		return true
+	}
+
+	config := router.targetCache.CORS(http.MethodGet, r.URL.EscapedPath())
+	if config == nil {
+		return false
+	}
+	for _, allowed := range config.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// socketClient is a client connected over WebSocket. Messages are written to the connection by a single goroutine.
+type socketClient struct {
+	space    string
+	conn     *websocket.Conn
+	messages chan SocketMessage
+	done     chan struct{}
+	once     sync.Once
+}
+
+func newSocketClient(space string, conn *websocket.Conn) *socketClient {
+	return &socketClient{
+		space:    space,
+		conn:     conn,
+		messages: make(chan SocketMessage, socketSendBuffer),
+		done:     make(chan struct{}),
+	}
+}
+
+func (c *socketClient) push(event eventpkg.Event) bool {
+	return c.send(SocketMessage{Type: socketMessageEvent, EventType: event.EventType, Event: &event})
+}
+
+// send queues message without blocking. It returns false if the message was dropped.
+func (c *socketClient) send(message SocketMessage) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	select {
+	case c.messages <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *socketClient) close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+func (c *socketClient) writeLoop() {
+	ticker := time.NewTicker(socketPingInterval)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message := <-c.messages:
+			c.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+			if err := c.conn.WriteJSON(message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-c.done:
+			c.conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+			c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		}
+	}
+}
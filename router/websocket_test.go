@@ -0,0 +1,145 @@
+// +build !hosted
+
+package router_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/serverless/event-gateway/event"
+	"github.com/serverless/event-gateway/function"
+	"github.com/serverless/event-gateway/router"
+	"github.com/serverless/event-gateway/router/mock"
+
+	httpprovider "github.com/serverless/event-gateway/providers/http"
+)
+
+func TestRouterWebSocket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	target := mock.NewMockTargeter(ctrl)
+	target.EXPECT().CORS(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	target.EXPECT().SyncSubscriber(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	target.EXPECT().AsyncSubscribers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]router.AsyncSubscriber{}).AnyTimes()
+	server := setupSocketServer(target)
+	defer server.Close()
+
+	t.Run("event pushed to subscribed client", func(t *testing.T) {
+		authorizer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"authorization":{"principalId":"bob"}}`))
+		}))
+		defer authorizer.Close()
+		authorizerID := function.ID("allow")
+		target.EXPECT().EventType("default", event.TypeName("user.created")).
+			Return(&event.Type{Space: "default", Name: "user.created", AuthorizerID: &authorizerID}).AnyTimes()
+		target.EXPECT().Function("default", authorizerID).Return(&function.Function{
+			Space:        "default",
+			ID:           authorizerID,
+			ProviderType: httpprovider.Type,
+			Provider:     &httpprovider.HTTP{URL: authorizer.URL},
+		}).AnyTimes()
+		conn := dialSocket(t, server, "default")
+		defer conn.Close()
+
+		msg := subscribeSocket(t, conn, "user.created")
+		assert.Equal(t, router.SocketMessage{Type: "subscribed", EventType: "user.created"}, msg)
+
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/", bytes.NewReader([]byte(`{"name":"bob"}`)))
+		req.Header.Set("Event", "user.created")
+		req.Header.Set("Content-Type", "application/json")
+		resp, _ := http.DefaultClient.Do(req)
+		resp.Body.Close()
+
+		msg = router.SocketMessage{}
+		conn.ReadJSON(&msg)
+		assert.Equal(t, "event", msg.Type)
+		assert.Equal(t, event.TypeName("user.created"), msg.Event.EventType)
+		assert.Equal(t, map[string]interface{}{"name": "bob"}, msg.Event.Data)
+	})
+
+	t.Run("error if event type doesn't exist", func(t *testing.T) {
+		target.EXPECT().EventType("default", event.TypeName("user.unknown")).Return(nil)
+		conn := dialSocket(t, server, "default")
+		defer conn.Close()
+
+		msg := subscribeSocket(t, conn, "user.unknown")
+
+		assert.Equal(t, router.SocketMessage{
+			Type:      "error",
+			EventType: "user.unknown",
+			Message:   `Event Type "user.unknown" not found.`,
+		}, msg)
+	})
+
+	t.Run("error if event type has no authorizer", func(t *testing.T) {
+		target.EXPECT().EventType("default", event.TypeName("user.updated")).
+			Return(&event.Type{Space: "default", Name: "user.updated"})
+		conn := dialSocket(t, server, "default")
+		defer conn.Close()
+
+		msg := subscribeSocket(t, conn, "user.updated")
+
+		assert.Equal(t, router.SocketMessage{
+			Type:      "error",
+			EventType: "user.updated",
+			Message:   "event type has no authorizer, only event types with authorizer can be subscribed to",
+		}, msg)
+	})
+
+	t.Run("error if authorizer rejected subscription", func(t *testing.T) {
+		authorizer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"error":{"message":"token invalid"}}`))
+		}))
+		defer authorizer.Close()
+		authorizerID := function.ID("auth")
+		target.EXPECT().EventType("default", event.TypeName("user.deleted")).
+			Return(&event.Type{Space: "default", Name: "user.deleted", AuthorizerID: &authorizerID}).Times(2)
+		target.EXPECT().Function("default", authorizerID).Return(&function.Function{
+			Space:        "default",
+			ID:           authorizerID,
+			ProviderType: httpprovider.Type,
+			Provider:     &httpprovider.HTTP{URL: authorizer.URL},
+		})
+		conn := dialSocket(t, server, "default")
+		defer conn.Close()
+
+		msg := subscribeSocket(t, conn, "user.deleted")
+
+		assert.Equal(t, router.SocketMessage{Type: "error", EventType: "user.deleted", Message: "token invalid"}, msg)
+	})
+}
+
+func setupSocketServer(target router.Targeter) *httptest.Server {
+	streaming := router.Streaming{WebSocket: true, ServerSentEvents: true, BufferSize: 10}
+	router := setupTestRouter(target)
+	router.SetStreaming(streaming)
+	return httptest.NewServer(router)
+}
+
+func dialSocket(t *testing.T, server *httptest.Server, space string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/spaces/" + space + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	return conn
+}
+
+func subscribeSocket(t *testing.T, conn *websocket.Conn, eventType event.TypeName) router.SocketMessage {
+	conn.WriteJSON(router.SocketRequest{Action: "subscribe", EventType: eventType})
+	msg := router.SocketMessage{}
+	err := conn.ReadJSON(&msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
@@ -0,0 +1,404 @@
+package schema
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// avroDecoder decodes Avro binary encoded payload (without object container header) into
+// map[string]interface{} for records and corresponding Go types for other Avro types.
+type avroDecoder struct {
+	root *avroType
+}
+
+type avroType struct {
+	kind     string
+	name     string
+	fields   []avroField
+	symbols  []string
+	items    *avroType
+	values   *avroType
+	branches []*avroType
+	size     int
+	// itemSize is a minimal size in bytes of encoded array item.
+	itemSize int
+}
+
+type avroField struct {
+	name string
+	typ  *avroType
+}
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true,
+	"float": true, "double": true, "bytes": true, "string": true,
+}
+
+func newAvroDecoder(definition string) (*avroDecoder, error) {
+	var raw interface{}
+	err := json.Unmarshal([]byte(definition), &raw)
+	if err != nil {
+		return nil, errors.New("invalid Avro schema JSON: " + err.Error())
+	}
+
+	parser := &avroParser{named: map[string]*avroType{}}
+	root, err := parser.parse(raw, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &avroDecoder{root: root}, nil
+}
+
+// Decode implements Decoder interface.
+func (d *avroDecoder) Decode(payload []byte) (interface{}, error) {
+	reader := &avroReader{buf: payload}
+	value, err := reader.read(d.root)
+	if err != nil {
+		return nil, err
+	}
+	if reader.pos != len(payload) {
+		return nil, fmt.Errorf("%d trailing bytes after Avro value", len(payload)-reader.pos)
+	}
+	return value, nil
+}
+
+type avroParser struct {
+	named map[string]*avroType
+}
+
+// nolint: gocyclo
+func (p *avroParser) parse(raw interface{}, namespace string) (*avroType, error) {
+	switch schema := raw.(type) {
+	case string:
+		if avroPrimitives[schema] {
+			return &avroType{kind: schema}, nil
+		}
+		if named, ok := p.named[fullName(schema, namespace)]; ok {
+			return named, nil
+		}
+		if named, ok := p.named[schema]; ok {
+			return named, nil
+		}
+		return nil, fmt.Errorf("unknown Avro type %q", schema)
+	case []interface{}:
+		union := &avroType{kind: "union"}
+		for _, branch := range schema {
+			typ, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.branches = append(union.branches, typ)
+		}
+		return union, nil
+	case map[string]interface{}:
+		kind, _ := schema["type"].(string)
+		if ns, ok := schema["namespace"].(string); ok {
+			namespace = ns
+		}
+		name, _ := schema["name"].(string)
+
+		switch kind {
+		case "record", "error":
+			if name == "" {
+				return nil, errors.New("Avro record without name")
+			}
+			typ := &avroType{kind: "record", name: fullName(name, namespace)}
+			p.named[typ.name] = typ
+			fields, _ := schema["fields"].([]interface{})
+			for _, rawField := range fields {
+				field, ok := rawField.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("invalid field in Avro record %q", name)
+				}
+				fieldName, _ := field["name"].(string)
+				fieldType, err := p.parse(field["type"], namespaceOf(typ.name))
+				if err != nil {
+					return nil, err
+				}
+				typ.fields = append(typ.fields, avroField{name: fieldName, typ: fieldType})
+			}
+			return typ, nil
+		case "enum":
+			typ := &avroType{kind: "enum", name: fullName(name, namespace)}
+			symbols, _ := schema["symbols"].([]interface{})
+			for _, symbol := range symbols {
+				s, _ := symbol.(string)
+				typ.symbols = append(typ.symbols, s)
+			}
+			p.named[typ.name] = typ
+			return typ, nil
+		case "fixed":
+			size, ok := schema["size"].(float64)
+			if !ok {
+				return nil, fmt.Errorf("Avro fixed %q without size", name)
+			}
+			if size < 0 {
+				return nil, fmt.Errorf("Avro fixed %q with negative size", name)
+			}
+			typ := &avroType{kind: "fixed", name: fullName(name, namespace), size: int(size)}
+			p.named[typ.name] = typ
+			return typ, nil
+		case "array":
+			items, err := p.parse(schema["items"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroType{kind: "array", items: items, itemSize: minSize(items, map[*avroType]bool{})}, nil
+		case "map":
+			values, err := p.parse(schema["values"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroType{kind: "map", values: values}, nil
+		default:
+			// primitive type in object form, e.g. {"type": "string", "logicalType": "uuid"}
+			return p.parse(schema["type"], namespace)
+		}
+	}
+
+	return nil, fmt.Errorf("invalid Avro schema %v", raw)
+}
+
+// minSize returns minimal size in bytes of encoded value of the type. Recursive references to records are counted as
+// empty which only makes the size smaller.
+func minSize(typ *avroType, visiting map[*avroType]bool) int {
+	switch typ.kind {
+	case "boolean", "int", "long", "bytes", "string", "enum", "union", "array", "map":
+		return 1
+	case "float":
+		return 4
+	case "double":
+		return 8
+	case "fixed":
+		return typ.size
+	case "record":
+		if visiting[typ] {
+			return 0
+		}
+		visiting[typ] = true
+		defer delete(visiting, typ)
+
+		size := 0
+		for _, field := range typ.fields {
+			size += minSize(field.typ, visiting)
+		}
+		return size
+	}
+	return 0
+}
+
+func fullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func namespaceOf(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+type avroReader struct {
+	buf []byte
+	pos int
+	// empty is a number of array items read so far which encoded value has no bytes (e.g. nulls).
+	empty int64
+}
+
+// maxAvroEmptyItems limits number of array items without encoded bytes in a single payload. Number of other items is
+// limited by payload size.
+const maxAvroEmptyItems = 1 << 16
+
+var (
+	errAvroUnexpectedEnd  = errors.New("unexpected end of Avro payload")
+	errAvroBlockCount     = errors.New("Avro block count exceeds payload size")
+	errAvroTooManyEmpties = fmt.Errorf("Avro arrays have more than %d items without encoded bytes", maxAvroEmptyItems)
+)
+
+// nolint: gocyclo
+func (r *avroReader) read(typ *avroType) (interface{}, error) {
+	switch typ.kind {
+	case "null":
+		return nil, nil
+	case "boolean":
+		if r.pos >= len(r.buf) {
+			return nil, errAvroUnexpectedEnd
+		}
+		b := r.buf[r.pos]
+		r.pos++
+		return b != 0, nil
+	case "int":
+		v, err := r.long()
+		if err != nil {
+			return nil, err
+		}
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return nil, errors.New("Avro int out of range")
+		}
+		return int32(v), nil
+	case "long":
+		return r.long()
+	case "float":
+		b, err := r.fixed(4)
+		if err != nil {
+			return nil, err
+		}
+		value := math.Float32frombits(binary.LittleEndian.Uint32(b))
+		if s, ok := nonFinite(float64(value)); ok {
+			return s, nil
+		}
+		return value, nil
+	case "double":
+		b, err := r.fixed(8)
+		if err != nil {
+			return nil, err
+		}
+		value := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		if s, ok := nonFinite(value); ok {
+			return s, nil
+		}
+		return value, nil
+	case "bytes":
+		return r.bytes()
+	case "string":
+		b, err := r.bytes()
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case "fixed":
+		return r.fixed(typ.size)
+	case "enum":
+		i, err := r.long()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(typ.symbols) {
+			return nil, fmt.Errorf("Avro enum %q index %d out of range", typ.name, i)
+		}
+		return typ.symbols[i], nil
+	case "union":
+		i, err := r.long()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(typ.branches) {
+			return nil, fmt.Errorf("Avro union index %d out of range", i)
+		}
+		return r.read(typ.branches[i])
+	case "record":
+		record := map[string]interface{}{}
+		for _, field := range typ.fields {
+			value, err := r.read(field.typ)
+			if err != nil {
+				return nil, err
+			}
+			record[field.name] = value
+		}
+		return record, nil
+	case "array":
+		items := []interface{}{}
+		err := r.blocks(typ.itemSize, func() error {
+			item, err := r.read(typ.items)
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+			return nil
+		})
+		return items, err
+	case "map":
+		values := map[string]interface{}{}
+		// map entry has at least a key length
+		err := r.blocks(1, func() error {
+			key, err := r.bytes()
+			if err != nil {
+				return err
+			}
+			value, err := r.read(typ.values)
+			if err != nil {
+				return err
+			}
+			values[string(key)] = value
+			return nil
+		})
+		return values, err
+	}
+
+	return nil, fmt.Errorf("unsupported Avro type %q", typ.kind)
+}
+
+// blocks reads array or map blocks calling item for every element. Block count comes from the payload so it's
+// checked against remaining bytes, given that every element has at least itemSize bytes, before reading elements.
+func (r *avroReader) blocks(itemSize int, item func() error) error {
+	for {
+		count, err := r.long()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return nil
+		}
+		if count < 0 {
+			count = -count
+			// block size in bytes, not needed for sequential reading
+			if _, err := r.long(); err != nil {
+				return err
+			}
+		}
+		if count < 0 {
+			return errAvroBlockCount
+		}
+		if itemSize > 0 && count > int64((len(r.buf)-r.pos)/itemSize) {
+			return errAvroBlockCount
+		}
+		if itemSize == 0 {
+			if count > maxAvroEmptyItems-r.empty {
+				return errAvroTooManyEmpties
+			}
+			r.empty += count
+		}
+		for i := int64(0); i < count; i++ {
+			if err := item(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (r *avroReader) long() (int64, error) {
+	v, n := binary.Varint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errAvroUnexpectedEnd
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *avroReader) bytes() ([]byte, error) {
+	length, err := r.long()
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, errors.New("negative Avro bytes length")
+	}
+	return r.fixed(int(length))
+}
+
+func (r *avroReader) fixed(size int) ([]byte, error) {
+	if len(r.buf)-r.pos < size {
+		return nil, errAvroUnexpectedEnd
+	}
+	b := r.buf[r.pos : r.pos+size]
+	r.pos += size
+	return b, nil
+}
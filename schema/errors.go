@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"fmt"
+)
+
+// ErrSchemaNotFound occurs when schema cannot be found.
+type ErrSchemaNotFound struct {
+	ID ID
+}
+
+func (e ErrSchemaNotFound) Error() string {
+	return fmt.Sprintf("Schema %q not found.", e.ID)
+}
+
+// ErrSchemaAlreadyExists occurs when schema with the same ID already exists.
+type ErrSchemaAlreadyExists struct {
+	ID ID
+}
+
+func (e ErrSchemaAlreadyExists) Error() string {
+	return fmt.Sprintf("Schema %q already exists.", e.ID)
+}
+
+// ErrSchemaValidation occurs when schema payload doesn't validate.
+type ErrSchemaValidation struct {
+	Message string
+}
+
+func (e ErrSchemaValidation) Error() string {
+	return fmt.Sprintf("Schema doesn't validate. Validation error: %s", e.Message)
+}
+
+// ErrSchemaInUse occurs when schema cannot be deleted because it's referenced by an event type.
+type ErrSchemaInUse struct {
+	ID        ID
+	EventType string
+}
+
+func (e ErrSchemaInUse) Error() string {
+	return fmt.Sprintf("Schema %s cannot be deleted because is used by %s event type.", e.ID, e.EventType)
+}
+
+// ErrPayloadValidation occurs when event payload doesn't match the schema.
+type ErrPayloadValidation struct {
+	ID      ID
+	Message string
+}
+
+func (e ErrPayloadValidation) Error() string {
+	return fmt.Sprintf("Event payload doesn't match schema %q: %s", e.ID, e.Message)
+}
@@ -0,0 +1,373 @@
+package schema
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// protobufDecoder decodes Protocol Buffers wire format into map[string]interface{} following proto3 JSON mapping.
+// Definition of the schema is a base64 encoded FileDescriptorSet (output of protoc --descriptor_set_out
+// --include_imports).
+type protobufDecoder struct {
+	messages map[string]*descriptor.DescriptorProto
+	enums    map[string]*descriptor.EnumDescriptorProto
+	root     *descriptor.DescriptorProto
+}
+
+func newProtobufDecoder(definition, messageType string) (*protobufDecoder, error) {
+	if messageType == "" {
+		return nil, errors.New("message type is required for Protocol Buffers schema")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(definition)
+	if err != nil {
+		return nil, errors.New("definition is not base64 encoded FileDescriptorSet: " + err.Error())
+	}
+
+	set := &descriptor.FileDescriptorSet{}
+	err = proto.Unmarshal(raw, set)
+	if err != nil {
+		return nil, errors.New("invalid FileDescriptorSet: " + err.Error())
+	}
+
+	decoder := &protobufDecoder{
+		messages: map[string]*descriptor.DescriptorProto{},
+		enums:    map[string]*descriptor.EnumDescriptorProto{},
+	}
+	for _, file := range set.File {
+		prefix := ""
+		if file.GetPackage() != "" {
+			prefix = "." + file.GetPackage()
+		}
+		for _, message := range file.MessageType {
+			decoder.registerMessage(prefix, message)
+		}
+		for _, enum := range file.EnumType {
+			decoder.enums[prefix+"."+enum.GetName()] = enum
+		}
+	}
+
+	root, ok := decoder.messages["."+strings.TrimPrefix(messageType, ".")]
+	if !ok {
+		return nil, fmt.Errorf("message type %q not found in FileDescriptorSet", messageType)
+	}
+	decoder.root = root
+
+	return decoder, nil
+}
+
+func (d *protobufDecoder) registerMessage(prefix string, message *descriptor.DescriptorProto) {
+	name := prefix + "." + message.GetName()
+	d.messages[name] = message
+	for _, nested := range message.NestedType {
+		d.registerMessage(name, nested)
+	}
+	for _, enum := range message.EnumType {
+		d.enums[name+"."+enum.GetName()] = enum
+	}
+}
+
+// Decode implements Decoder interface.
+func (d *protobufDecoder) Decode(payload []byte) (interface{}, error) {
+	return d.decodeMessage(d.root, payload)
+}
+
+// nolint: gocyclo
+func (d *protobufDecoder) decodeMessage(message *descriptor.DescriptorProto, payload []byte) (map[string]interface{}, error) {
+	fields := map[int32]*descriptor.FieldDescriptorProto{}
+	for _, field := range message.Field {
+		fields[field.GetNumber()] = field
+	}
+
+	result := map[string]interface{}{}
+	reader := &wireReader{buf: payload}
+	for !reader.done() {
+		key, err := reader.varint()
+		if err != nil {
+			return nil, err
+		}
+		number := int32(key >> 3)
+		wireType := int(key & 0x7)
+
+		field, known := fields[number]
+		if !known {
+			if err := reader.skip(wireType); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if wireType == proto.WireBytes && isPackable(field) {
+			raw, err := reader.bytes()
+			if err != nil {
+				return nil, err
+			}
+			packed := &wireReader{buf: raw}
+			for !packed.done() {
+				value, err := d.decodeScalar(field, packed, scalarWireType(field))
+				if err != nil {
+					return nil, err
+				}
+				appendValue(result, field, value)
+			}
+			continue
+		}
+
+		value, err := d.decodeValue(field, reader, wireType)
+		if err != nil {
+			return nil, err
+		}
+
+		if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE && d.isMapEntry(field) {
+			entry := value.(map[string]interface{})
+			m, ok := result[jsonName(field)].(map[string]interface{})
+			if !ok {
+				m = map[string]interface{}{}
+				result[jsonName(field)] = m
+			}
+			key, ok := entry["key"]
+			if !ok {
+				key = d.zeroMapKey(field)
+			}
+			m[fmt.Sprint(key)] = entry["value"]
+			continue
+		}
+
+		if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+			appendValue(result, field, value)
+		} else {
+			result[jsonName(field)] = value
+		}
+	}
+
+	return result, nil
+}
+
+func (d *protobufDecoder) decodeValue(field *descriptor.FieldDescriptorProto, reader *wireReader, wireType int) (interface{}, error) {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES:
+		if wireType != proto.WireBytes {
+			return nil, fmt.Errorf("invalid wire type %d for field %q", wireType, field.GetName())
+		}
+	}
+
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		raw, err := reader.bytes()
+		if err != nil {
+			return nil, err
+		}
+		message, ok := d.messages[field.GetTypeName()]
+		if !ok {
+			return nil, fmt.Errorf("message type %q not found", field.GetTypeName())
+		}
+		return d.decodeMessage(message, raw)
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		raw, err := reader.bytes()
+		if err != nil {
+			return nil, err
+		}
+		return string(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return reader.bytes()
+	}
+
+	if wireType != scalarWireType(field) {
+		return nil, fmt.Errorf("invalid wire type %d for field %q", wireType, field.GetName())
+	}
+	return d.decodeScalar(field, reader, wireType)
+}
+
+// nolint: gocyclo
+func (d *protobufDecoder) decodeScalar(field *descriptor.FieldDescriptorProto, reader *wireReader, wireType int) (interface{}, error) {
+	var raw uint64
+	var err error
+	switch wireType {
+	case proto.WireVarint:
+		raw, err = reader.varint()
+	case proto.WireFixed64:
+		raw, err = reader.fixed(8)
+	case proto.WireFixed32:
+		raw, err = reader.fixed(4)
+	default:
+		return nil, fmt.Errorf("invalid wire type %d for field %q", wireType, field.GetName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 64-bit integers are represented as strings in proto3 JSON mapping
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return raw != 0, nil
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return int32(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return uint32(raw), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT32:
+		return int32(uint32(raw)>>1) ^ -int32(raw&1), nil
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return strconv.FormatInt(int64(raw), 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return strconv.FormatUint(raw, 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return strconv.FormatInt(int64(raw>>1)^-int64(raw&1), 10), nil
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		value := math.Float32frombits(uint32(raw))
+		if s, ok := nonFinite(float64(value)); ok {
+			return s, nil
+		}
+		return value, nil
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		value := math.Float64frombits(raw)
+		if s, ok := nonFinite(value); ok {
+			return s, nil
+		}
+		return value, nil
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		if enum, ok := d.enums[field.GetTypeName()]; ok {
+			for _, value := range enum.Value {
+				if value.GetNumber() == int32(raw) {
+					return value.GetName(), nil
+				}
+			}
+		}
+		return int32(raw), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type of field %q", field.GetName())
+}
+
+func (d *protobufDecoder) isMapEntry(field *descriptor.FieldDescriptorProto) bool {
+	message, ok := d.messages[field.GetTypeName()]
+	return ok && message.GetOptions().GetMapEntry()
+}
+
+// zeroMapKey returns default value of the key of map field. It's used for map entries without key.
+func (d *protobufDecoder) zeroMapKey(field *descriptor.FieldDescriptorProto) interface{} {
+	for _, key := range d.messages[field.GetTypeName()].Field {
+		if key.GetNumber() != 1 {
+			continue
+		}
+		switch key.GetType() {
+		case descriptor.FieldDescriptorProto_TYPE_STRING:
+			return ""
+		case descriptor.FieldDescriptorProto_TYPE_BOOL:
+			return false
+		}
+		return 0
+	}
+	return ""
+}
+
+func isPackable(field *descriptor.FieldDescriptorProto) bool {
+	if field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return false
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES,
+		descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return false
+	}
+	return true
+}
+
+func scalarWireType(field *descriptor.FieldDescriptorProto) int {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return proto.WireFixed64
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return proto.WireFixed32
+	}
+	return proto.WireVarint
+}
+
+func appendValue(result map[string]interface{}, field *descriptor.FieldDescriptorProto, value interface{}) {
+	values, _ := result[jsonName(field)].([]interface{})
+	result[jsonName(field)] = append(values, value)
+}
+
+func jsonName(field *descriptor.FieldDescriptorProto) string {
+	if field.GetJsonName() != "" {
+		return field.GetJsonName()
+	}
+	return field.GetName()
+}
+
+// wireReader reads Protocol Buffers wire format primitives.
+type wireReader struct {
+	buf []byte
+	pos int
+}
+
+var errProtobufUnexpectedEnd = errors.New("unexpected end of Protocol Buffers payload")
+
+func (r *wireReader) done() bool {
+	return r.pos >= len(r.buf)
+}
+
+func (r *wireReader) varint() (uint64, error) {
+	v, n := proto.DecodeVarint(r.buf[r.pos:])
+	if n == 0 {
+		return 0, errProtobufUnexpectedEnd
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *wireReader) fixed(size int) (uint64, error) {
+	if len(r.buf)-r.pos < size {
+		return 0, errProtobufUnexpectedEnd
+	}
+	var v uint64
+	for i := size - 1; i >= 0; i-- {
+		v = v<<8 | uint64(r.buf[r.pos+i])
+	}
+	r.pos += size
+	return v, nil
+}
+
+func (r *wireReader) bytes() ([]byte, error) {
+	length, err := r.varint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(r.buf)-r.pos) < length {
+		return nil, errProtobufUnexpectedEnd
+	}
+	b := r.buf[r.pos : r.pos+int(length)]
+	r.pos += int(length)
+	return b, nil
+}
+
+func (r *wireReader) skip(wireType int) error {
+	var err error
+	switch wireType {
+	case proto.WireVarint:
+		_, err = r.varint()
+	case proto.WireFixed64:
+		_, err = r.fixed(8)
+	case proto.WireBytes:
+		_, err = r.bytes()
+	case proto.WireFixed32:
+		_, err = r.fixed(4)
+	default:
+		err = fmt.Errorf("unsupported wire type %d", wireType)
+	}
+	return err
+}
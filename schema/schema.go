@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"errors"
+	"math"
+
+	"github.com/serverless/event-gateway/metadata"
+	"go.uber.org/zap/zapcore"
+)
+
+// ID uniquely identifies a schema.
+type ID string
+
+// Format of a schema definition.
+type Format string
+
+const (
+	// FormatProtobuf is a schema defined as a Protocol Buffers message type in a FileDescriptorSet.
+	FormatProtobuf = Format("protobuf")
+	// FormatAvro is a schema defined as an Apache Avro JSON schema.
+	FormatAvro = Format("avro")
+)
+
+// Schema is a registered definition of binary event payload. It's used for validating and decoding
+// payloads of events which event type references the schema.
+type Schema struct {
+	Space      string `json:"space" validate:"required,min=3,space"`
+	ID         ID     `json:"schemaId" validate:"required,schemaid"`
+	Format     Format `json:"format" validate:"required,eq=protobuf|eq=avro"`
+	Definition string `json:"definition" validate:"required"`
+	// MessageType is a fully qualified name of Protocol Buffers message type (e.g. "acme.users.UserCreated").
+	MessageType string `json:"messageType,omitempty"`
+
+	Metadata metadata.Metadata `json:"metadata,omitempty"`
+
+	decoder Decoder
+}
+
+// Schemas is an array of schemas.
+type Schemas []*Schema
+
+// Decoder decodes binary payload into data structure that can be marshaled to JSON.
+type Decoder interface {
+	Decode(payload []byte) (interface{}, error)
+}
+
+// Compile parses schema definition and prepares decoder. It has to be called before Decode.
+func (s *Schema) Compile() error {
+	var decoder Decoder
+	var err error
+
+	switch s.Format {
+	case FormatAvro:
+		decoder, err = newAvroDecoder(s.Definition)
+	case FormatProtobuf:
+		decoder, err = newProtobufDecoder(s.Definition, s.MessageType)
+	default:
+		err = errors.New("unsupported schema format " + string(s.Format))
+	}
+	if err != nil {
+		return err
+	}
+
+	s.decoder = decoder
+	return nil
+}
+
+// Decode validates payload against the schema and returns decoded data.
+func (s *Schema) Decode(payload []byte) (interface{}, error) {
+	if s.decoder == nil {
+		return nil, errors.New("schema not compiled")
+	}
+
+	data, err := s.decoder.Decode(payload)
+	if err != nil {
+		return nil, &ErrPayloadValidation{ID: s.ID, Message: err.Error()}
+	}
+	return data, nil
+}
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface
+func (s Schema) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("space", s.Space)
+	enc.AddString("schemaId", string(s.ID))
+	enc.AddString("format", string(s.Format))
+	if s.MessageType != "" {
+		enc.AddString("messageType", s.MessageType)
+	}
+
+	return nil
+}
+
+// nonFinite returns NaN and infinities as "NaN", "Infinity" and "-Infinity" strings (like proto3 JSON mapping does)
+// because they can't be marshaled to JSON.
+func nonFinite(f float64) (string, bool) {
+	switch {
+	case math.IsNaN(f):
+		return "NaN", true
+	case math.IsInf(f, 1):
+		return "Infinity", true
+	case math.IsInf(f, -1):
+		return "-Infinity", true
+	}
+	return "", false
+}
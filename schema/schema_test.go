@@ -0,0 +1,298 @@
+package schema_test
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/serverless/event-gateway/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+const avroUserSchema = `{
+	"type": "record",
+	"name": "User",
+	"namespace": "acme",
+	"fields": [
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": "int"},
+		{"name": "email", "type": ["null", "string"]},
+		{"name": "role", "type": {"type": "enum", "name": "Role", "symbols": ["ADMIN", "MEMBER"]}},
+		{"name": "tags", "type": {"type": "array", "items": "string"}}
+	]
+}`
+
+func TestAvroDecode(t *testing.T) {
+	s := &schema.Schema{ID: "user", Format: schema.FormatAvro, Definition: avroUserSchema}
+	assert.Nil(t, s.Compile())
+
+	t.Run("valid payload", func(t *testing.T) {
+		payload := []byte{
+			0x08, 'j', 'o', 'h', 'n', // name
+			0x54,                      // age 42
+			0x02, 0x06, 'j', '@', 'x', // email union branch 1
+			0x02,                  // role MEMBER
+			0x02, 0x02, 'a', 0x00, // tags ["a"]
+		}
+
+		data, err := s.Decode(payload)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"name":  "john",
+			"age":   int32(42),
+			"email": "j@x",
+			"role":  "MEMBER",
+			"tags":  []interface{}{"a"},
+		}, data)
+	})
+
+	t.Run("truncated payload", func(t *testing.T) {
+		_, err := s.Decode([]byte{0x08, 'j', 'o'})
+
+		assert.EqualError(t, err, `Event payload doesn't match schema "user": unexpected end of Avro payload`)
+	})
+
+	t.Run("trailing bytes", func(t *testing.T) {
+		_, err := s.Decode([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0xff})
+
+		assert.EqualError(t, err, `Event payload doesn't match schema "user": 1 trailing bytes after Avro value`)
+	})
+}
+
+func TestAvroDecode_BlockCount(t *testing.T) {
+	t.Run("count larger than payload", func(t *testing.T) {
+		s := &schema.Schema{ID: "tags", Format: schema.FormatAvro, Definition: `{"type": "array", "items": "string"}`}
+		assert.Nil(t, s.Compile())
+
+		_, err := s.Decode(append(varint(1000), 0x02, 'a', 0x00))
+
+		assert.EqualError(t, err, `Event payload doesn't match schema "tags": Avro block count exceeds payload size`)
+	})
+
+	t.Run("too many nulls", func(t *testing.T) {
+		s := &schema.Schema{ID: "nulls", Format: schema.FormatAvro, Definition: `{"type": "array", "items": "null"}`}
+		assert.Nil(t, s.Compile())
+
+		_, err := s.Decode(append(varint(1<<62), 0x00))
+
+		assert.EqualError(t, err,
+			`Event payload doesn't match schema "nulls": Avro arrays have more than 65536 items without encoded bytes`)
+	})
+
+	t.Run("too many empty records in blocks", func(t *testing.T) {
+		s := &schema.Schema{ID: "empty", Format: schema.FormatAvro,
+			Definition: `{"type": "array", "items": {"type": "record", "name": "Empty", "fields": []}}`}
+		assert.Nil(t, s.Compile())
+
+		payload := []byte{}
+		for i := 0; i < 3; i++ {
+			payload = append(payload, varint(1<<15)...)
+		}
+
+		_, err := s.Decode(append(payload, 0x00))
+
+		assert.EqualError(t, err,
+			`Event payload doesn't match schema "empty": Avro arrays have more than 65536 items without encoded bytes`)
+	})
+
+	t.Run("empty records", func(t *testing.T) {
+		s := &schema.Schema{ID: "empty", Format: schema.FormatAvro,
+			Definition: `{"type": "array", "items": {"type": "record", "name": "Empty", "fields": []}}`}
+		assert.Nil(t, s.Compile())
+
+		data, err := s.Decode(append(varint(2), 0x00))
+
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{map[string]interface{}{}, map[string]interface{}{}}, data)
+	})
+}
+
+func TestAvroDecode_NonFiniteDouble(t *testing.T) {
+	s := &schema.Schema{ID: "double", Format: schema.FormatAvro, Definition: `"double"`}
+	assert.Nil(t, s.Compile())
+	payload := make([]byte, 8)
+	binary.LittleEndian.PutUint64(payload, math.Float64bits(math.Inf(-1)))
+
+	data, err := s.Decode(payload)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "-Infinity", data)
+}
+
+func TestAvroCompile_InvalidSchema(t *testing.T) {
+	s := &schema.Schema{Format: schema.FormatAvro, Definition: `{"type": "record", "fields": []}`}
+
+	assert.EqualError(t, s.Compile(), "Avro record without name")
+}
+
+func TestAvroCompile_NegativeFixedSize(t *testing.T) {
+	s := &schema.Schema{Format: schema.FormatAvro, Definition: `{"type": "fixed", "name": "hash", "size": -1}`}
+
+	assert.EqualError(t, s.Compile(), `Avro fixed "hash" with negative size`)
+}
+
+func TestProtobufDecode(t *testing.T) {
+	s := &schema.Schema{
+		ID:          "user",
+		Format:      schema.FormatProtobuf,
+		Definition:  testDescriptorSet(),
+		MessageType: "acme.User",
+	}
+	assert.Nil(t, s.Compile())
+
+	t.Run("valid payload", func(t *testing.T) {
+		buf := proto.NewBuffer(nil)
+		buf.EncodeVarint(1<<3 | proto.WireBytes)
+		buf.EncodeStringBytes("john")
+		buf.EncodeVarint(2<<3 | proto.WireVarint)
+		buf.EncodeVarint(42)
+		buf.EncodeVarint(3<<3 | proto.WireVarint)
+		buf.EncodeVarint(1)
+		buf.EncodeVarint(4<<3 | proto.WireBytes)
+		buf.EncodeRawBytes([]byte{1, 2})
+
+		data, err := s.Decode(buf.Bytes())
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"name":  "john",
+			"id":    "42",
+			"role":  "MEMBER",
+			"flags": []interface{}{int32(1), int32(2)},
+		}, data)
+	})
+
+	t.Run("map entry without key", func(t *testing.T) {
+		entry := proto.NewBuffer(nil)
+		entry.EncodeVarint(2<<3 | proto.WireBytes)
+		entry.EncodeStringBytes("blue")
+		buf := proto.NewBuffer(nil)
+		buf.EncodeVarint(5<<3 | proto.WireBytes)
+		buf.EncodeRawBytes(entry.Bytes())
+
+		data, err := s.Decode(buf.Bytes())
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"labels": map[string]interface{}{"": "blue"}}, data)
+	})
+
+	t.Run("non-finite double", func(t *testing.T) {
+		buf := proto.NewBuffer(nil)
+		buf.EncodeVarint(6<<3 | proto.WireFixed64)
+		buf.EncodeFixed64(math.Float64bits(math.NaN()))
+
+		data, err := s.Decode(buf.Bytes())
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"score": "NaN"}, data)
+	})
+
+	t.Run("invalid wire type", func(t *testing.T) {
+		_, err := s.Decode([]byte{1<<3 | proto.WireFixed32, 0x00, 0x00, 0x00, 0x00})
+
+		assert.EqualError(t, err, `Event payload doesn't match schema "user": invalid wire type 5 for field "name"`)
+	})
+}
+
+func TestProtobufCompile_UnknownMessageType(t *testing.T) {
+	s := &schema.Schema{Format: schema.FormatProtobuf, Definition: testDescriptorSet(), MessageType: "acme.Unknown"}
+
+	assert.EqualError(t, s.Compile(), `message type "acme.Unknown" not found in FileDescriptorSet`)
+}
+
+func testDescriptorSet() string {
+	set := &descriptor.FileDescriptorSet{
+		File: []*descriptor.FileDescriptorProto{{
+			Name:    proto.String("user.proto"),
+			Package: proto.String("acme"),
+			EnumType: []*descriptor.EnumDescriptorProto{{
+				Name: proto.String("Role"),
+				Value: []*descriptor.EnumValueDescriptorProto{
+					{Name: proto.String("ADMIN"), Number: proto.Int32(0)},
+					{Name: proto.String("MEMBER"), Number: proto.Int32(1)},
+				},
+			}},
+			MessageType: []*descriptor.DescriptorProto{{
+				Name: proto.String("User"),
+				Field: []*descriptor.FieldDescriptorProto{
+					{
+						Name:     proto.String("name"),
+						JsonName: proto.String("name"),
+						Number:   proto.Int32(1),
+						Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+					},
+					{
+						Name:     proto.String("id"),
+						JsonName: proto.String("id"),
+						Number:   proto.Int32(2),
+						Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptor.FieldDescriptorProto_TYPE_INT64.Enum(),
+					},
+					{
+						Name:     proto.String("role"),
+						JsonName: proto.String("role"),
+						Number:   proto.Int32(3),
+						Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptor.FieldDescriptorProto_TYPE_ENUM.Enum(),
+						TypeName: proto.String(".acme.Role"),
+					},
+					{
+						Name:     proto.String("flags"),
+						JsonName: proto.String("flags"),
+						Number:   proto.Int32(4),
+						Label:    descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+						Type:     descriptor.FieldDescriptorProto_TYPE_INT32.Enum(),
+					},
+					{
+						Name:     proto.String("labels"),
+						JsonName: proto.String("labels"),
+						Number:   proto.Int32(5),
+						Label:    descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+						Type:     descriptor.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+						TypeName: proto.String(".acme.User.LabelsEntry"),
+					},
+					{
+						Name:     proto.String("score"),
+						JsonName: proto.String("score"),
+						Number:   proto.Int32(6),
+						Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptor.FieldDescriptorProto_TYPE_DOUBLE.Enum(),
+					},
+				},
+				NestedType: []*descriptor.DescriptorProto{{
+					Name: proto.String("LabelsEntry"),
+					Field: []*descriptor.FieldDescriptorProto{
+						{
+							Name:     proto.String("key"),
+							JsonName: proto.String("key"),
+							Number:   proto.Int32(1),
+							Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:     descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+						},
+						{
+							Name:     proto.String("value"),
+							JsonName: proto.String("value"),
+							Number:   proto.Int32(2),
+							Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:     descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+						},
+					},
+					Options: &descriptor.MessageOptions{MapEntry: proto.Bool(true)},
+				}},
+			}},
+		}},
+	}
+
+	raw, _ := proto.Marshal(set)
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func varint(v int64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutVarint(buf, v)]
+}
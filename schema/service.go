@@ -0,0 +1,12 @@
+package schema
+
+import "github.com/serverless/event-gateway/metadata"
+
+// Service represents service for managing schemas.
+type Service interface {
+	GetSchema(space string, id ID) (*Schema, error)
+	ListSchemas(space string, filters ...metadata.Filter) (Schemas, error)
+	CreateSchema(s *Schema) (*Schema, error)
+	UpdateSchema(s *Schema) (*Schema, error)
+	DeleteSchema(space string, id ID) error
+}
@@ -0,0 +1,42 @@
+package secret
+
+import (
+	"fmt"
+)
+
+// ErrSecretNotFound occurs when secret cannot be found.
+type ErrSecretNotFound struct {
+	Name Name
+}
+
+func (e ErrSecretNotFound) Error() string {
+	return fmt.Sprintf("Secret %q not found.", e.Name)
+}
+
+// ErrSecretAlreadyExists occurs when secret with the same name already exists.
+type ErrSecretAlreadyExists struct {
+	Name Name
+}
+
+func (e ErrSecretAlreadyExists) Error() string {
+	return fmt.Sprintf("Secret %q already exists.", e.Name)
+}
+
+// ErrSecretValidation occurs when secret payload doesn't validate.
+type ErrSecretValidation struct {
+	Message string
+}
+
+func (e ErrSecretValidation) Error() string {
+	return fmt.Sprintf("Secret doesn't validate. Validation error: %s", e.Message)
+}
+
+// ErrSecretInUse occurs when secret cannot be deleted because it's referenced by a function.
+type ErrSecretInUse struct {
+	Name       Name
+	FunctionID string
+}
+
+func (e ErrSecretInUse) Error() string {
+	return fmt.Sprintf("Secret %s cannot be deleted because is used by %s function.", e.Name, e.FunctionID)
+}
@@ -0,0 +1,34 @@
+package secret
+
+import (
+	"github.com/serverless/event-gateway/metadata"
+	"go.uber.org/zap/zapcore"
+)
+
+// Name uniquely identifies a secret in the space.
+type Name string
+
+// Secret is a sensitive value (e.g. AWS secret access key) referenced by function provider configs. Secret is written
+// once and its value is never returned by the Configuration API. Provider config fields reference secrets with
+// {"secretRef": "<name>"} object instead of a plain value.
+type Secret struct {
+	Space string `json:"space" validate:"required,min=3,space"`
+	Name  Name   `json:"name" validate:"required,secretname"`
+	Value string `json:"value,omitempty" validate:"required"`
+
+	Metadata metadata.Metadata `json:"metadata,omitempty"`
+}
+
+// Secrets is an array of secrets.
+type Secrets []*Secret
+
+// MarshalLogObject is a part of zapcore.ObjectMarshaler interface
+func (s Secret) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("space", s.Space)
+	enc.AddString("name", string(s.Name))
+	if s.Value != "" {
+		enc.AddString("value", "*****")
+	}
+
+	return nil
+}
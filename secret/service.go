@@ -0,0 +1,11 @@
+package secret
+
+import "github.com/serverless/event-gateway/metadata"
+
+// Service represents service for managing secrets. Returned secrets don't include values.
+type Service interface {
+	GetSecret(space string, name Name) (*Secret, error)
+	ListSecrets(space string, filters ...metadata.Filter) (Secrets, error)
+	CreateSecret(s *Secret) (*Secret, error)
+	DeleteSecret(space string, name Name) error
+}
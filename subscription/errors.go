@@ -48,3 +48,10 @@ type ErrPathConfict struct {
 func (e ErrPathConfict) Error() string {
 	return fmt.Sprintf("Subscription path conflict: %s", e.Message)
 }
+
+// ErrSubscriptionsModified occurs when subscriptions were modified concurrently during update or swap.
+type ErrSubscriptionsModified struct{}
+
+func (e ErrSubscriptionsModified) Error() string {
+	return "Subscriptions were modified concurrently. Changes were not applied."
+}
@@ -9,4 +9,5 @@ type Service interface {
 	CreateSubscription(s *Subscription) (*Subscription, error)
 	UpdateSubscription(id ID, s *Subscription) (*Subscription, error)
 	DeleteSubscription(space string, id ID) error
+	SwapSubscriptions(space string, retargets []Retarget) (Subscriptions, error)
 }
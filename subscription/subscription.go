@@ -1,6 +1,9 @@
 package subscription
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/serverless/event-gateway/event"
 	"github.com/serverless/event-gateway/function"
 	"github.com/serverless/event-gateway/metadata"
@@ -9,17 +12,52 @@ import (
 
 // Subscription maps event type to a function.
 type Subscription struct {
-	Space      string         `json:"space" validate:"required,min=3,space"`
-	ID         ID             `json:"subscriptionId"`
-	Type       Type           `json:"type" validate:"required,eq=async|eq=sync"`
-	EventType  event.TypeName `json:"eventType" validate:"required,eventType"`
-	FunctionID function.ID    `json:"functionId" validate:"required"`
-	Path       string         `json:"path" validate:"required,urlPath"`
-	Method     string         `json:"method" validate:"required,eq=GET|eq=POST|eq=DELETE|eq=PUT|eq=PATCH|eq=HEAD|eq=OPTIONS"`
+	Space       string         `json:"space" validate:"required,min=3,space"`
+	ID          ID             `json:"subscriptionId"`
+	Type        Type           `json:"type" validate:"required,eq=async|eq=sync"`
+	EventType   event.TypeName `json:"eventType" validate:"required,eventType"`
+	FunctionID  function.ID    `json:"functionId" validate:"required"`
+	Path        string         `json:"path" validate:"required,urlPath"`
+	Method      string         `json:"method" validate:"required,eq=GET|eq=POST|eq=DELETE|eq=PUT|eq=PATCH|eq=HEAD|eq=OPTIONS"`
+	PayloadMode PayloadMode    `json:"payloadMode,omitempty" validate:"omitempty,eq=passthrough|eq=decode"`
+
+	// Targets split events between the function and additional functions e.g. for canary releases. Weight of a target
+	// is a percentage of events delivered to it. FunctionID receives the remaining share.
+	Targets []Target `json:"targets,omitempty" validate:"omitempty,dive"`
+	Sticky  *Sticky  `json:"sticky,omitempty"`
 
 	Metadata metadata.Metadata `json:"metadata,omitempty"`
 }
 
+// Target is an additional function receiving a share of subscription events.
+type Target struct {
+	FunctionID function.ID `json:"functionId" validate:"required"`
+	Weight     uint        `json:"weight" validate:"max=100"`
+}
+
+// Sticky routing config. Events with the same key are delivered to the same target. Key is a value of the HTTP request
+// header or the event attribute. Attribute is a dot separated path in the event e.g. "source", "extensions.tenant" or
+// "data.userId".
+type Sticky struct {
+	Header    string `json:"header,omitempty"`
+	Attribute string `json:"attribute,omitempty"`
+}
+
+// FunctionIDs returns IDs of all functions receiving subscription events.
+func (s Subscription) FunctionIDs() []function.ID {
+	ids := []function.ID{s.FunctionID}
+	for _, target := range s.Targets {
+		ids = append(ids, target.FunctionID)
+	}
+	return ids
+}
+
+// Retarget changes the function of an existing subscription. Used by the swap operation.
+type Retarget struct {
+	SubscriptionID ID          `json:"subscriptionId" validate:"required"`
+	FunctionID     function.ID `json:"functionId" validate:"required"`
+}
+
 // ID uniquely identifies a subscription.
 type ID string
 
@@ -33,6 +71,16 @@ const (
 	TypeAsync = Type("async")
 )
 
+// PayloadMode of subscription.
+type PayloadMode string
+
+const (
+	// PayloadModePassthrough causes that payload is validated against the schema and delivered as is.
+	PayloadModePassthrough = PayloadMode("passthrough")
+	// PayloadModeDecode causes that payload is decoded with the schema and delivered as JSON.
+	PayloadModeDecode = PayloadMode("decode")
+)
+
 // Subscriptions is an array of subscriptions.
 type Subscriptions []*Subscription
 
@@ -43,12 +91,22 @@ func (s Subscription) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddString("type", string(s.Type))
 	enc.AddString("eventType", string(s.EventType))
 	enc.AddString("functionId", string(s.FunctionID))
+	if len(s.Targets) > 0 {
+		targets := []string{}
+		for _, target := range s.Targets {
+			targets = append(targets, fmt.Sprintf("%s:%d", target.FunctionID, target.Weight))
+		}
+		enc.AddString("targets", strings.Join(targets, ","))
+	}
 	if s.Method != "" {
 		enc.AddString("method", string(s.Method))
 	}
 	if s.Path != "" {
 		enc.AddString("path", string(s.Path))
 	}
+	if s.PayloadMode != "" {
+		enc.AddString("payloadMode", string(s.PayloadMode))
+	}
 
 	return nil
 }
@@ -0,0 +1,130 @@
+// Package webhook implements signing of requests sent by HTTP function provider. Go receivers can use Verify or
+// VerifyRequest to check that the request was sent by Event Gateway and wasn't modified or replayed.
+//
+// Signature header has "t=<timestamp>,v1=<signature>" format where timestamp is a Unix time of signing and signature is
+// a hex encoded HMAC-SHA256 of "<timestamp>.<body>". During secret rotation the header contains one v1 signature per
+// active secret e.g. "t=1526919030,v1=5257a8...,v1=b1e3f0...".
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader is a name of the header carrying request signature.
+	SignatureHeader = "Event-Gateway-Signature"
+	// DefaultTolerance is a recommended maximum age of the signature.
+	DefaultTolerance = 5 * time.Minute
+
+	timestampKey = "t"
+	signatureKey = "v1"
+)
+
+var (
+	// ErrInvalidHeader occurs when signature header is missing or malformed.
+	ErrInvalidHeader = errors.New("signature header is missing or malformed")
+	// ErrNoValidSignature occurs when none of the signatures matches the payload and secrets.
+	ErrNoValidSignature = errors.New("no valid signature found")
+	// ErrTimestampOutOfTolerance occurs when the signature is older, or newer, than allowed tolerance.
+	ErrTimestampOutOfTolerance = errors.New("signature timestamp is out of tolerance")
+)
+
+// Sign returns signature header value for the payload signed at given time. One signature is added per secret.
+func Sign(payload []byte, timestamp time.Time, secrets ...string) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	parts := []string{timestampKey + "=" + unix}
+	for _, secret := range secrets {
+		parts = append(parts, signatureKey+"="+hex.EncodeToString(sign(payload, unix, secret)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// Verify checks that the header contains signature of the payload made with one of the secrets and that the signature
+// timestamp is within tolerance from now. Zero tolerance disables timestamp check.
+func Verify(payload []byte, header string, tolerance time.Duration, secrets ...string) error {
+	unix, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if tolerance > 0 {
+		timestamp, _ := strconv.ParseInt(unix, 10, 64)
+		age := time.Since(time.Unix(timestamp, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrTimestampOutOfTolerance
+		}
+	}
+
+	for _, secret := range secrets {
+		expected := sign(payload, unix, secret)
+		for _, signature := range signatures {
+			if hmac.Equal(expected, signature) {
+				return nil
+			}
+		}
+	}
+	return ErrNoValidSignature
+}
+
+// VerifyRequest reads the request body and verifies it with the signature from SignatureHeader. The body is replaced
+// so it can be read again by the caller. It returns the body.
+func VerifyRequest(r *http.Request, tolerance time.Duration, secrets ...string) ([]byte, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	err = Verify(body, r.Header.Get(SignatureHeader), tolerance, secrets...)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func sign(payload []byte, timestamp, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// parseHeader returns timestamp and decoded signatures from the header. Unknown keys are ignored so new signature
+// schemes can be added without breaking receivers.
+func parseHeader(header string) (string, [][]byte, error) {
+	unix := ""
+	signatures := [][]byte{}
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			return "", nil, ErrInvalidHeader
+		}
+		switch kv[0] {
+		case timestampKey:
+			if _, err := strconv.ParseInt(kv[1], 10, 64); err != nil {
+				return "", nil, ErrInvalidHeader
+			}
+			unix = kv[1]
+		case signatureKey:
+			signature, err := hex.DecodeString(kv[1])
+			if err != nil {
+				continue
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+	if unix == "" || len(signatures) == 0 {
+		return "", nil, ErrInvalidHeader
+	}
+	return unix, signatures, nil
+}
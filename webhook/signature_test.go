@@ -0,0 +1,67 @@
+package webhook_test
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/serverless/event-gateway/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	header := webhook.Sign([]byte(`{"eventType":"user.created"}`), time.Unix(1526919030, 0), "secret", "new-secret")
+
+	assert.Equal(t, "t=1526919030,"+
+		"v1=8de0fed686f51525201610d7037d51fc8fc8a44c0eade1873a18a0e4760fab90,"+
+		"v1=a8d92b3b8b8b80c3f88ac8e1338845a611787473787c065f4937f7c0808f2656", header)
+}
+
+func TestVerify(t *testing.T) {
+	payload := []byte(`{"eventType":"user.created"}`)
+	now := time.Now()
+
+	for _, testCase := range []struct {
+		header        string
+		secrets       []string
+		expectedError error
+	}{
+		{webhook.Sign(payload, now, "secret"), []string{"secret"}, nil},
+		{webhook.Sign(payload, now, "old-secret", "secret"), []string{"secret"}, nil},
+		{webhook.Sign(payload, now, "secret"), []string{"new-secret", "secret"}, nil},
+		{webhook.Sign(payload, now, "secret"), []string{"wrong"}, webhook.ErrNoValidSignature},
+		{webhook.Sign([]byte("tampered"), now, "secret"), []string{"secret"}, webhook.ErrNoValidSignature},
+		{webhook.Sign(payload, now.Add(-time.Hour), "secret"), []string{"secret"}, webhook.ErrTimestampOutOfTolerance},
+		{webhook.Sign(payload, now.Add(time.Hour), "secret"), []string{"secret"}, webhook.ErrTimestampOutOfTolerance},
+		{"", []string{"secret"}, webhook.ErrInvalidHeader},
+		{"t=abc,v1=00", []string{"secret"}, webhook.ErrInvalidHeader},
+		{"t=1526919030", []string{"secret"}, webhook.ErrInvalidHeader},
+	} {
+		err := webhook.Verify(payload, testCase.header, webhook.DefaultTolerance, testCase.secrets...)
+
+		assert.Equal(t, testCase.expectedError, err, testCase.header)
+	}
+}
+
+func TestVerifyNoTolerance(t *testing.T) {
+	payload := []byte(`{"eventType":"user.created"}`)
+
+	err := webhook.Verify(payload, webhook.Sign(payload, time.Unix(1526919030, 0), "secret"), 0, "secret")
+
+	assert.Nil(t, err)
+}
+
+func TestVerifyRequest(t *testing.T) {
+	payload := `{"eventType":"user.created"}`
+	req := httptest.NewRequest("POST", "/", strings.NewReader(payload))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign([]byte(payload), time.Now(), "secret"))
+
+	body, err := webhook.VerifyRequest(req, webhook.DefaultTolerance, "secret")
+
+	assert.Nil(t, err)
+	assert.Equal(t, payload, string(body))
+	reread, _ := ioutil.ReadAll(req.Body)
+	assert.Equal(t, payload, string(reread))
+}